@@ -3,6 +3,7 @@ module github.com/iotaledger/goshimmer
 go 1.12
 
 require (
+	github.com/BurntSushi/toml v0.3.1
 	github.com/dgraph-io/badger v1.6.0
 	github.com/ethereum/go-ethereum v1.9.1
 	github.com/gdamore/tcell v1.2.0
@@ -23,4 +24,5 @@ require (
 	golang.org/x/sys v0.0.0-20190726091711-fc99dfbffb4e // indirect
 	golang.org/x/text v0.3.2 // indirect
 	gopkg.in/check.v1 v1.0.0-20180628173108-788fd7840127 // indirect
+	gopkg.in/yaml.v2 v2.2.2
 )
@@ -11,12 +11,18 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/gossip-on-solidification"
 	"github.com/iotaledger/goshimmer/plugins/gracefulshutdown"
 	"github.com/iotaledger/goshimmer/plugins/metrics"
+	prometheus_exporter "github.com/iotaledger/goshimmer/plugins/prometheus-exporter"
 	"github.com/iotaledger/goshimmer/plugins/statusscreen"
 	statusscreen_tps "github.com/iotaledger/goshimmer/plugins/statusscreen-tps"
 	"github.com/iotaledger/goshimmer/plugins/tangle"
 	"github.com/iotaledger/goshimmer/plugins/tipselection"
 	"github.com/iotaledger/goshimmer/plugins/webapi"
+	webapi_autopeering "github.com/iotaledger/goshimmer/plugins/webapi-autopeering"
+	webapi_autopeering_admin "github.com/iotaledger/goshimmer/plugins/webapi-autopeering-admin"
 	webapi_gtta "github.com/iotaledger/goshimmer/plugins/webapi-gtta"
+	webapi_neighbors "github.com/iotaledger/goshimmer/plugins/webapi-neighbors"
+	webapi_nodeinfo "github.com/iotaledger/goshimmer/plugins/webapi-nodeinfo"
+	webapi_parameters "github.com/iotaledger/goshimmer/plugins/webapi-parameters"
 	webapi_spammer "github.com/iotaledger/goshimmer/plugins/webapi-spammer"
 	"github.com/iotaledger/goshimmer/plugins/zeromq"
 )
@@ -35,12 +41,18 @@ func main() {
 		zeromq.PLUGIN,
 		dashboard.PLUGIN,
 		metrics.PLUGIN,
+		prometheus_exporter.PLUGIN,
 
 		statusscreen.PLUGIN,
 		statusscreen_tps.PLUGIN,
 
 		webapi.PLUGIN,
+		webapi_autopeering.PLUGIN,
+		webapi_autopeering_admin.PLUGIN,
 		webapi_gtta.PLUGIN,
+		webapi_neighbors.PLUGIN,
+		webapi_nodeinfo.PLUGIN,
+		webapi_parameters.PLUGIN,
 		webapi_spammer.PLUGIN,
 	)
 }
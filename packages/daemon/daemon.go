@@ -1,16 +1,23 @@
 package daemon
 
 import (
+	"sort"
 	"sync"
 )
 
+type backgroundWorker struct {
+	name           string
+	handler        WorkerFunc
+	priority       int
+	shutdownSignal chan struct{}
+	terminated     chan struct{}
+}
+
 var (
 	running                  bool
 	wg                       sync.WaitGroup
-	ShutdownSignal           = make(chan int, 1)
-	backgroundWorkers        = make([]func(), 0)
-	backgroundWorkerNames    = make([]string, 0)
-	runningBackgroundWorkers = make(map[string]bool)
+	backgroundWorkers        = make([]*backgroundWorker, 0)
+	runningBackgroundWorkers = make(map[*backgroundWorker]bool)
 	lock                     = sync.Mutex{}
 )
 
@@ -19,7 +26,7 @@ func GetRunningBackgroundWorkers() []string {
 
 	result := make([]string, 0)
 	for runningBackgroundWorker := range runningBackgroundWorkers {
-		result = append(result, runningBackgroundWorker)
+		result = append(result, runningBackgroundWorker.name)
 	}
 
 	lock.Unlock()
@@ -27,32 +34,45 @@ func GetRunningBackgroundWorkers() []string {
 	return result
 }
 
-func runBackgroundWorker(name string, backgroundWorker func()) {
+// Needs to be called while holding the lock.
+func runBackgroundWorker(worker *backgroundWorker) {
 	wg.Add(1)
 
-	go func() {
-		lock.Lock()
-		runningBackgroundWorkers[name] = true
-		lock.Unlock()
+	runningBackgroundWorkers[worker] = true
 
-		backgroundWorker()
+	go func() {
+		worker.handler(worker.shutdownSignal)
 
 		lock.Lock()
-		delete(runningBackgroundWorkers, name)
+		delete(runningBackgroundWorkers, worker)
 		lock.Unlock()
 
+		close(worker.terminated)
+
 		wg.Done()
 	}()
 }
 
-func BackgroundWorker(name string, handler func()) {
+// Registers a background worker that gets started together with the daemon (or immediately if the daemon is running
+// already). Workers with a higher priority are signaled to shut down first and the daemon waits for all of them to
+// terminate before it signals the workers of the next lower priority (the default priority is 0).
+func BackgroundWorker(name string, handler WorkerFunc, priority ...int) {
+	worker := &backgroundWorker{
+		name:           name,
+		handler:        handler,
+		shutdownSignal: make(chan struct{}),
+		terminated:     make(chan struct{}),
+	}
+	if len(priority) >= 1 {
+		worker.priority = priority[0]
+	}
+
 	lock.Lock()
 
 	if IsRunning() {
-		runBackgroundWorker(name, handler)
+		runBackgroundWorker(worker)
 	} else {
-		backgroundWorkerNames = append(backgroundWorkerNames, name)
-		backgroundWorkers = append(backgroundWorkers, handler)
+		backgroundWorkers = append(backgroundWorkers, worker)
 	}
 
 	lock.Unlock()
@@ -63,15 +83,14 @@ func Start() {
 		lock.Lock()
 
 		if !running {
-			ShutdownSignal = make(chan int, 1)
-
 			running = true
 
 			Events.Run.Trigger()
 
-			for i, backgroundWorker := range backgroundWorkers {
-				runBackgroundWorker(backgroundWorkerNames[i], backgroundWorker)
+			for _, backgroundWorker := range backgroundWorkers {
+				runBackgroundWorker(backgroundWorker)
 			}
+			backgroundWorkers = make([]*backgroundWorker, 0)
 		}
 
 		lock.Unlock()
@@ -84,16 +103,20 @@ func Run() {
 	wg.Wait()
 }
 
+// Initiates the shutdown of the background workers without waiting for them to terminate.
 func Shutdown() {
 	if running {
 		lock.Lock()
 
 		if running {
-			close(ShutdownSignal)
-
 			running = false
 
-			Events.Shutdown.Trigger()
+			wg.Add(1)
+			go func() {
+				shutdownBackgroundWorkers()
+
+				wg.Done()
+			}()
 		}
 
 		lock.Unlock()
@@ -101,23 +124,46 @@ func Shutdown() {
 }
 
 func ShutdownAndWait() {
-	if running {
-		lock.Lock()
+	Shutdown()
 
-		if running {
-			close(ShutdownSignal)
+	wg.Wait()
+}
 
-			running = false
+func IsRunning() bool {
+	return running
+}
 
-			Events.Shutdown.Trigger()
-		}
+// Signals the running workers to shut down - one priority after the other, starting with the highest one.
+func shutdownBackgroundWorkers() {
+	lock.Lock()
+	stages := make(map[int][]*backgroundWorker)
+	for worker := range runningBackgroundWorkers {
+		stages[worker.priority] = append(stages[worker.priority], worker)
+	}
+	lock.Unlock()
 
-		lock.Unlock()
+	priorities := make([]int, 0, len(stages))
+	for priority := range stages {
+		priorities = append(priorities, priority)
 	}
+	sort.Sort(sort.Reverse(sort.IntSlice(priorities)))
 
-	wg.Wait()
-}
+	for _, priority := range priorities {
+		workerNames := make([]string, len(stages[priority]))
+		for i, worker := range stages[priority] {
+			workerNames[i] = worker.name
+		}
+		sort.Strings(workerNames)
 
-func IsRunning() bool {
-	return running
+		Events.ShutdownStageStarted.Trigger(priority, workerNames)
+
+		for _, worker := range stages[priority] {
+			close(worker.shutdownSignal)
+		}
+		for _, worker := range stages[priority] {
+			<-worker.terminated
+		}
+
+		Events.ShutdownStageCompleted.Trigger(priority)
+	}
 }
@@ -0,0 +1,46 @@
+package daemon
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestShutdownPriorities(t *testing.T) {
+	var terminatedWorkers []string
+	var mutex sync.Mutex
+
+	addWorker := func(name string, priority ...int) {
+		BackgroundWorker(name, func(shutdownSignal <-chan struct{}) {
+			<-shutdownSignal
+
+			// the next stage must wait for slow workers
+			time.Sleep(10 * time.Millisecond)
+
+			mutex.Lock()
+			terminatedWorkers = append(terminatedWorkers, name)
+			mutex.Unlock()
+		}, priority...)
+	}
+
+	addWorker("Database")
+	addWorker("WebAPI", 2)
+	addWorker("Tangle", 1)
+
+	Start()
+	ShutdownAndWait()
+
+	expectedOrder := []string{"WebAPI", "Tangle", "Database"}
+	if len(terminatedWorkers) != len(expectedOrder) {
+		t.Fatal("not all workers terminated", terminatedWorkers)
+	}
+	for i, name := range expectedOrder {
+		if terminatedWorkers[i] != name {
+			t.Fatal("the workers were not stopped in the order of their priorities", terminatedWorkers)
+		}
+	}
+
+	if len(GetRunningBackgroundWorkers()) != 0 {
+		t.Error("workers are still running after the shutdown")
+	}
+}
@@ -5,9 +5,21 @@ import (
 )
 
 var Events = struct {
-	Run      *events.Event
-	Shutdown *events.Event
+	Run *events.Event
+	// triggered with the priority and the names of the workers before the workers of a shutdown stage are signaled
+	ShutdownStageStarted *events.Event
+	// triggered with the priority once all workers of a shutdown stage have terminated
+	ShutdownStageCompleted *events.Event
 }{
-	Run:      events.NewEvent(events.CallbackCaller),
-	Shutdown: events.NewEvent(events.CallbackCaller),
+	Run:                    events.NewEvent(events.CallbackCaller),
+	ShutdownStageStarted:   events.NewEvent(shutdownStageStartedCaller),
+	ShutdownStageCompleted: events.NewEvent(shutdownStageCompletedCaller),
+}
+
+func shutdownStageStartedCaller(handler interface{}, params ...interface{}) {
+	handler.(func(int, []string))(params[0].(int), params[1].([]string))
+}
+
+func shutdownStageCompletedCaller(handler interface{}, params ...interface{}) {
+	handler.(func(int))(params[0].(int))
 }
@@ -1,3 +1,6 @@
 package daemon
 
 type Callback = func()
+
+// A background worker - the shutdown signal is closed once the daemon reaches the shutdown stage of the worker.
+type WorkerFunc = func(shutdownSignal <-chan struct{})
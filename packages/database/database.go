@@ -43,7 +43,7 @@ func (this *prefixDb) Set(key []byte, value []byte) error {
 	err := this.db.Update(func(txn *badger.Txn) error {
 		return txn.Set(append(this.prefix, key...), value)
 	})
-	return err
+	return countOperation(&writes, err)
 }
 
 func (this *prefixDb) Contains(key []byte) (bool, error) {
@@ -51,6 +51,7 @@ func (this *prefixDb) Contains(key []byte) (bool, error) {
 		_, err := txn.Get(append(this.prefix, key...))
 		return err
 	})
+	countOperation(&reads, err)
 
 	if err == badger.ErrKeyNotFound {
 		return false, nil
@@ -75,14 +76,14 @@ func (this *prefixDb) Get(key []byte) ([]byte, error) {
 		})
 	})
 
-	return result, err
+	return result, countOperation(&reads, err)
 }
 
 func (this *prefixDb) Delete(key []byte) error {
 	err := this.db.Update(func(txn *badger.Txn) error {
 		return txn.Delete(append(this.prefix, key...))
 	})
-	return err
+	return countOperation(&deletes, err)
 }
 
 func (this *prefixDb) ForEach(consumer func([]byte, []byte)) error {
@@ -107,5 +108,32 @@ func (this *prefixDb) ForEach(consumer func([]byte, []byte)) error {
 		}
 		return nil
 	})
-	return err
+	return countOperation(&iterations, err)
+}
+
+// Iterates the entries in the order of their keys - starting at the given key (or the next larger one) until the
+// consumer returns false.
+func (this *prefixDb) ForEachFrom(start []byte, consumer func([]byte, []byte) bool) error {
+	err := this.db.View(func(txn *badger.Txn) error {
+		iteratorOptions := badger.DefaultIteratorOptions
+		iteratorOptions.Prefix = this.prefix // filter by prefix
+
+		it := txn.NewIterator(iteratorOptions)
+		defer it.Close()
+
+		for it.Seek(append(append([]byte{}, this.prefix...), start...)); it.Valid(); it.Next() {
+			item := it.Item()
+
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			if !consumer(item.KeyCopy(nil)[len(this.prefix):], value) {
+				break
+			}
+		}
+		return nil
+	})
+	return countOperation(&iterations, err)
 }
@@ -5,5 +5,6 @@ type Database interface {
 	Contains(key []byte) (bool, error)
 	Get(key []byte) ([]byte, error)
 	ForEach(func(key []byte, value []byte)) error
+	ForEachFrom(start []byte, consumer func(key []byte, value []byte) bool) error
 	Delete(key []byte) error
 }
@@ -0,0 +1,47 @@
+package database
+
+import (
+	"sync/atomic"
+
+	"github.com/dgraph-io/badger"
+)
+
+// Returns the number of operations that were executed on the database since the node started.
+func GetStatistics() Statistics {
+	return Statistics{
+		Reads:      atomic.LoadUint64(&reads),
+		Writes:     atomic.LoadUint64(&writes),
+		Deletes:    atomic.LoadUint64(&deletes),
+		Iterations: atomic.LoadUint64(&iterations),
+		Errors:     atomic.LoadUint64(&failedOperations),
+	}
+}
+
+type Statistics struct {
+	Reads      uint64
+	Writes     uint64
+	Deletes    uint64
+	Iterations uint64
+	Errors     uint64
+}
+
+// counters for the executed operations
+var (
+	reads            uint64
+	writes           uint64
+	deletes          uint64
+	iterations       uint64
+	failedOperations uint64
+)
+
+// increases the given operation counter (and the error counter if the operation failed for another reason than a
+// missing key) and passes the error through
+func countOperation(counter *uint64, err error) error {
+	atomic.AddUint64(counter, 1)
+
+	if err != nil && err != badger.ErrKeyNotFound {
+		atomic.AddUint64(&failedOperations, 1)
+	}
+
+	return err
+}
@@ -2,6 +2,7 @@ package datastructure
 
 import (
 	"sync"
+	"sync/atomic"
 
 	"github.com/iotaledger/goshimmer/packages/typeutils"
 )
@@ -19,6 +20,8 @@ type LRUCache struct {
 	options          *LRUCacheOptions
 	mutex            sync.RWMutex
 	krwMutex         KRWMutex
+	hits             uint64
+	misses           uint64
 }
 
 func NewLRUCache(capacity int, options ...*LRUCacheOptions) *LRUCache {
@@ -96,9 +99,14 @@ func (cache *LRUCache) ComputeIfAbsent(key interface{}, callback func() interfac
 		result = element.GetValue().(*lruCacheElement).value
 
 		keyMutex.RUnlock()
+
+		atomic.AddUint64(&cache.hits, 1)
 	} else {
 		cache.mutex.RUnlock()
 		keyMutex.RUnlock()
+
+		atomic.AddUint64(&cache.misses, 1)
+
 		keyMutex.Lock()
 		if result = callback(); !typeutils.IsInterfaceNil(result) {
 			cache.mutex.Lock()
@@ -176,11 +184,15 @@ func (cache *LRUCache) Contains(key interface{}) (result bool) {
 		cache.mutex.Unlock()
 
 		result = true
+
+		atomic.AddUint64(&cache.hits, 1)
 	} else {
 		cache.mutex.RUnlock()
 		keyMutex.RUnlock()
 
 		result = false
+
+		atomic.AddUint64(&cache.misses, 1)
 	}
 
 	cache.krwMutex.Free(key)
@@ -202,8 +214,12 @@ func (cache *LRUCache) Get(key interface{}) (result interface{}) {
 		result = element.GetValue().(*lruCacheElement).value
 
 		keyMutex.RUnlock()
+
+		atomic.AddUint64(&cache.hits, 1)
 	} else {
 		cache.mutex.RUnlock()
+
+		atomic.AddUint64(&cache.misses, 1)
 	}
 
 	cache.krwMutex.Free(key)
@@ -225,6 +241,21 @@ func (cache *LRUCache) GetSize() int {
 	return cache.size
 }
 
+// Returns the number of lookups (Get, Contains and ComputeIfAbsent) that found an entry (hits) and that did not (misses).
+func (cache *LRUCache) Statistics() (hits uint64, misses uint64) {
+	return atomic.LoadUint64(&cache.hits), atomic.LoadUint64(&cache.misses)
+}
+
+// Returns the share of the lookups that found an entry in the cache.
+func (cache *LRUCache) HitRate() float64 {
+	hits, misses := cache.Statistics()
+	if hits+misses == 0 {
+		return 0
+	}
+
+	return float64(hits) / float64(hits+misses)
+}
+
 func (cache *LRUCache) Delete(key interface{}) bool {
 	keyMutex := cache.krwMutex.Register(key)
 	keyMutex.Lock()
@@ -259,6 +290,30 @@ func (cache *LRUCache) Delete(key interface{}) bool {
 	return false
 }
 
+// Removes all entries from the cache (starting with the least recently used one) and calls the eviction callback for
+// each of them - this allows to write back modified entries before the node shuts down.
+func (cache *LRUCache) DeleteAll() {
+	cache.mutex.Lock()
+	evictedElements := make([]*lruCacheElement, 0, cache.size)
+	for cache.size > 0 {
+		if entry, err := cache.doublyLinkedList.removeLastEntry(); err != nil {
+			panic(err)
+		} else {
+			evictedElements = append(evictedElements, entry.value.(*lruCacheElement))
+		}
+
+		cache.size--
+	}
+	cache.directory = make(map[interface{}]*DoublyLinkedListEntry, cache.capacity)
+	cache.mutex.Unlock()
+
+	if cache.options.EvictionCallback != nil {
+		for _, evictedElement := range evictedElements {
+			cache.options.EvictionCallback(evictedElement.key, evictedElement.value)
+		}
+	}
+}
+
 func (cache *LRUCache) promoteElement(element *DoublyLinkedListEntry) {
 	if err := cache.doublyLinkedList.removeEntry(element); err != nil {
 		panic(err)
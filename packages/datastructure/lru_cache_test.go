@@ -102,3 +102,61 @@ func TestLRUCache_ComputeIfPresent(t *testing.T) {
 		t.Error("cache was not updated correctly")
 	}
 }
+
+func TestLRUCache_DeleteAll(t *testing.T) {
+	evictedKeys := make([]interface{}, 0)
+	cache := NewLRUCache(5, &LRUCacheOptions{
+		EvictionCallback: func(key interface{}, value interface{}) {
+			evictedKeys = append(evictedKeys, key)
+		},
+	})
+
+	cache.Set("a", 1)
+	cache.Set("b", 2)
+	cache.Set("c", 3)
+	cache.Get("a")
+
+	cache.DeleteAll()
+
+	if cache.GetSize() != 0 || cache.Contains("a") {
+		t.Error("the cache should be empty")
+	}
+
+	expectedKeys := []interface{}{"b", "c", "a"}
+	if len(evictedKeys) != len(expectedKeys) {
+		t.Fatal("wrong number of evicted entries", evictedKeys)
+	}
+	for i, key := range expectedKeys {
+		if evictedKeys[i] != key {
+			t.Error("the entries were not evicted in the least recently used order", evictedKeys)
+		}
+	}
+
+	cache.Set("d", 4)
+	if cache.Get("d") != 4 || cache.GetSize() != 1 {
+		t.Error("the cache can not be used after it was emptied")
+	}
+}
+
+func TestLRUCache_Statistics(t *testing.T) {
+	cache := NewLRUCache(5)
+
+	cache.Set("a", 1)
+	cache.Get("a")
+	cache.Get("b")
+	cache.Contains("a")
+	cache.ComputeIfAbsent("c", func() interface{} {
+		return 3
+	})
+	cache.ComputeIfAbsent("c", func() interface{} {
+		return 3
+	})
+
+	if hits, misses := cache.Statistics(); hits != 3 || misses != 2 {
+		t.Error("unexpected statistics", hits, misses)
+	}
+
+	if cache.HitRate() != 0.6 {
+		t.Error("unexpected hit rate", cache.HitRate())
+	}
+}
@@ -0,0 +1,137 @@
+package filter
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// SeenSet remembers the keys that were added to it, bounded by both a maximum number of entries and (optionally) a
+// maximum age. The oldest entries are evicted first. It keeps track of the number of hits (duplicates) and misses.
+type SeenSet struct {
+	entries      []seenSetEntry
+	entriesByKey map[string]time.Time
+	head         int
+	size         int
+	capacity     int
+	timeToLive   time.Duration
+	hits         uint64
+	misses       uint64
+	mutex        sync.RWMutex
+}
+
+type seenSetEntry struct {
+	key       string
+	addedTime time.Time
+}
+
+// Creates a new SeenSet with the given capacity - a timeToLive of 0 disables the time based eviction.
+func NewSeenSet(capacity int, timeToLive time.Duration) *SeenSet {
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &SeenSet{
+		entries:      make([]seenSetEntry, capacity),
+		entriesByKey: make(map[string]time.Time, capacity),
+		capacity:     capacity,
+		timeToLive:   timeToLive,
+	}
+}
+
+func (seenSet *SeenSet) Contains(key string) bool {
+	seenSet.mutex.RLock()
+	defer seenSet.mutex.RUnlock()
+
+	addedTime, exists := seenSet.entriesByKey[key]
+
+	return exists && !seenSet.isExpired(addedTime, time.Now())
+}
+
+// Adds the key to the set and returns true if it was not seen before (the hit and miss counters are updated).
+func (seenSet *SeenSet) Add(key string) bool {
+	added, _ := seenSet.add(key, true)
+
+	return added
+}
+
+// Adds the key like Add, but never evicts keys that did not expire, yet - while the set is full of such keys, new keys
+// are rejected and full is true.
+func (seenSet *SeenSet) AddWithoutEviction(key string) (added bool, full bool) {
+	return seenSet.add(key, false)
+}
+
+func (seenSet *SeenSet) add(key string, evictOldest bool) (added bool, full bool) {
+	now := time.Now()
+
+	seenSet.mutex.Lock()
+	defer seenSet.mutex.Unlock()
+
+	seenSet.evictExpired(now)
+
+	if _, exists := seenSet.entriesByKey[key]; exists {
+		atomic.AddUint64(&seenSet.hits, 1)
+
+		return false, false
+	}
+
+	if seenSet.size == seenSet.capacity {
+		if !evictOldest {
+			return false, true
+		}
+
+		delete(seenSet.entriesByKey, seenSet.entries[seenSet.head].key)
+
+		seenSet.head = (seenSet.head + 1) % seenSet.capacity
+		seenSet.size--
+	}
+
+	seenSet.entries[(seenSet.head+seenSet.size)%seenSet.capacity] = seenSetEntry{key: key, addedTime: now}
+	seenSet.entriesByKey[key] = now
+	seenSet.size++
+
+	atomic.AddUint64(&seenSet.misses, 1)
+
+	return true, false
+}
+
+func (seenSet *SeenSet) Size() int {
+	seenSet.mutex.RLock()
+	defer seenSet.mutex.RUnlock()
+
+	return seenSet.size
+}
+
+func (seenSet *SeenSet) Capacity() int {
+	return seenSet.capacity
+}
+
+// Returns the number of duplicate (hits) and new keys (misses) that were passed to Add.
+func (seenSet *SeenSet) Statistics() (hits uint64, misses uint64) {
+	return atomic.LoadUint64(&seenSet.hits), atomic.LoadUint64(&seenSet.misses)
+}
+
+// Returns the share of the added keys that were duplicates.
+func (seenSet *SeenSet) HitRate() float64 {
+	hits, misses := seenSet.Statistics()
+	if hits+misses == 0 {
+		return 0
+	}
+
+	return float64(hits) / float64(hits+misses)
+}
+
+func (seenSet *SeenSet) isExpired(addedTime time.Time, now time.Time) bool {
+	return seenSet.timeToLive > 0 && now.Sub(addedTime) > seenSet.timeToLive
+}
+
+// removes the expired entries (they are ordered by their age, so we can stop at the first non-expired one)
+func (seenSet *SeenSet) evictExpired(now time.Time) {
+	for seenSet.size > 0 && seenSet.isExpired(seenSet.entries[seenSet.head].addedTime, now) {
+		delete(seenSet.entriesByKey, seenSet.entries[seenSet.head].key)
+
+		seenSet.entries[seenSet.head] = seenSetEntry{}
+		seenSet.head = (seenSet.head + 1) % seenSet.capacity
+		seenSet.size--
+	}
+}
@@ -0,0 +1,97 @@
+package filter
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestSeenSet_Capacity(t *testing.T) {
+	seenSet := NewSeenSet(3, 0)
+
+	for i := 0; i < 3; i++ {
+		if !seenSet.Add(strconv.Itoa(i)) {
+			t.Error("new key was reported as seen", i)
+		}
+	}
+
+	if seenSet.Add("1") {
+		t.Error("duplicate key was reported as new")
+	}
+
+	// evicts the oldest key "0"
+	seenSet.Add("3")
+
+	if seenSet.Contains("0") {
+		t.Error("oldest key was not evicted")
+	}
+	if !seenSet.Contains("1") || !seenSet.Contains("3") {
+		t.Error("recent keys were evicted")
+	}
+	if seenSet.Size() != 3 {
+		t.Error("unexpected size", seenSet.Size())
+	}
+
+	if hits, misses := seenSet.Statistics(); hits != 1 || misses != 4 {
+		t.Error("unexpected statistics", hits, misses)
+	}
+}
+
+func TestSeenSet_AddWithoutEviction(t *testing.T) {
+	seenSet := NewSeenSet(2, 50*time.Millisecond)
+
+	seenSet.AddWithoutEviction("a")
+	seenSet.AddWithoutEviction("b")
+
+	if added, full := seenSet.AddWithoutEviction("a"); added || full {
+		t.Error("duplicate key was not reported as seen", added, full)
+	}
+	if added, full := seenSet.AddWithoutEviction("c"); added || !full {
+		t.Error("key was added to a full set", added, full)
+	}
+	if !seenSet.Contains("a") || !seenSet.Contains("b") {
+		t.Error("unexpired keys were evicted")
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	if added, full := seenSet.AddWithoutEviction("c"); !added || full {
+		t.Error("key was not added after the other keys expired", added, full)
+	}
+}
+
+func TestSeenSet_TimeToLive(t *testing.T) {
+	seenSet := NewSeenSet(100, 50*time.Millisecond)
+
+	seenSet.Add("a")
+	if !seenSet.Contains("a") {
+		t.Error("key was not added")
+	}
+
+	time.Sleep(100 * time.Millisecond)
+
+	if seenSet.Contains("a") {
+		t.Error("expired key is still contained")
+	}
+	if !seenSet.Add("a") {
+		t.Error("expired key was reported as seen")
+	}
+	if seenSet.Size() != 1 {
+		t.Error("expired key was not evicted", seenSet.Size())
+	}
+}
+
+func BenchmarkSeenSet_Add(b *testing.B) {
+	seenSet := NewSeenSet(100000, time.Minute)
+
+	keys := make([]string, 200000)
+	for i := range keys {
+		keys[i] = strconv.Itoa(i)
+	}
+
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		seenSet.Add(keys[i%len(keys)])
+	}
+}
@@ -0,0 +1,144 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Encoder turns an entry into a single line of output (including the line break).
+type Encoder interface {
+	Encode(entry *Entry) []byte
+}
+
+// Returns the encoder for the given format (console or json).
+func NewEncoder(format string, rootSource string) (Encoder, error) {
+	switch strings.ToLower(format) {
+	case "console":
+		return NewConsoleEncoder(rootSource), nil
+	case "json":
+		return NewJSONEncoder(), nil
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+// region ConsoleEncoder ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// ConsoleEncoder writes human readable lines like "[ INFO ] Gossip: Starting TCP Server port=14666".
+type ConsoleEncoder struct {
+	rootSource string
+}
+
+// Creates a console encoder - entries of the root source (i.e. the node itself) are written without a source prefix.
+func NewConsoleEncoder(rootSource string) *ConsoleEncoder {
+	return &ConsoleEncoder{
+		rootSource: rootSource,
+	}
+}
+
+func (encoder *ConsoleEncoder) Encode(entry *Entry) []byte {
+	var builder strings.Builder
+
+	builder.WriteString(consoleLevelLabels[entry.Level])
+	builder.WriteString(" ")
+	if entry.Source != "" && entry.Source != encoder.rootSource {
+		builder.WriteString(entry.Source)
+		builder.WriteString(": ")
+	}
+	builder.WriteString(entry.Message)
+	builder.WriteString(FormatFields(entry.Fields))
+	builder.WriteString("\n")
+
+	return []byte(builder.String())
+}
+
+// Formats the fields as " key=value" pairs (values that contain spaces or quotes are quoted).
+func FormatFields(fields []Field) string {
+	var builder strings.Builder
+	for _, field := range fields {
+		value := fmt.Sprint(field.Value)
+		if value == "" || strings.ContainsAny(value, " \t\n\"=") {
+			value = strconv.Quote(value)
+		}
+
+		builder.WriteString(" ")
+		builder.WriteString(field.Key)
+		builder.WriteString("=")
+		builder.WriteString(value)
+	}
+
+	return builder.String()
+}
+
+var consoleLevelLabels = map[Level]string{
+	LEVEL_FAILURE: "[ FAIL ]",
+	LEVEL_WARNING: "[ WARN ]",
+	LEVEL_SUCCESS: "[  OK  ]",
+	LEVEL_INFO:    "[ INFO ]",
+	LEVEL_DEBUG:   "[ NOTE ]",
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region JSONEncoder //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// JSONEncoder writes one JSON object per line, i.e. {"time":"...","level":"info","source":"Gossip","message":"Starting
+// TCP Server","fields":{"port":14666}}.
+type JSONEncoder struct{}
+
+func NewJSONEncoder() *JSONEncoder {
+	return &JSONEncoder{}
+}
+
+func (encoder *JSONEncoder) Encode(entry *Entry) []byte {
+	jsonEntry := jsonEntry{
+		Time:    entry.Time.Format(time.RFC3339Nano),
+		Level:   entry.Level.String(),
+		Source:  entry.Source,
+		Message: entry.Message,
+	}
+
+	if len(entry.Fields) != 0 {
+		jsonEntry.Fields = make(map[string]interface{}, len(entry.Fields))
+		for _, field := range entry.Fields {
+			jsonEntry.Fields[field.Key] = toJSONValue(field.Value)
+		}
+	}
+
+	data, err := json.Marshal(jsonEntry)
+	if err != nil {
+		// values that can not be marshaled are written as strings
+		for key, value := range jsonEntry.Fields {
+			jsonEntry.Fields[key] = fmt.Sprint(value)
+		}
+
+		data, _ = json.Marshal(jsonEntry)
+	}
+
+	return append(data, '\n')
+}
+
+// errors and types with a String method (i.e. durations and IPs) are written in their readable form
+func toJSONValue(value interface{}) interface{} {
+	switch typedValue := value.(type) {
+	case error:
+		return typedValue.Error()
+	case fmt.Stringer:
+		return typedValue.String()
+	default:
+		return value
+	}
+}
+
+type jsonEntry struct {
+	Time    string                 `json:"time"`
+	Level   string                 `json:"level"`
+	Source  string                 `json:"source"`
+	Message string                 `json:"message"`
+	Fields  map[string]interface{} `json:"fields,omitempty"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
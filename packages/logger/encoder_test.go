@@ -0,0 +1,46 @@
+package logger
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestConsoleEncoder(t *testing.T) {
+	encoder := NewConsoleEncoder("Node")
+
+	entry := &Entry{Level: LEVEL_SUCCESS, Source: "Gossip", Message: "Starting TCP Server ... done", Fields: []Field{{"port", 14666}, {"address", "a b"}}}
+	if line := string(encoder.Encode(entry)); line != "[  OK  ] Gossip: Starting TCP Server ... done port=14666 address=\"a b\"\n" {
+		t.Error("unexpected line", line)
+	}
+
+	entry = &Entry{Level: LEVEL_INFO, Source: "Node", Message: "Loading plugins ..."}
+	if line := string(encoder.Encode(entry)); line != "[ INFO ] Loading plugins ...\n" {
+		t.Error("unexpected line", line)
+	}
+}
+
+func TestJSONEncoder(t *testing.T) {
+	entry := &Entry{
+		Time:    time.Unix(1563000000, 0).UTC(),
+		Level:   LEVEL_FAILURE,
+		Source:  "Gossip",
+		Message: "connection failed",
+		Fields:  []Field{{"error", errors.New("timeout")}, {"backoff", 10 * time.Second}, {"attempt", 2}},
+	}
+
+	var decodedEntry map[string]interface{}
+	if err := json.Unmarshal(NewJSONEncoder().Encode(entry), &decodedEntry); err != nil {
+		t.Fatal(err)
+	}
+
+	if decodedEntry["time"] != "2019-07-13T06:40:00Z" || decodedEntry["level"] != "failure" || decodedEntry["source"] != "Gossip" || decodedEntry["message"] != "connection failed" {
+		t.Error("unexpected entry", decodedEntry)
+	}
+
+	fields := decodedEntry["fields"].(map[string]interface{})
+	if fields["error"] != "timeout" || fields["backoff"] != "10s" || fields["attempt"] != float64(2) {
+		t.Error("unexpected fields", fields)
+	}
+}
@@ -0,0 +1,34 @@
+package logger
+
+import (
+	"fmt"
+	"time"
+)
+
+type Entry struct {
+	Time    time.Time
+	Level   Level
+	Source  string
+	Message string
+	Fields  []Field
+}
+
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// converts alternating keys and values into fields (a missing value of the last key is reported as nil)
+func toFields(keyValues []interface{}) []Field {
+	fields := make([]Field, 0, (len(keyValues)+1)/2)
+	for i := 0; i < len(keyValues); i += 2 {
+		field := Field{Key: fmt.Sprint(keyValues[i])}
+		if i+1 < len(keyValues) {
+			field.Value = keyValues[i+1]
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
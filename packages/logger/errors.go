@@ -0,0 +1,8 @@
+package logger
+
+import "github.com/pkg/errors"
+
+var (
+	ErrUnknownLevel  = errors.New("unknown log level (expected failure, warning, success, info, debug or 0 - 4)")
+	ErrUnknownFormat = errors.New("unknown log format (expected console or json)")
+)
@@ -0,0 +1,51 @@
+package logger
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Level controls which entries are logged - a level includes all levels with a lower value.
+type Level int
+
+const (
+	LEVEL_FAILURE Level = iota
+	LEVEL_WARNING
+	LEVEL_SUCCESS
+	LEVEL_INFO
+	LEVEL_DEBUG
+)
+
+var levelNames = map[Level]string{
+	LEVEL_FAILURE: "failure",
+	LEVEL_WARNING: "warning",
+	LEVEL_SUCCESS: "success",
+	LEVEL_INFO:    "info",
+	LEVEL_DEBUG:   "debug",
+}
+
+func (level Level) String() string {
+	if name, exists := levelNames[level]; exists {
+		return name
+	}
+
+	return strconv.Itoa(int(level))
+}
+
+// Parses the name (i.e. "debug") or the number (i.e. "4") of a level.
+func ParseLevel(value string) (Level, error) {
+	normalizedValue := strings.ToLower(strings.TrimSpace(value))
+	for level, name := range levelNames {
+		if name == normalizedValue {
+			return level, nil
+		}
+	}
+
+	if number, err := strconv.Atoi(normalizedValue); err == nil && number >= int(LEVEL_FAILURE) && number <= int(LEVEL_DEBUG) {
+		return Level(number), nil
+	}
+
+	return 0, errors.Wrap(ErrUnknownLevel, value)
+}
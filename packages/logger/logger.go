@@ -0,0 +1,205 @@
+package logger
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Logger writes entries of a single source (i.e. a plugin) together with its context fields.
+type Logger struct {
+	source string
+	fields []Field
+}
+
+func New(source string) *Logger {
+	return &Logger{
+		source: source,
+	}
+}
+
+// Returns a logger that adds the given key / value pairs to all of its entries.
+func (logger *Logger) With(keyValues ...interface{}) *Logger {
+	return &Logger{
+		source: logger.source,
+		fields: append(append([]Field{}, logger.fields...), toFields(keyValues)...),
+	}
+}
+
+func (logger *Logger) IsEnabled(level Level) bool {
+	return IsEnabled(logger.source, level)
+}
+
+func (logger *Logger) Failure(message string, keyValues ...interface{}) {
+	logger.log(LEVEL_FAILURE, message, keyValues)
+}
+
+func (logger *Logger) Warning(message string, keyValues ...interface{}) {
+	logger.log(LEVEL_WARNING, message, keyValues)
+}
+
+func (logger *Logger) Success(message string, keyValues ...interface{}) {
+	logger.log(LEVEL_SUCCESS, message, keyValues)
+}
+
+func (logger *Logger) Info(message string, keyValues ...interface{}) {
+	logger.log(LEVEL_INFO, message, keyValues)
+}
+
+func (logger *Logger) Debug(message string, keyValues ...interface{}) {
+	logger.log(LEVEL_DEBUG, message, keyValues)
+}
+
+func (logger *Logger) log(level Level, message string, keyValues []interface{}) {
+	if !IsEnabled(logger.source, level) {
+		return
+	}
+
+	fields := logger.fields
+	if len(keyValues) != 0 {
+		fields = append(append([]Field{}, logger.fields...), toFields(keyValues)...)
+	}
+
+	dispatch(&Entry{
+		Time:    time.Now(),
+		Level:   level,
+		Source:  logger.source,
+		Message: message,
+		Fields:  fields,
+	})
+}
+
+// Logs a single entry without creating a Logger first.
+func Log(source string, level Level, message string, keyValues ...interface{}) {
+	if !IsEnabled(source, level) {
+		return
+	}
+
+	dispatch(&Entry{
+		Time:    time.Now(),
+		Level:   level,
+		Source:  source,
+		Message: message,
+		Fields:  toFields(keyValues),
+	})
+}
+
+// Adds a sink that receives all logged entries (sinks that were added already are ignored).
+func AddSink(sink Sink) {
+	sinksMutex.Lock()
+	defer sinksMutex.Unlock()
+
+	for _, existingSink := range sinks {
+		if existingSink == sink {
+			return
+		}
+	}
+
+	sinks = append(sinks, sink)
+}
+
+func RemoveSink(sink Sink) {
+	sinksMutex.Lock()
+	defer sinksMutex.Unlock()
+
+	for i, existingSink := range sinks {
+		if existingSink == sink {
+			sinks = append(sinks[:i:i], sinks[i+1:]...)
+
+			return
+		}
+	}
+}
+
+// Sets the level of all sources that do not have a level of their own.
+func SetLevel(level Level) {
+	levelsMutex.Lock()
+	defer levelsMutex.Unlock()
+
+	defaultLevel = level
+	effectiveLevels = make(map[string]Level)
+}
+
+func GetLevel() Level {
+	levelsMutex.RLock()
+	defer levelsMutex.RUnlock()
+
+	return defaultLevel
+}
+
+// Replaces the levels of the individual sources - the names are matched case insensitive and ignore spaces, dashes and
+// underscores (so "autopeering" matches the plugin "Auto Peering").
+func SetSourceLevels(levels map[string]Level) {
+	levelsMutex.Lock()
+	defer levelsMutex.Unlock()
+
+	sourceLevels = make(map[string]Level, len(levels))
+	for source, level := range levels {
+		sourceLevels[normalizeSource(source)] = level
+	}
+	effectiveLevels = make(map[string]Level)
+}
+
+// Parses definitions like "gossip=debug" (see SetSourceLevels).
+func ParseSourceLevels(definitions []string) (map[string]Level, error) {
+	result := make(map[string]Level, len(definitions))
+	for _, definition := range definitions {
+		separatorIndex := strings.LastIndex(definition, "=")
+		if separatorIndex <= 0 {
+			return nil, errors.Wrap(ErrUnknownLevel, "expected source=level but got "+definition)
+		}
+
+		level, err := ParseLevel(definition[separatorIndex+1:])
+		if err != nil {
+			return nil, err
+		}
+
+		result[definition[:separatorIndex]] = level
+	}
+
+	return result, nil
+}
+
+// Checks if entries of the given source and level are logged.
+func IsEnabled(source string, level Level) bool {
+	levelsMutex.RLock()
+	effectiveLevel, exists := effectiveLevels[source]
+	levelsMutex.RUnlock()
+
+	if !exists {
+		levelsMutex.Lock()
+		if effectiveLevel, exists = sourceLevels[normalizeSource(source)]; !exists {
+			effectiveLevel = defaultLevel
+		}
+		effectiveLevels[source] = effectiveLevel
+		levelsMutex.Unlock()
+	}
+
+	return level <= effectiveLevel
+}
+
+func dispatch(entry *Entry) {
+	sinksMutex.RLock()
+	currentSinks := sinks
+	sinksMutex.RUnlock()
+
+	for _, sink := range currentSinks {
+		sink.Log(entry)
+	}
+}
+
+func normalizeSource(source string) string {
+	return strings.NewReplacer(" ", "", "-", "", "_", "").Replace(strings.ToLower(source))
+}
+
+var (
+	sinks      = make([]Sink, 0)
+	sinksMutex sync.RWMutex
+
+	defaultLevel    = LEVEL_INFO
+	sourceLevels    = make(map[string]Level)
+	effectiveLevels = make(map[string]Level)
+	levelsMutex     sync.RWMutex
+)
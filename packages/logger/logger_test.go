@@ -0,0 +1,60 @@
+package logger
+
+import (
+	"testing"
+)
+
+type testSink struct {
+	entries []*Entry
+}
+
+func (sink *testSink) Log(entry *Entry) {
+	sink.entries = append(sink.entries, entry)
+}
+
+func TestLogger(t *testing.T) {
+	sink := &testSink{}
+	AddSink(sink)
+	AddSink(sink)
+	defer RemoveSink(sink)
+
+	SetLevel(LEVEL_INFO)
+	levels, err := ParseSourceLevels([]string{"auto-peering=debug", "Gossip=0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetSourceLevels(levels)
+	defer SetSourceLevels(nil)
+
+	New("Gossip").Warning("dropped")
+	New("Tangle").Debug("dropped")
+	New("Tangle").Info("solid", "hash", "ABC")
+	New("Auto Peering").With("peer", "1.2.3.4").Debug("ping", "port", 14626)
+
+	if len(sink.entries) != 2 {
+		t.Fatal("expected 2 entries but got", len(sink.entries))
+	}
+
+	if entry := sink.entries[0]; entry.Source != "Tangle" || entry.Level != LEVEL_INFO || len(entry.Fields) != 1 || entry.Fields[0] != (Field{"hash", "ABC"}) {
+		t.Error("unexpected entry", entry)
+	}
+
+	if entry := sink.entries[1]; FormatFields(entry.Fields) != " peer=1.2.3.4 port=14626" {
+		t.Error("unexpected fields", FormatFields(entry.Fields))
+	}
+}
+
+func TestParseLevel(t *testing.T) {
+	for value, expectedLevel := range map[string]Level{"debug": LEVEL_DEBUG, "WARNING": LEVEL_WARNING, "2": LEVEL_SUCCESS} {
+		if level, err := ParseLevel(value); err != nil || level != expectedLevel {
+			t.Error(value, "was parsed as", level, err)
+		}
+	}
+
+	if _, err := ParseLevel("5"); err == nil {
+		t.Error("an invalid level was accepted")
+	}
+	if _, err := ParseSourceLevels([]string{"gossip"}); err == nil {
+		t.Error("a definition without a level was accepted")
+	}
+}
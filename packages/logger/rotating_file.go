@@ -0,0 +1,115 @@
+package logger
+
+import (
+	"os"
+	"strconv"
+	"sync"
+)
+
+// RotatingFile is a log file that gets renamed to <path>.1 (and the older backups to <path>.2, <path>.3, ...) once it
+// would exceed its maximum size.
+type RotatingFile struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+	mutex      sync.Mutex
+}
+
+// Opens (or creates) the log file - new entries are appended to an existing file.
+func NewRotatingFile(path string, maxSize int64, maxBackups int) (*RotatingFile, error) {
+	rotatingFile := &RotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+
+	if err := rotatingFile.open(os.O_APPEND); err != nil {
+		return nil, err
+	}
+
+	return rotatingFile, nil
+}
+
+func (rotatingFile *RotatingFile) Write(data []byte) (int, error) {
+	rotatingFile.mutex.Lock()
+	defer rotatingFile.mutex.Unlock()
+
+	if rotatingFile.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	if rotatingFile.size > 0 && rotatingFile.size+int64(len(data)) > rotatingFile.maxSize {
+		if err := rotatingFile.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	written, err := rotatingFile.file.Write(data)
+	rotatingFile.size += int64(written)
+
+	return written, err
+}
+
+func (rotatingFile *RotatingFile) Close() error {
+	rotatingFile.mutex.Lock()
+	defer rotatingFile.mutex.Unlock()
+
+	if rotatingFile.file == nil {
+		return nil
+	}
+
+	err := rotatingFile.file.Close()
+	rotatingFile.file = nil
+
+	return err
+}
+
+func (rotatingFile *RotatingFile) open(mode int) error {
+	file, err := os.OpenFile(rotatingFile.path, os.O_CREATE|os.O_WRONLY|mode, 0644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	rotatingFile.file = file
+	rotatingFile.size = info.Size()
+
+	return nil
+}
+
+func (rotatingFile *RotatingFile) rotate() error {
+	if err := rotatingFile.file.Close(); err != nil {
+		return err
+	}
+	rotatingFile.file = nil
+
+	if rotatingFile.maxBackups == 0 {
+		if err := os.Remove(rotatingFile.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	} else {
+		for i := rotatingFile.maxBackups - 1; i >= 1; i-- {
+			if err := os.Rename(rotatingFile.backupPath(i), rotatingFile.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+
+		if err := os.Rename(rotatingFile.path, rotatingFile.backupPath(1)); err != nil {
+			return err
+		}
+	}
+
+	return rotatingFile.open(os.O_TRUNC)
+}
+
+func (rotatingFile *RotatingFile) backupPath(index int) string {
+	return rotatingFile.path + "." + strconv.Itoa(index)
+}
@@ -0,0 +1,45 @@
+package logger
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRotatingFile(t *testing.T) {
+	directory, err := ioutil.TempDir("", "logger")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	path := filepath.Join(directory, "node.log")
+	rotatingFile, err := NewRotatingFile(path, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		if _, err := rotatingFile.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := rotatingFile.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the oldest line exceeds the amount of backups
+	for name, expectedContent := range map[string]string{"node.log": "fourth\n", "node.log.1": "third\n", "node.log.2": "second\n"} {
+		content, err := ioutil.ReadFile(filepath.Join(directory, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != expectedContent {
+			t.Errorf("%s contains %q instead of %q", name, content, expectedContent)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Error("more backups than configured were kept")
+	}
+}
@@ -0,0 +1,52 @@
+package logger
+
+import (
+	"io"
+	"sync"
+)
+
+// Sink receives all entries that pass the level of their source.
+type Sink interface {
+	Log(entry *Entry)
+}
+
+// WriterSink encodes the entries and writes them to a writer (i.e. the console or a RotatingFile).
+type WriterSink struct {
+	writer  io.Writer
+	encoder Encoder
+	enabled bool
+	mutex   sync.Mutex
+}
+
+func NewWriterSink(writer io.Writer, encoder Encoder) *WriterSink {
+	return &WriterSink{
+		writer:  writer,
+		encoder: encoder,
+		enabled: true,
+	}
+}
+
+func (sink *WriterSink) Log(entry *Entry) {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+
+	if sink.enabled {
+		// there is nowhere left to report failed writes to
+		_, _ = sink.writer.Write(sink.encoder.Encode(entry))
+	}
+}
+
+func (sink *WriterSink) SetEncoder(encoder Encoder) {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+
+	sink.encoder = encoder
+}
+
+// Pauses or resumes the output (i.e. while the console is used by the status screen).
+func (sink *WriterSink) SetEnabled(enabled bool) {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+
+	sink.enabled = enabled
+}
@@ -0,0 +1,137 @@
+package natpmp
+
+import (
+	"encoding/binary"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Client requests the external address and port mappings from a NAT-PMP (RFC 6886) gateway.
+type Client struct {
+	gateway        *net.UDPAddr
+	initialTimeout time.Duration
+	maxAttempts    int
+	mutex          sync.Mutex
+}
+
+// Creates a client for the given gateway (the port is optional and defaults to DEFAULT_GATEWAY_PORT).
+func NewClient(gatewayAddress string) (*Client, error) {
+	if _, _, err := net.SplitHostPort(gatewayAddress); err != nil {
+		gatewayAddress = net.JoinHostPort(gatewayAddress, strconv.Itoa(DEFAULT_GATEWAY_PORT))
+	}
+
+	gateway, err := net.ResolveUDPAddr("udp", gatewayAddress)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not resolve NAT-PMP gateway")
+	}
+
+	return &Client{
+		gateway:        gateway,
+		initialTimeout: DEFAULT_INITIAL_TIMEOUT,
+		maxAttempts:    DEFAULT_MAX_ATTEMPTS,
+	}, nil
+}
+
+// Returns the address that the gateway uses towards the internet.
+func (client *Client) GetExternalAddress() (net.IP, error) {
+	response, err := client.request([]byte{VERSION, OPCODE_EXTERNAL_ADDRESS}, EXTERNAL_ADDRESS_RESPONSE_SIZE)
+	if err != nil {
+		return nil, err
+	}
+
+	return net.IPv4(response[8], response[9], response[10], response[11]), nil
+}
+
+// Asks the gateway to forward the external port to the internal port of this host - the gateway is free to choose
+// a different external port (0 lets the gateway choose) and a shorter lifetime.
+func (client *Client) AddPortMapping(protocol Protocol, internalPort uint16, externalPort uint16, lifetime time.Duration) (*Mapping, error) {
+	request := make([]byte, MAPPING_REQUEST_SIZE)
+	request[0] = VERSION
+	request[1] = protocol
+	binary.BigEndian.PutUint16(request[4:6], internalPort)
+	binary.BigEndian.PutUint16(request[6:8], externalPort)
+	binary.BigEndian.PutUint32(request[8:12], uint32(lifetime/time.Second))
+
+	response, err := client.request(request, MAPPING_RESPONSE_SIZE)
+	if err != nil {
+		return nil, err
+	}
+
+	if binary.BigEndian.Uint16(response[8:10]) != internalPort {
+		return nil, ErrMalformedResponse
+	}
+
+	return &Mapping{
+		Protocol:     protocol,
+		InternalPort: internalPort,
+		ExternalPort: binary.BigEndian.Uint16(response[10:12]),
+		Lifetime:     time.Duration(binary.BigEndian.Uint32(response[12:16])) * time.Second,
+	}, nil
+}
+
+// Removes the mapping of the internal port (a mapping request with a lifetime of 0).
+func (client *Client) DeletePortMapping(protocol Protocol, internalPort uint16) error {
+	_, err := client.AddPortMapping(protocol, internalPort, 0, 0)
+
+	return err
+}
+
+// sends the request and waits for the matching response (the request is repeated with a doubled timeout if the
+// response does not arrive in time, since udp packets can get lost)
+func (client *Client) request(request []byte, responseSize int) ([]byte, error) {
+	client.mutex.Lock()
+	defer client.mutex.Unlock()
+
+	conn, err := net.DialUDP("udp", nil, client.gateway)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not connect to NAT-PMP gateway")
+	}
+	defer conn.Close()
+
+	buffer := make([]byte, responseSize)
+	timeout := client.initialTimeout
+	for attempt := 0; attempt < client.maxAttempts; attempt++ {
+		if _, err := conn.Write(request); err != nil {
+			return nil, errors.Wrap(err, "could not send NAT-PMP request")
+		}
+
+		deadline := time.Now().Add(timeout)
+		for {
+			if err := conn.SetReadDeadline(deadline); err != nil {
+				return nil, err
+			}
+
+			readBytes, err := conn.Read(buffer)
+			if err != nil {
+				if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+					break
+				}
+
+				return nil, errors.Wrap(err, "could not read NAT-PMP response")
+			}
+
+			// ignore unrelated packets (i.e. the late answer to a previous request)
+			if readBytes < 4 || buffer[0] != VERSION || buffer[1] != request[1]|OPCODE_RESPONSE_FLAG {
+				continue
+			}
+
+			if resultCode := binary.BigEndian.Uint16(buffer[2:4]); resultCode != RESULT_SUCCESS {
+				return nil, &ResultError{ResultCode: resultCode}
+			}
+
+			if readBytes < responseSize {
+				return nil, ErrMalformedResponse
+			}
+
+			return buffer, nil
+		}
+
+		timeout *= 2
+	}
+
+	return nil, ErrNoResponse
+}
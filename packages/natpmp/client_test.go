@@ -0,0 +1,167 @@
+package natpmp
+
+import (
+	"encoding/binary"
+	"net"
+	"testing"
+	"time"
+)
+
+// gatewayStandIn answers NAT-PMP requests like a router would (it maps every internal port to the internal port + 1000
+// and can be told to ignore the first requests to simulate packet loss).
+type gatewayStandIn struct {
+	conn            *net.UDPConn
+	externalAddress net.IP
+	resultCode      uint16
+	ignoredRequests int
+	mappings        map[uint16]uint16
+}
+
+func newGatewayStandIn(t *testing.T) *gatewayStandIn {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &gatewayStandIn{
+		conn:            conn,
+		externalAddress: net.IPv4(203, 0, 113, 7),
+		mappings:        make(map[uint16]uint16),
+	}
+}
+
+func (gateway *gatewayStandIn) serve(requestCount int) <-chan bool {
+	done := make(chan bool, 1)
+
+	go func() {
+		defer close(done)
+
+		buffer := make([]byte, 64)
+		for i := 0; i < requestCount; i++ {
+			readBytes, addr, err := gateway.conn.ReadFromUDP(buffer)
+			if err != nil {
+				return
+			}
+
+			if gateway.ignoredRequests > 0 {
+				gateway.ignoredRequests--
+
+				continue
+			}
+
+			if _, err := gateway.conn.WriteToUDP(gateway.answer(buffer[:readBytes]), addr); err != nil {
+				return
+			}
+		}
+	}()
+
+	return done
+}
+
+func (gateway *gatewayStandIn) answer(request []byte) []byte {
+	var response []byte
+	if request[1] == OPCODE_EXTERNAL_ADDRESS {
+		response = make([]byte, EXTERNAL_ADDRESS_RESPONSE_SIZE)
+		copy(response[8:12], gateway.externalAddress.To4())
+	} else {
+		internalPort := binary.BigEndian.Uint16(request[4:6])
+		lifetime := binary.BigEndian.Uint32(request[8:12])
+
+		if lifetime == 0 {
+			delete(gateway.mappings, internalPort)
+		} else {
+			gateway.mappings[internalPort] = internalPort + 1000
+		}
+
+		response = make([]byte, MAPPING_RESPONSE_SIZE)
+		binary.BigEndian.PutUint16(response[8:10], internalPort)
+		binary.BigEndian.PutUint16(response[10:12], gateway.mappings[internalPort])
+		binary.BigEndian.PutUint32(response[12:16], lifetime)
+	}
+
+	response[0] = VERSION
+	response[1] = request[1] | OPCODE_RESPONSE_FLAG
+	binary.BigEndian.PutUint16(response[2:4], gateway.resultCode)
+
+	return response
+}
+
+func newTestClient(t *testing.T, gateway *gatewayStandIn) *Client {
+	client, err := NewClient(gateway.conn.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.initialTimeout = 20 * time.Millisecond
+
+	return client
+}
+
+func TestClient_GetExternalAddress(t *testing.T) {
+	gateway := newGatewayStandIn(t)
+	defer gateway.conn.Close()
+
+	// the first request gets lost and has to be repeated
+	gateway.ignoredRequests = 1
+	done := gateway.serve(2)
+
+	address, err := newTestClient(t, gateway).GetExternalAddress()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !address.Equal(gateway.externalAddress) {
+		t.Error("wrong external address", address)
+	}
+
+	<-done
+}
+
+func TestClient_AddDeletePortMapping(t *testing.T) {
+	gateway := newGatewayStandIn(t)
+	defer gateway.conn.Close()
+
+	done := gateway.serve(2)
+	client := newTestClient(t, gateway)
+
+	mapping, err := client.AddPortMapping(PROTOCOL_TCP, 14626, 14626, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mapping.InternalPort != 14626 || mapping.ExternalPort != 15626 || mapping.Lifetime != time.Hour || mapping.Protocol != PROTOCOL_TCP {
+		t.Error("wrong mapping", mapping)
+	}
+
+	if err := client.DeletePortMapping(PROTOCOL_TCP, 14626); err != nil {
+		t.Fatal(err)
+	}
+
+	<-done
+
+	if len(gateway.mappings) != 0 {
+		t.Error("the mapping was not deleted")
+	}
+}
+
+func TestClient_Errors(t *testing.T) {
+	gateway := newGatewayStandIn(t)
+	defer gateway.conn.Close()
+
+	gateway.resultCode = 2
+	done := gateway.serve(1)
+
+	client := newTestClient(t, gateway)
+	if _, err := client.AddPortMapping(PROTOCOL_UDP, 14626, 14626, time.Hour); err == nil {
+		t.Error("the refused mapping was not reported")
+	} else if resultErr, ok := err.(*ResultError); !ok || resultErr.ResultCode != 2 {
+		t.Error("wrong error", err)
+	}
+	<-done
+
+	// a gateway that does not answer at all
+	gateway.ignoredRequests = DEFAULT_MAX_ATTEMPTS
+	done = gateway.serve(DEFAULT_MAX_ATTEMPTS)
+
+	if _, err := client.GetExternalAddress(); err != ErrNoResponse {
+		t.Error("the missing response was not reported", err)
+	}
+	<-done
+}
@@ -0,0 +1,36 @@
+package natpmp
+
+import "time"
+
+const (
+	// the port that NAT-PMP gateways listen on (RFC 6886)
+	DEFAULT_GATEWAY_PORT = 5351
+
+	// the time to wait for the first answer (it doubles with every retry as recommended by RFC 6886)
+	DEFAULT_INITIAL_TIMEOUT = 250 * time.Millisecond
+
+	// the amount of times a request is sent before we give up
+	DEFAULT_MAX_ATTEMPTS = 5
+
+	// the lifetime that RFC 6886 recommends for port mappings
+	DEFAULT_MAPPING_LIFETIME = 2 * time.Hour
+)
+
+const (
+	PROTOCOL_UDP = Protocol(1)
+	PROTOCOL_TCP = Protocol(2)
+)
+
+const (
+	VERSION = 0
+
+	OPCODE_EXTERNAL_ADDRESS = 0
+	OPCODE_RESPONSE_FLAG    = 128
+
+	RESULT_SUCCESS = 0
+
+	EXTERNAL_ADDRESS_REQUEST_SIZE  = 2
+	EXTERNAL_ADDRESS_RESPONSE_SIZE = 12
+	MAPPING_REQUEST_SIZE           = 12
+	MAPPING_RESPONSE_SIZE          = 16
+)
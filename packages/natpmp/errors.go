@@ -0,0 +1,22 @@
+package natpmp
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrMalformedResponse = errors.New("malformed NAT-PMP response")
+	ErrNoResponse        = errors.New("NAT-PMP gateway did not respond")
+)
+
+// ResultError is returned if the gateway answered with a result code other than RESULT_SUCCESS (i.e. 2 if the
+// gateway does not allow port mappings).
+type ResultError struct {
+	ResultCode uint16
+}
+
+func (err *ResultError) Error() string {
+	return "NAT-PMP gateway refused the request with result code " + strconv.Itoa(int(err.ResultCode))
+}
@@ -0,0 +1,16 @@
+package natpmp
+
+import "time"
+
+// Protocol is the transport protocol of a port mapping (its value is the opcode of the mapping request).
+type Protocol = byte
+
+// Mapping describes a port mapping that the gateway created.
+type Mapping struct {
+	Protocol     Protocol
+	InternalPort uint16
+	// the port that the gateway forwards to the internal port (it might differ from the requested port)
+	ExternalPort uint16
+	// the mapping needs to be renewed before its lifetime ends
+	Lifetime time.Duration
+}
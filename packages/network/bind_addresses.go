@@ -0,0 +1,22 @@
+package network
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Returns a human readable description of the addresses that a server listens on (i.e. for log messages) - it accepts
+// the same arguments as the Listen methods of the tcp and udp servers.
+func DescribeBindAddresses(port int, addresses ...string) string {
+	if len(addresses) == 0 {
+		return "port " + strconv.Itoa(port)
+	}
+
+	bindAddresses := make([]string, len(addresses))
+	for i, address := range addresses {
+		bindAddresses[i] = net.JoinHostPort(address, strconv.Itoa(port))
+	}
+
+	return strings.Join(bindAddresses, ", ")
+}
@@ -3,51 +3,93 @@ package tcp
 import (
 	"net"
 	"strconv"
+	"sync"
 
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/network"
 )
 
 type Server struct {
-	Socket net.Listener
-	Events serverEvents
+	Sockets []net.Listener
+	Events  serverEvents
+	mutex   sync.RWMutex
 }
 
 func (this *Server) Shutdown() {
-	if this.Socket != nil {
-		socket := this.Socket
-		this.Socket = nil
+	this.mutex.Lock()
+	sockets := this.Sockets
+	this.Sockets = nil
+	this.mutex.Unlock()
 
+	for _, socket := range sockets {
 		socket.Close()
 	}
 }
 
-func (this *Server) Listen(port int) *Server {
-	socket, err := net.Listen("tcp4", "0.0.0.0:"+strconv.Itoa(port))
-	if err != nil {
-		this.Events.Error.Trigger(err)
+// Listens on the given port of all passed in addresses (IPv4 or IPv6) and blocks until the server is shut down. If no
+// address is passed in, the server listens on all interfaces (dual stack).
+func (this *Server) Listen(port int, addresses ...string) *Server {
+	if len(addresses) == 0 {
+		addresses = []string{""}
+	}
+
+	sockets := make([]net.Listener, 0, len(addresses))
+	for _, address := range addresses {
+		socket, err := net.Listen("tcp", net.JoinHostPort(address, strconv.Itoa(port)))
+		if err != nil {
+			for _, openedSocket := range sockets {
+				openedSocket.Close()
+			}
+
+			this.Events.Error.Trigger(err)
+
+			return this
+		}
 
-		return this
-	} else {
-		this.Socket = socket
+		sockets = append(sockets, socket)
 	}
 
+	this.mutex.Lock()
+	this.Sockets = sockets
+	this.mutex.Unlock()
+
 	this.Events.Start.Trigger()
 	defer this.Events.Shutdown.Trigger()
 
-	for this.Socket != nil {
-		if socket, err := this.Socket.Accept(); err != nil {
-			if this.Socket != nil {
+	var wg sync.WaitGroup
+	for _, socket := range sockets {
+		wg.Add(1)
+
+		go func(socket net.Listener) {
+			defer wg.Done()
+
+			this.acceptConnections(socket)
+		}(socket)
+	}
+	wg.Wait()
+
+	return this
+}
+
+func (this *Server) IsListening() bool {
+	this.mutex.RLock()
+	defer this.mutex.RUnlock()
+
+	return this.Sockets != nil
+}
+
+func (this *Server) acceptConnections(socket net.Listener) {
+	for this.IsListening() {
+		if conn, err := socket.Accept(); err != nil {
+			if this.IsListening() {
 				this.Events.Error.Trigger(err)
 			}
 		} else {
-			peer := network.NewManagedConnection(socket)
+			peer := network.NewManagedConnection(conn)
 
 			go this.Events.Connect.Trigger(peer)
 		}
 	}
-
-	return this
 }
 
 func NewServer() *Server {
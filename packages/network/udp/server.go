@@ -3,41 +3,84 @@ package udp
 import (
 	"net"
 	"strconv"
+	"sync"
 
 	"github.com/iotaledger/goshimmer/packages/events"
 )
 
 type Server struct {
-	Socket            net.PacketConn
+	Sockets           []net.PacketConn
 	ReceiveBufferSize int
 	Events            serverEvents
+	mutex             sync.RWMutex
 }
 
 func (this *Server) Shutdown() {
-	if this.Socket != nil {
-		socket := this.Socket
-		this.Socket = nil
+	this.mutex.Lock()
+	sockets := this.Sockets
+	this.Sockets = nil
+	this.mutex.Unlock()
 
+	for _, socket := range sockets {
 		socket.Close()
 	}
 }
 
-func (this *Server) Listen(address string, port int) {
-	if socket, err := net.ListenPacket("udp", address+":"+strconv.Itoa(port)); err != nil {
-		this.Events.Error.Trigger(err)
+// Listens on the given port of all passed in addresses (IPv4 or IPv6) and blocks until the server is shut down. If no
+// address is passed in, the server listens on all interfaces (dual stack).
+func (this *Server) Listen(port int, addresses ...string) {
+	if len(addresses) == 0 {
+		addresses = []string{""}
+	}
+
+	sockets := make([]net.PacketConn, 0, len(addresses))
+	for _, address := range addresses {
+		socket, err := net.ListenPacket("udp", net.JoinHostPort(address, strconv.Itoa(port)))
+		if err != nil {
+			for _, openedSocket := range sockets {
+				openedSocket.Close()
+			}
+
+			this.Events.Error.Trigger(err)
 
-		return
-	} else {
-		this.Socket = socket
+			return
+		}
+
+		sockets = append(sockets, socket)
 	}
 
+	this.mutex.Lock()
+	this.Sockets = sockets
+	this.mutex.Unlock()
+
 	this.Events.Start.Trigger()
 	defer this.Events.Shutdown.Trigger()
 
+	var wg sync.WaitGroup
+	for _, socket := range sockets {
+		wg.Add(1)
+
+		go func(socket net.PacketConn) {
+			defer wg.Done()
+
+			this.readPackets(socket)
+		}(socket)
+	}
+	wg.Wait()
+}
+
+func (this *Server) IsListening() bool {
+	this.mutex.RLock()
+	defer this.mutex.RUnlock()
+
+	return this.Sockets != nil
+}
+
+func (this *Server) readPackets(socket net.PacketConn) {
 	buf := make([]byte, this.ReceiveBufferSize)
-	for this.Socket != nil {
-		if bytesRead, addr, err := this.Socket.ReadFrom(buf); err != nil {
-			if this.Socket != nil {
+	for this.IsListening() {
+		if bytesRead, addr, err := socket.ReadFrom(buf); err != nil {
+			if this.IsListening() {
 				this.Events.Error.Trigger(err)
 			}
 		} else {
@@ -1,9 +1,14 @@
 package node
 
+import "github.com/iotaledger/goshimmer/packages/logger"
+
 const (
-	LOG_LEVEL_FAILURE = 0
-	LOG_LEVEL_WARNING = 1
-	LOG_LEVEL_SUCCESS = 2
-	LOG_LEVEL_INFO    = 3
-	LOG_LEVEL_DEBUG   = 4
+	LOG_LEVEL_FAILURE = int(logger.LEVEL_FAILURE)
+	LOG_LEVEL_WARNING = int(logger.LEVEL_WARNING)
+	LOG_LEVEL_SUCCESS = int(logger.LEVEL_SUCCESS)
+	LOG_LEVEL_INFO    = int(logger.LEVEL_INFO)
+	LOG_LEVEL_DEBUG   = int(logger.LEVEL_DEBUG)
 )
+
+// the version of the node software (reported to the analysis server)
+const VERSION = "0.0.1"
@@ -0,0 +1,74 @@
+package node
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Orders the plugins so that every plugin comes after its dependencies - apart from that, the order of the arguments is
+// kept (the first plugin whose dependencies are satisfied is always picked next).
+func sortPlugins(plugins []*Plugin) ([]*Plugin, error) {
+	knownPlugins := make(map[*Plugin]bool, len(plugins))
+	for _, plugin := range plugins {
+		knownPlugins[plugin] = true
+	}
+
+	for _, plugin := range plugins {
+		for _, dependency := range plugin.Dependencies {
+			if !knownPlugins[dependency] {
+				return nil, errors.Wrap(ErrUnknownDependency, plugin.Name+" requires "+dependency.Name)
+			}
+		}
+	}
+
+	sortedPlugins := make([]*Plugin, 0, len(plugins))
+	sortedPluginSet := make(map[*Plugin]bool, len(plugins))
+	remainingPlugins := append([]*Plugin{}, plugins...)
+	for len(remainingPlugins) != 0 {
+		nextIndex := -1
+		for i, plugin := range remainingPlugins {
+			if dependenciesContained(plugin, sortedPluginSet) {
+				nextIndex = i
+
+				break
+			}
+		}
+
+		if nextIndex == -1 {
+			names := make([]string, len(remainingPlugins))
+			for i, plugin := range remainingPlugins {
+				names[i] = plugin.Name
+			}
+
+			return nil, errors.Wrap(ErrCyclicDependencies, strings.Join(names, ", "))
+		}
+
+		sortedPlugins = append(sortedPlugins, remainingPlugins[nextIndex])
+		sortedPluginSet[remainingPlugins[nextIndex]] = true
+		remainingPlugins = append(remainingPlugins[:nextIndex], remainingPlugins[nextIndex+1:]...)
+	}
+
+	return sortedPlugins, nil
+}
+
+// Returns an error if the plugin depends on a plugin that is not loaded.
+func checkDependencies(plugin *Plugin, loadedPlugins map[*Plugin]bool) error {
+	for _, dependency := range plugin.Dependencies {
+		if !loadedPlugins[dependency] {
+			return errors.Wrap(ErrDisabledDependency, plugin.Name+" requires "+dependency.Name)
+		}
+	}
+
+	return nil
+}
+
+func dependenciesContained(plugin *Plugin, plugins map[*Plugin]bool) bool {
+	for _, dependency := range plugin.Dependencies {
+		if !plugins[dependency] {
+			return false
+		}
+	}
+
+	return true
+}
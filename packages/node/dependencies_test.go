@@ -0,0 +1,48 @@
+package node
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+)
+
+func TestSortPlugins(t *testing.T) {
+	a := &Plugin{Name: "A"}
+	b := &Plugin{Name: "B"}
+	c := (&Plugin{Name: "C"}).DependsOn(b)
+	d := (&Plugin{Name: "D"}).DependsOn(a)
+	a.DependsOn(c)
+
+	sortedPlugins, err := sortPlugins([]*Plugin{a, b, c, d})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedOrder := []*Plugin{b, c, a, d}
+	for i, plugin := range sortedPlugins {
+		if plugin != expectedOrder[i] {
+			t.Fatalf("plugin %d: expected %s but got %s", i, expectedOrder[i].Name, plugin.Name)
+		}
+	}
+
+	if _, err := sortPlugins([]*Plugin{a, c, d}); errors.Cause(err) != ErrUnknownDependency {
+		t.Error("missing dependency was not detected", err)
+	}
+
+	b.DependsOn(d)
+	if _, err := sortPlugins([]*Plugin{a, b, c, d}); errors.Cause(err) != ErrCyclicDependencies {
+		t.Error("cyclic dependency was not detected", err)
+	}
+}
+
+func TestCheckDependencies(t *testing.T) {
+	a := &Plugin{Name: "A"}
+	b := (&Plugin{Name: "B"}).DependsOn(a)
+
+	if err := checkDependencies(b, map[*Plugin]bool{a: true}); err != nil {
+		t.Error(err)
+	}
+	if err := checkDependencies(b, map[*Plugin]bool{}); errors.Cause(err) != ErrDisabledDependency {
+		t.Error("disabled dependency was not detected", err)
+	}
+}
@@ -0,0 +1,9 @@
+package node
+
+import "github.com/pkg/errors"
+
+var (
+	ErrUnknownDependency  = errors.New("plugin depends on a plugin that is not part of the node")
+	ErrCyclicDependencies = errors.New("plugins depend on each other")
+	ErrDisabledDependency = errors.New("plugin depends on a disabled plugin")
+)
@@ -7,6 +7,8 @@ import (
 type pluginEvents struct {
 	Configure *events.Event
 	Run       *events.Event
+	// triggered in the reverse order of the run events once the node shuts down
+	Shutdown *events.Event
 }
 
 func pluginCaller(handler interface{}, params ...interface{}) {
@@ -1,7 +1,13 @@
 package node
 
-import "fmt"
+import (
+	"os"
 
+	"github.com/iotaledger/goshimmer/packages/logger"
+)
+
+// Logger adapts the structured log entries to callbacks that receive the formatted message (i.e. to show them in the
+// status screen) - the fields of an entry are appended to its message.
 type Logger struct {
 	Enabled    bool
 	LogInfo    func(pluginName string, message string)
@@ -11,32 +17,25 @@ type Logger struct {
 	LogDebug   func(pluginName string, message string)
 }
 
-func pluginPrefix(pluginName string) string {
-	var pluginPrefix string
-	if pluginName == "Node" {
-		pluginPrefix = ""
-	} else {
-		pluginPrefix = pluginName + ": "
+func (nodeLogger *Logger) Log(entry *logger.Entry) {
+	if !nodeLogger.Enabled {
+		return
 	}
 
-	return pluginPrefix
+	message := entry.Message + logger.FormatFields(entry.Fields)
+	switch entry.Level {
+	case logger.LEVEL_INFO:
+		nodeLogger.LogInfo(entry.Source, message)
+	case logger.LEVEL_SUCCESS:
+		nodeLogger.LogSuccess(entry.Source, message)
+	case logger.LEVEL_WARNING:
+		nodeLogger.LogWarning(entry.Source, message)
+	case logger.LEVEL_FAILURE:
+		nodeLogger.LogFailure(entry.Source, message)
+	case logger.LEVEL_DEBUG:
+		nodeLogger.LogDebug(entry.Source, message)
+	}
 }
 
-var DEFAULT_LOGGER = &Logger{
-	Enabled: true,
-	LogSuccess: func(pluginName string, message string) {
-		fmt.Println("[  OK  ] " + pluginPrefix(pluginName) + message)
-	},
-	LogInfo: func(pluginName string, message string) {
-		fmt.Println("[ INFO ] " + pluginPrefix(pluginName) + message)
-	},
-	LogWarning: func(pluginName string, message string) {
-		fmt.Println("[ WARN ] " + pluginPrefix(pluginName) + message)
-	},
-	LogFailure: func(pluginName string, message string) {
-		fmt.Println("[ FAIL ] " + pluginPrefix(pluginName) + message)
-	},
-	LogDebug: func(pluginName string, message string) {
-		fmt.Println("[ NOTE ] " + pluginPrefix(pluginName) + message)
-	},
-}
+// writes the log to the console (the messages of the node itself are written without a prefix)
+var DEFAULT_LOGGER = logger.NewWriterSink(os.Stdout, logger.NewConsoleEncoder("Node"))
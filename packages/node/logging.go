@@ -0,0 +1,60 @@
+package node
+
+import (
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/packages/logger"
+)
+
+var logFile *logger.RotatingFile
+
+func init() {
+	logger.SetLevel(logger.Level(LOG_LEVEL.GetValue()))
+
+	LOG_LEVEL.Events.Change.Attach(events.NewClosure(func(oldValue int, newValue int) {
+		logger.SetLevel(logger.Level(newValue))
+	}))
+
+	PLUGIN_LOG_LEVELS.Events.Change.Attach(events.NewClosure(func(oldValue []string, newValue []string) {
+		if err := applyPluginLogLevels(newValue); err != nil {
+			logger.Log("Node", logger.LEVEL_WARNING, "ignoring invalid plugin log levels", "error", err)
+		}
+	}))
+}
+
+// Applies the logging parameters once they were loaded - the log levels also follow later changes of their
+// parameters, while the format and the log file are only set up once.
+func ConfigureLogging() error {
+	logger.SetLevel(logger.Level(LOG_LEVEL.GetValue()))
+
+	if err := applyPluginLogLevels(PLUGIN_LOG_LEVELS.GetValue()); err != nil {
+		return err
+	}
+
+	encoder, err := logger.NewEncoder(*LOG_FORMAT.Value, "Node")
+	if err != nil {
+		return err
+	}
+	DEFAULT_LOGGER.SetEncoder(encoder)
+
+	if *LOG_FILE.Value != "" && logFile == nil {
+		logFile, err = logger.NewRotatingFile(*LOG_FILE.Value, int64(*LOG_FILE_MAX_SIZE.Value)*1024*1024, int(*LOG_FILE_MAX_BACKUPS.Value))
+		if err != nil {
+			return err
+		}
+
+		logger.AddSink(logger.NewWriterSink(logFile, logger.NewJSONEncoder()))
+	}
+
+	return nil
+}
+
+func applyPluginLogLevels(definitions []string) error {
+	levels, err := logger.ParseSourceLevels(definitions)
+	if err != nil {
+		return err
+	}
+
+	logger.SetSourceLevels(levels)
+
+	return nil
+}
@@ -1,15 +1,19 @@
 package node
 
 import (
+	"os"
 	"sync"
+	"time"
 
 	"github.com/iotaledger/goshimmer/packages/daemon"
+	"github.com/iotaledger/goshimmer/packages/logger"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 )
 
 type Node struct {
 	wg            *sync.WaitGroup
-	loggers       []*Logger
 	loadedPlugins []*Plugin
+	startTime     time.Time
 }
 
 var DisabledPlugins = make(map[string]bool)
@@ -17,9 +21,9 @@ var EnabledPlugins = make(map[string]bool)
 
 func New(plugins ...*Plugin) *Node {
 	node := &Node{
-		loggers:       make([]*Logger, 0),
 		wg:            &sync.WaitGroup{},
 		loadedPlugins: make([]*Plugin, 0),
+		startTime:     time.Now(),
 	}
 
 	node.AddLogger(DEFAULT_LOGGER)
@@ -48,58 +52,29 @@ func Shutdown() {
 	daemon.ShutdownAndWait()
 }
 
-func (node *Node) AddLogger(logger *Logger) {
-	node.loggers = append(node.loggers, logger)
+// Adds a sink that receives the log entries of all plugins (i.e. a Logger).
+func (node *Node) AddLogger(sink logger.Sink) {
+	logger.AddSink(sink)
 }
 
 func (node *Node) LogSuccess(pluginName string, message string) {
-	if *LOG_LEVEL.Value >= LOG_LEVEL_SUCCESS {
-		for _, logger := range node.loggers {
-			if logger.Enabled {
-				logger.LogSuccess(pluginName, message)
-			}
-		}
-	}
+	logger.Log(pluginName, logger.LEVEL_SUCCESS, message)
 }
 
 func (node *Node) LogInfo(pluginName string, message string) {
-	if *LOG_LEVEL.Value >= LOG_LEVEL_INFO {
-		for _, logger := range node.loggers {
-			if logger.Enabled {
-				logger.LogInfo(pluginName, message)
-			}
-		}
-	}
+	logger.Log(pluginName, logger.LEVEL_INFO, message)
 }
 
 func (node *Node) LogDebug(pluginName string, message string) {
-	if *LOG_LEVEL.Value >= LOG_LEVEL_DEBUG {
-		for _, logger := range node.loggers {
-			if logger.Enabled {
-				logger.LogDebug(pluginName, message)
-			}
-		}
-	}
+	logger.Log(pluginName, logger.LEVEL_DEBUG, message)
 }
 
 func (node *Node) LogWarning(pluginName string, message string) {
-	if *LOG_LEVEL.Value >= LOG_LEVEL_WARNING {
-		for _, logger := range node.loggers {
-			if logger.Enabled {
-				logger.LogWarning(pluginName, message)
-			}
-		}
-	}
+	logger.Log(pluginName, logger.LEVEL_WARNING, message)
 }
 
 func (node *Node) LogFailure(pluginName string, message string) {
-	if *LOG_LEVEL.Value >= LOG_LEVEL_FAILURE {
-		for _, logger := range node.loggers {
-			if logger.Enabled {
-				logger.LogFailure(pluginName, message)
-			}
-		}
-	}
+	logger.Log(pluginName, logger.LEVEL_FAILURE, message)
 }
 
 func isDisabled(plugin *Plugin) bool {
@@ -115,11 +90,22 @@ func isEnabled(plugin *Plugin) bool {
 }
 
 func (node *Node) configure(plugins ...*Plugin) {
-	for _, plugin := range plugins {
+	sortedPlugins, err := sortPlugins(plugins)
+	if err != nil {
+		node.exitWithError(err)
+	}
+
+	loadedPlugins := make(map[*Plugin]bool, len(sortedPlugins))
+	for _, plugin := range sortedPlugins {
 		status := plugin.Status
 		if (status == Enabled && !isDisabled(plugin)) ||
 			(status == Disabled && isEnabled(plugin)) {
 
+			if err := checkDependencies(plugin, loadedPlugins); err != nil {
+				node.exitWithError(err)
+			}
+			loadedPlugins[plugin] = true
+
 			plugin.wg = node.wg
 			plugin.Node = node
 
@@ -144,6 +130,8 @@ func (node *Node) Start() {
 
 	node.LogSuccess("Node", "Starting background workers ...")
 
+	node.runShutdownHandler()
+
 	daemon.Start()
 }
 
@@ -158,7 +146,46 @@ func (node *Node) Run() {
 
 	node.LogSuccess("Node", "Starting background workers ...")
 
+	node.runShutdownHandler()
+
 	daemon.Run()
 
 	node.LogSuccess("Node", "Shutdown complete!")
 }
+
+// Triggers the shutdown events of the loaded plugins in reverse order once the daemon shuts down, so that every plugin
+// is shut down before the plugins it depends on (this happens before the background workers get stopped).
+func (node *Node) runShutdownHandler() {
+	daemon.BackgroundWorker("Node Shutdown", func(shutdownSignal <-chan struct{}) {
+		<-shutdownSignal
+
+		for i := len(node.loadedPlugins) - 1; i >= 0; i-- {
+			plugin := node.loadedPlugins[i]
+
+			plugin.Events.Shutdown.Trigger(plugin)
+
+			node.LogInfo("Node", "Stopping Plugin: "+plugin.Name+" ... done")
+		}
+	}, shutdown.PRIORITY_PLUGINS)
+}
+
+func (node *Node) exitWithError(err error) {
+	node.LogFailure("Node", err.Error())
+
+	os.Exit(1)
+}
+
+// Returns the names of the plugins that were loaded (enabled) by the node.
+func (node *Node) GetLoadedPlugins() []string {
+	result := make([]string, len(node.loadedPlugins))
+	for i, plugin := range node.loadedPlugins {
+		result[i] = plugin.Name
+	}
+
+	return result
+}
+
+// Returns the time that passed since the node was created.
+func (node *Node) GetUptime() time.Duration {
+	return time.Since(node.startTime)
+}
@@ -3,7 +3,14 @@ package node
 import "github.com/iotaledger/goshimmer/packages/parameter"
 
 var (
-	LOG_LEVEL = parameter.AddInt("NODE/LOG_LEVEL", LOG_LEVEL_INFO, "controls the log types that are shown")
+	CONFIG_FILE = parameter.AddString("NODE/CONFIG_FILE", "", "path of a JSON, YAML or TOML configuration file (overridden by environment variables and flags)")
+
+	LOG_LEVEL            = parameter.AddInt("NODE/LOG_LEVEL", LOG_LEVEL_INFO, "controls the log types that are shown").MakeReloadable()
+	PLUGIN_LOG_LEVELS    = parameter.AddStringSlice("NODE/PLUGIN_LOG_LEVELS", nil, "log levels of individual plugins that differ from the log level (i.e. gossip=debug autopeering=warning)").MakeReloadable()
+	LOG_FORMAT           = parameter.AddString("NODE/LOG_FORMAT", "console", "format of the log on the console (console or json)")
+	LOG_FILE             = parameter.AddString("NODE/LOG_FILE", "", "path of a file that the log is written to in JSON format (empty = no log file)")
+	LOG_FILE_MAX_SIZE    = parameter.AddUint("NODE/LOG_FILE_MAX_SIZE", 100, "size in megabytes after which the log file is rotated").SetRange(1, 100000)
+	LOG_FILE_MAX_BACKUPS = parameter.AddUint("NODE/LOG_FILE_MAX_BACKUPS", 5, "amount of rotated log files that are kept").SetRange(0, 1000)
 
 	DISABLE_PLUGINS = parameter.AddString("NODE/DISABLE_PLUGINS", "", "a list of plugins that shall be disabled")
 	ENABLE_PLUGINS  = parameter.AddString("NODE/ENABLE_PLUGINS", "", "a list of plugins that shall be enabled")
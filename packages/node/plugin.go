@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/packages/logger"
 	"github.com/iotaledger/goshimmer/packages/parameter"
 )
 
@@ -14,11 +15,13 @@ const (
 )
 
 type Plugin struct {
-	Node   *Node
-	Name   string
-	Status int
-	Events pluginEvents
-	wg     *sync.WaitGroup
+	Node         *Node
+	Name         string
+	Status       int
+	Dependencies []*Plugin
+	Events       pluginEvents
+	Log          *logger.Logger
+	wg           *sync.WaitGroup
 }
 
 // Creates a new plugin with the given name, default status and callbacks.
@@ -30,7 +33,9 @@ func NewPlugin(name string, status int, callback Callback, callbacks ...Callback
 		Events: pluginEvents{
 			Configure: events.NewEvent(pluginCaller),
 			Run:       events.NewEvent(pluginCaller),
+			Shutdown:  events.NewEvent(pluginCaller),
 		},
+		Log: logger.New(name),
 	}
 
 	// make the plugin known to the parameters
@@ -50,6 +55,14 @@ func NewPlugin(name string, status int, callback Callback, callbacks ...Callback
 	return plugin
 }
 
+// Declares plugins that have to be loaded for this plugin to work - they are configured and run before this plugin and
+// shut down after it.
+func (plugin *Plugin) DependsOn(dependencies ...*Plugin) *Plugin {
+	plugin.Dependencies = append(plugin.Dependencies, dependencies...)
+
+	return plugin
+}
+
 func GetPluginIdentifier(name string) string {
 	return strings.ToLower(strings.Replace(name, " ", "", -1))
 }
@@ -0,0 +1,310 @@
+package parameter
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Loads the parameter values from a JSON, YAML or TOML file (the format is derived from the file extension).
+//
+// The parameters can either be nested by their group ({"gossip": {"port": 14666}}) or use their full name
+// ({"GOSSIP/PORT": 14666}). Keys are case insensitive and ignore underscores and dashes, so "node/disablePlugins"
+// matches NODE/DISABLE_PLUGINS. String parameters also accept lists, which get joined by spaces. All keys that do not
+// belong to a parameter are reported by an *UnknownKeysError.
+func LoadConfigFile(path string) error {
+	config, err := readConfigFile(path)
+	if err != nil {
+		return err
+	}
+
+	return LoadConfig(config)
+}
+
+// Applies the values of an already parsed configuration (see LoadConfigFile).
+func LoadConfig(config map[string]interface{}) error {
+	return loadConfig(config, nil)
+}
+
+// Loads the parameter values from the environment - the variables are named after the parameters with the given
+// prefix (i.e. GOSSIP/PORT is read from GOSHIMMER_GOSSIP_PORT for the prefix GOSHIMMER).
+func LoadEnvironment(prefix string) error {
+	return loadEnvironment(prefix, nil)
+}
+
+// Returns the name of the environment variable of the parameter.
+func GetEnvironmentVariableName(prefix string, name string) string {
+	return prefix + "_" + strings.NewReplacer("/", "_", "-", "_").Replace(strings.ToUpper(name))
+}
+
+// Sets the value of the parameter with the given name - the value is converted to the type of the parameter (strings
+// are parsed) and validated against its limits.
+func SetValue(name string, value interface{}) error {
+	if parameter, exists := boolParameters[name]; exists {
+		convertedValue, err := toBool(value)
+		if err != nil {
+			return err
+		}
+		parameter.SetValue(convertedValue)
+
+		return nil
+	}
+
+	if parameter, exists := intParameters[name]; exists {
+		convertedValue, err := toInt(value)
+		if err != nil {
+			return err
+		}
+		parameter.SetValue(convertedValue)
+
+		return nil
+	}
+
+	if parameter, exists := stringParameters[name]; exists {
+		convertedValue, err := toString(value)
+		if err != nil {
+			return err
+		}
+		parameter.SetValue(convertedValue)
+
+		return nil
+	}
+
+	if parameter, exists := stringSliceParameters[name]; exists {
+		if elements, isList := value.([]interface{}); isList {
+			convertedValue := make([]string, len(elements))
+			for i, element := range elements {
+				convertedValue[i] = fmt.Sprint(element)
+			}
+
+			return parameter.SetValue(convertedValue)
+		}
+
+		return setFromScalar(parameter, value)
+	}
+
+	if parameter, exists := durationParameters[name]; exists {
+		return setFromScalar(parameter, value)
+	}
+
+	if parameter, exists := floatParameters[name]; exists {
+		return setFromScalar(parameter, value)
+	}
+
+	if parameter, exists := uintParameters[name]; exists {
+		return setFromScalar(parameter, value)
+	}
+
+	return newUnknownKeysError([]string{name})
+}
+
+func readConfigFile(path string) (map[string]interface{}, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config := make(map[string]interface{})
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(data, &config)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &config)
+	case ".toml":
+		err = toml.Unmarshal(data, &config)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse "+path)
+	}
+
+	return config, nil
+}
+
+// applies the values of the parameters that are accepted by the filter (nil = all parameters)
+func loadConfig(config map[string]interface{}, filter func(name string) bool) error {
+	values := make(map[string]interface{})
+	flattenConfig("", config, values)
+
+	parameterNames := getNormalizedParameterNames()
+
+	unknownKeys := make([]string, 0)
+	for key, value := range values {
+		name, exists := parameterNames[normalizeKey(key)]
+		if !exists {
+			unknownKeys = append(unknownKeys, key)
+
+			continue
+		}
+
+		if filter != nil && !filter(name) {
+			continue
+		}
+
+		if err := SetValue(name, value); err != nil {
+			return errors.Wrap(err, key)
+		}
+	}
+
+	if len(unknownKeys) != 0 {
+		return newUnknownKeysError(unknownKeys)
+	}
+
+	return nil
+}
+
+func loadEnvironment(prefix string, filter func(name string) bool) error {
+	for _, name := range getNormalizedParameterNames() {
+		if filter != nil && !filter(name) {
+			continue
+		}
+
+		variableName := GetEnvironmentVariableName(prefix, name)
+		if value, exists := os.LookupEnv(variableName); exists {
+			if err := SetValue(name, value); err != nil {
+				return errors.Wrap(err, variableName)
+			}
+		}
+	}
+
+	return nil
+}
+
+// passes the value to the parser of the parameter (numbers of config files are formatted without exponents first)
+func setFromScalar(parameter flag.Value, value interface{}) error {
+	switch typedValue := value.(type) {
+	case string:
+		return parameter.Set(typedValue)
+	case float64:
+		return parameter.Set(formatFloat(typedValue))
+	case int, int64, uint64, bool:
+		return parameter.Set(fmt.Sprint(typedValue))
+	default:
+		return errors.Wrap(ErrInvalidValue, fmt.Sprintf("unsupported value %v", value))
+	}
+}
+
+// nested maps are flattened into keys that are separated by "/"
+func flattenConfig(prefix string, config interface{}, result map[string]interface{}) {
+	switch typedConfig := config.(type) {
+	case map[string]interface{}:
+		for key, value := range typedConfig {
+			flattenConfig(joinKey(prefix, key), value, result)
+		}
+	case map[interface{}]interface{}:
+		for key, value := range typedConfig {
+			flattenConfig(joinKey(prefix, fmt.Sprint(key)), value, result)
+		}
+	default:
+		result[prefix] = config
+	}
+}
+
+func joinKey(prefix string, key string) string {
+	if prefix == "" {
+		return key
+	}
+
+	return prefix + "/" + key
+}
+
+func normalizeKey(key string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+}
+
+func getNormalizedParameterNames() map[string]string {
+	result := make(map[string]string)
+	for name := range boolParameters {
+		result[normalizeKey(name)] = name
+	}
+	for name := range intParameters {
+		result[normalizeKey(name)] = name
+	}
+	for name := range stringParameters {
+		result[normalizeKey(name)] = name
+	}
+	for name := range stringSliceParameters {
+		result[normalizeKey(name)] = name
+	}
+	for name := range durationParameters {
+		result[normalizeKey(name)] = name
+	}
+	for name := range floatParameters {
+		result[normalizeKey(name)] = name
+	}
+	for name := range uintParameters {
+		result[normalizeKey(name)] = name
+	}
+
+	return result
+}
+
+func toBool(value interface{}) (bool, error) {
+	switch typedValue := value.(type) {
+	case bool:
+		return typedValue, nil
+	case string:
+		result, err := strconv.ParseBool(typedValue)
+		if err != nil {
+			return false, errors.Wrap(ErrInvalidValue, "expected a boolean but got "+typedValue)
+		}
+
+		return result, nil
+	default:
+		return false, errors.Wrap(ErrInvalidValue, fmt.Sprintf("expected a boolean but got %v", value))
+	}
+}
+
+func toInt(value interface{}) (int, error) {
+	switch typedValue := value.(type) {
+	case int:
+		return typedValue, nil
+	case int64:
+		return int(typedValue), nil
+	case float64:
+		// JSON numbers are always floats
+		if typedValue != math.Trunc(typedValue) {
+			return 0, errors.Wrap(ErrInvalidValue, fmt.Sprintf("expected an integer but got %v", value))
+		}
+
+		return int(typedValue), nil
+	case string:
+		result, err := strconv.Atoi(typedValue)
+		if err != nil {
+			return 0, errors.Wrap(ErrInvalidValue, "expected an integer but got "+typedValue)
+		}
+
+		return result, nil
+	default:
+		return 0, errors.Wrap(ErrInvalidValue, fmt.Sprintf("expected an integer but got %v", value))
+	}
+}
+
+func toString(value interface{}) (string, error) {
+	switch typedValue := value.(type) {
+	case string:
+		return typedValue, nil
+	case []interface{}:
+		elements := make([]string, len(typedValue))
+		for i, element := range typedValue {
+			elements[i] = fmt.Sprint(element)
+		}
+
+		return strings.Join(elements, " "), nil
+	case bool, int, int64, float64:
+		return fmt.Sprint(typedValue), nil
+	default:
+		return "", errors.Wrap(ErrInvalidValue, fmt.Sprintf("expected a string or a list but got %v", value))
+	}
+}
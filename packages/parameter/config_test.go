@@ -0,0 +1,157 @@
+package parameter
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/pkg/errors"
+)
+
+var (
+	testPort    = AddInt("TEST/PORT", 1, "")
+	testEnabled = AddBool("TEST/ENABLED", false, "")
+	testPlugins = AddString("TEST/DISABLE_PLUGINS", "", "")
+	testAddress = AddString("TEST/SERVER-ADDRESS", "", "")
+)
+
+func resetTestParameters() {
+	*testPort.Value = testPort.DefaultValue
+	*testEnabled.Value = testEnabled.DefaultValue
+	*testPlugins.Value = testPlugins.DefaultValue
+	*testAddress.Value = testAddress.DefaultValue
+}
+
+func writeConfigFile(t *testing.T, name string, content string) string {
+	directory, err := ioutil.TempDir("", "parameter")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(directory, name)
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	configs := map[string]string{
+		"config.json": `{"test": {"port": 14666, "enabled": true, "disablePlugins": ["statusscreen", "zeromq"]}, "TEST/SERVER-ADDRESS": "localhost"}`,
+		"config.yaml": "test:\n  port: 14666\n  enabled: true\n  disable_plugins:\n    - statusscreen\n    - zeromq\n  server_address: localhost\n",
+		"config.toml": "[test]\nport = 14666\nenabled = true\ndisable_plugins = [\"statusscreen\", \"zeromq\"]\nserver-address = \"localhost\"\n",
+	}
+
+	for name, content := range configs {
+		resetTestParameters()
+
+		path := writeConfigFile(t, name, content)
+		defer os.RemoveAll(filepath.Dir(path))
+
+		if err := LoadConfigFile(path); err != nil {
+			t.Fatal(name, err)
+		}
+
+		if *testPort.Value != 14666 || !*testEnabled.Value || *testPlugins.Value != "statusscreen zeromq" || *testAddress.Value != "localhost" {
+			t.Error(name, "was not applied:", *testPort.Value, *testEnabled.Value, *testPlugins.Value, *testAddress.Value)
+		}
+	}
+}
+
+func TestLoadConfig_UnknownKeys(t *testing.T) {
+	resetTestParameters()
+
+	err := LoadConfig(map[string]interface{}{
+		"test":    map[string]interface{}{"port": 2.0, "prot": 3.0},
+		"unknown": "value",
+	})
+
+	unknownKeysError, ok := err.(*UnknownKeysError)
+	if !ok {
+		t.Fatal("expected an *UnknownKeysError but got", err)
+	}
+	if len(unknownKeysError.Keys) != 2 || unknownKeysError.Keys[0] != "test/prot" || unknownKeysError.Keys[1] != "unknown" {
+		t.Error("wrong unknown keys:", unknownKeysError.Keys)
+	}
+	if *testPort.Value != 2 {
+		t.Error("the known keys should still be applied")
+	}
+
+	if err := LoadConfig(map[string]interface{}{"test": map[string]interface{}{"port": 1.5}}); err == nil {
+		t.Error("a fractional value for an int parameter should be rejected")
+	}
+}
+
+func TestLoadEnvironment(t *testing.T) {
+	resetTestParameters()
+
+	os.Setenv("GOSHIMMER_TEST_PORT", "15600")
+	os.Setenv("GOSHIMMER_TEST_SERVER_ADDRESS", "example.com")
+	defer os.Unsetenv("GOSHIMMER_TEST_PORT")
+	defer os.Unsetenv("GOSHIMMER_TEST_SERVER_ADDRESS")
+
+	if err := LoadEnvironment("GOSHIMMER"); err != nil {
+		t.Fatal(err)
+	}
+	if *testPort.Value != 15600 || *testAddress.Value != "example.com" {
+		t.Error("the environment was not applied:", *testPort.Value, *testAddress.Value)
+	}
+
+	os.Setenv("GOSHIMMER_TEST_ENABLED", "maybe")
+	defer os.Unsetenv("GOSHIMMER_TEST_ENABLED")
+
+	if err := LoadEnvironment("GOSHIMMER"); err == nil {
+		t.Error("an invalid boolean should be rejected")
+	}
+}
+
+func TestReloadConfigFile(t *testing.T) {
+	resetTestParameters()
+
+	testRate := AddUint("TEST/RATE", 10, "").SetRange(1, 100).MakeReloadable()
+	testLevel := AddInt("TEST/LEVEL", 1, "").MakeReloadable()
+
+	changes := 0
+	testRate.Events.Change.Attach(events.NewClosure(func(oldValue uint64, newValue uint64) {
+		if oldValue != 10 || newValue != 50 {
+			t.Error("unexpected change from", oldValue, "to", newValue)
+		}
+
+		changes++
+	}))
+
+	path := writeConfigFile(t, "config.json", `{"test": {"port": 14666, "rate": 50, "level": 3}}`)
+	defer os.RemoveAll(filepath.Dir(path))
+
+	// parameters that were i.e. passed as flags are excluded by the filter
+	if err := ReloadConfigFile(path, func(name string) bool { return name != testLevel.Name }); err != nil {
+		t.Fatal(err)
+	}
+
+	if *testPort.Value != testPort.DefaultValue {
+		t.Error("a parameter that is not reloadable was changed")
+	}
+	if *testLevel.Value != 1 {
+		t.Error("a filtered parameter was changed")
+	}
+	if *testRate.Value != 50 || changes != 1 {
+		t.Error("the reloadable parameter was not changed:", *testRate.Value, changes)
+	}
+
+	if err := SetReloadableValue("test/rate", "500"); errors.Cause(err) != ErrValueOutOfRange {
+		t.Error("expected an out of range error but got", err)
+	}
+	if err := SetReloadableValue("test/port", "1"); errors.Cause(err) != ErrNotReloadable {
+		t.Error("expected a not reloadable error but got", err)
+	}
+	if err := SetReloadableValue("test/level", "4"); err != nil || *testLevel.Value != 4 {
+		t.Error("failed to change the reloadable parameter:", err)
+	}
+
+	if values := GetReloadableValues(); values["TEST/RATE"] != "50" || values["TEST/LEVEL"] != "4" || len(values) != 2 {
+		t.Error("unexpected reloadable values", values)
+	}
+}
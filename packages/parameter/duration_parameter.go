@@ -0,0 +1,139 @@
+package parameter
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/pkg/errors"
+)
+
+type DurationParameter struct {
+	Name         string
+	Value        *time.Duration
+	DefaultValue time.Duration
+	Description  string
+	MinValue     time.Duration
+	MaxValue     time.Duration
+	Reloadable   bool
+	Events       DurationParameterEvents
+	limited      bool
+	valueMutex   sync.RWMutex
+}
+
+type DurationParameterEvents struct {
+	// func(oldValue time.Duration, newValue time.Duration)
+	Change *events.Event
+}
+
+var durationParameters = make(map[string]*DurationParameter)
+
+func AddDuration(name string, defaultValue time.Duration, description string) *DurationParameter {
+	if _, exists := durationParameters[name]; exists {
+		panic("duplicate parameter - \"" + name + "\" was defined already")
+	}
+
+	newParameter := &DurationParameter{
+		Name:         name,
+		DefaultValue: defaultValue,
+		Value:        &defaultValue,
+		Description:  description,
+		Events: DurationParameterEvents{
+			Change: events.NewEvent(durationChangeCaller),
+		},
+	}
+
+	durationParameters[name] = newParameter
+
+	Events.AddDuration.Trigger(newParameter)
+
+	return newParameter
+}
+
+func GetDuration(name string) *DurationParameter {
+	return durationParameters[name]
+}
+
+func GetDurations() map[string]*DurationParameter {
+	return durationParameters
+}
+
+// Limits the values of the parameter (it panics if the current value lies outside of the limits).
+func (parameter *DurationParameter) SetRange(minValue time.Duration, maxValue time.Duration) *DurationParameter {
+	parameter.MinValue = minValue
+	parameter.MaxValue = maxValue
+	parameter.limited = true
+
+	if err := parameter.validate(*parameter.Value); err != nil {
+		panic(err)
+	}
+
+	return parameter
+}
+
+// Allows the parameter to be changed while the node is running (i.e. by reloading the configuration).
+func (parameter *DurationParameter) MakeReloadable() *DurationParameter {
+	parameter.Reloadable = true
+
+	return parameter
+}
+
+func (parameter *DurationParameter) SetValue(value time.Duration) error {
+	if err := parameter.validate(value); err != nil {
+		return err
+	}
+
+	parameter.valueMutex.Lock()
+	oldValue := *parameter.Value
+	*parameter.Value = value
+	parameter.valueMutex.Unlock()
+
+	if oldValue != value {
+		parameter.Events.Change.Trigger(oldValue, value)
+	}
+
+	return nil
+}
+
+// Returns the current value of the parameter (it can be read safely while the parameter is reloaded).
+func (parameter *DurationParameter) GetValue() time.Duration {
+	parameter.valueMutex.RLock()
+	defer parameter.valueMutex.RUnlock()
+
+	return *parameter.Value
+}
+
+// Parses the value (i.e. "1m30s" - plain numbers are interpreted as seconds) and sets it (implements flag.Value).
+func (parameter *DurationParameter) Set(value string) error {
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return parameter.SetValue(time.Duration(seconds) * time.Second)
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return errors.Wrap(ErrInvalidValue, "expected a duration but got "+value)
+	}
+
+	return parameter.SetValue(duration)
+}
+
+func (parameter *DurationParameter) String() string {
+	if parameter.Value == nil {
+		return ""
+	}
+
+	return parameter.GetValue().String()
+}
+
+func (parameter *DurationParameter) validate(value time.Duration) error {
+	if parameter.limited && (value < parameter.MinValue || value > parameter.MaxValue) {
+		return errors.Wrap(ErrValueOutOfRange, parameter.Name+" must lie between "+parameter.MinValue.String()+" and "+parameter.MaxValue.String()+" but was "+value.String())
+	}
+
+	return nil
+}
+
+func durationChangeCaller(handler interface{}, params ...interface{}) {
+	handler.(func(time.Duration, time.Duration))(params[0].(time.Duration), params[1].(time.Duration))
+}
@@ -0,0 +1,30 @@
+package parameter
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported configuration file format (expected .json, .yaml, .yml or .toml)")
+	ErrInvalidValue      = errors.New("invalid parameter value")
+	ErrValueOutOfRange   = errors.New("parameter value out of range")
+	ErrNotReloadable     = errors.New("parameter can not be changed while the node is running")
+)
+
+// UnknownKeysError lists all keys of a configuration that do not belong to a known parameter.
+type UnknownKeysError struct {
+	Keys []string
+}
+
+func newUnknownKeysError(keys []string) *UnknownKeysError {
+	sort.Strings(keys)
+
+	return &UnknownKeysError{Keys: keys}
+}
+
+func (err *UnknownKeysError) Error() string {
+	return "unknown configuration keys: " + strings.Join(err.Keys, ", ")
+}
@@ -5,15 +5,23 @@ import (
 )
 
 var Events = struct {
-	AddBool   *events.Event
-	AddInt    *events.Event
-	AddString *events.Event
-	AddPlugin *events.Event
+	AddBool        *events.Event
+	AddInt         *events.Event
+	AddString      *events.Event
+	AddDuration    *events.Event
+	AddStringSlice *events.Event
+	AddFloat       *events.Event
+	AddUint        *events.Event
+	AddPlugin      *events.Event
 }{
-	AddBool:   events.NewEvent(boolParameterCaller),
-	AddInt:    events.NewEvent(intParameterCaller),
-	AddString: events.NewEvent(stringParameterCaller),
-	AddPlugin: events.NewEvent(pluginParameterCaller),
+	AddBool:        events.NewEvent(boolParameterCaller),
+	AddInt:         events.NewEvent(intParameterCaller),
+	AddString:      events.NewEvent(stringParameterCaller),
+	AddDuration:    events.NewEvent(durationParameterCaller),
+	AddStringSlice: events.NewEvent(stringSliceParameterCaller),
+	AddFloat:       events.NewEvent(floatParameterCaller),
+	AddUint:        events.NewEvent(uintParameterCaller),
+	AddPlugin:      events.NewEvent(pluginParameterCaller),
 }
 
 func boolParameterCaller(handler interface{}, params ...interface{}) {
@@ -28,6 +36,34 @@ func stringParameterCaller(handler interface{}, params ...interface{}) {
 	handler.(func(*StringParameter))(params[0].(*StringParameter))
 }
 
+func durationParameterCaller(handler interface{}, params ...interface{}) {
+	handler.(func(*DurationParameter))(params[0].(*DurationParameter))
+}
+
+func stringSliceParameterCaller(handler interface{}, params ...interface{}) {
+	handler.(func(*StringSliceParameter))(params[0].(*StringSliceParameter))
+}
+
+func floatParameterCaller(handler interface{}, params ...interface{}) {
+	handler.(func(*FloatParameter))(params[0].(*FloatParameter))
+}
+
+func uintParameterCaller(handler interface{}, params ...interface{}) {
+	handler.(func(*UintParameter))(params[0].(*UintParameter))
+}
+
 func pluginParameterCaller(handler interface{}, params ...interface{}) {
 	handler.(func(string, int))(params[0].(string), params[1].(int))
 }
+
+func boolChangeCaller(handler interface{}, params ...interface{}) {
+	handler.(func(bool, bool))(params[0].(bool), params[1].(bool))
+}
+
+func intChangeCaller(handler interface{}, params ...interface{}) {
+	handler.(func(int, int))(params[0].(int), params[1].(int))
+}
+
+func stringChangeCaller(handler interface{}, params ...interface{}) {
+	handler.(func(string, string))(params[0].(string), params[1].(string))
+}
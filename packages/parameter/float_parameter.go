@@ -0,0 +1,143 @@
+package parameter
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/pkg/errors"
+)
+
+type FloatParameter struct {
+	Name         string
+	Value        *float64
+	DefaultValue float64
+	Description  string
+	MinValue     float64
+	MaxValue     float64
+	Reloadable   bool
+	Events       FloatParameterEvents
+	limited      bool
+	valueMutex   sync.RWMutex
+}
+
+type FloatParameterEvents struct {
+	// func(oldValue float64, newValue float64)
+	Change *events.Event
+}
+
+var floatParameters = make(map[string]*FloatParameter)
+
+func AddFloat(name string, defaultValue float64, description string) *FloatParameter {
+	if _, exists := floatParameters[name]; exists {
+		panic("duplicate parameter - \"" + name + "\" was defined already")
+	}
+
+	newParameter := &FloatParameter{
+		Name:         name,
+		DefaultValue: defaultValue,
+		Value:        &defaultValue,
+		Description:  description,
+		Events: FloatParameterEvents{
+			Change: events.NewEvent(floatChangeCaller),
+		},
+	}
+
+	floatParameters[name] = newParameter
+
+	Events.AddFloat.Trigger(newParameter)
+
+	return newParameter
+}
+
+func GetFloat(name string) *FloatParameter {
+	return floatParameters[name]
+}
+
+func GetFloats() map[string]*FloatParameter {
+	return floatParameters
+}
+
+// Limits the values of the parameter (it panics if the current value lies outside of the limits).
+func (parameter *FloatParameter) SetRange(minValue float64, maxValue float64) *FloatParameter {
+	parameter.MinValue = minValue
+	parameter.MaxValue = maxValue
+	parameter.limited = true
+
+	if err := parameter.validate(*parameter.Value); err != nil {
+		panic(err)
+	}
+
+	return parameter
+}
+
+// Allows the parameter to be changed while the node is running (i.e. by reloading the configuration).
+func (parameter *FloatParameter) MakeReloadable() *FloatParameter {
+	parameter.Reloadable = true
+
+	return parameter
+}
+
+func (parameter *FloatParameter) SetValue(value float64) error {
+	if err := parameter.validate(value); err != nil {
+		return err
+	}
+
+	parameter.valueMutex.Lock()
+	oldValue := *parameter.Value
+	*parameter.Value = value
+	parameter.valueMutex.Unlock()
+
+	if oldValue != value {
+		parameter.Events.Change.Trigger(oldValue, value)
+	}
+
+	return nil
+}
+
+// Returns the current value of the parameter (it can be read safely while the parameter is reloaded).
+func (parameter *FloatParameter) GetValue() float64 {
+	parameter.valueMutex.RLock()
+	defer parameter.valueMutex.RUnlock()
+
+	return *parameter.Value
+}
+
+// Parses the value and sets it (implements flag.Value).
+func (parameter *FloatParameter) Set(value string) error {
+	parsedValue, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return errors.Wrap(ErrInvalidValue, "expected a number but got "+value)
+	}
+
+	return parameter.SetValue(parsedValue)
+}
+
+func (parameter *FloatParameter) String() string {
+	if parameter.Value == nil {
+		return ""
+	}
+
+	return formatFloat(parameter.GetValue())
+}
+
+func (parameter *FloatParameter) validate(value float64) error {
+	// NaN is not comparable and would pass any range check
+	if value != value {
+		return errors.Wrap(ErrInvalidValue, parameter.Name+" must be a number")
+	}
+
+	if parameter.limited && (value < parameter.MinValue || value > parameter.MaxValue) {
+		return errors.Wrap(ErrValueOutOfRange, parameter.Name+" must lie between "+formatFloat(parameter.MinValue)+" and "+formatFloat(parameter.MaxValue)+" but was "+formatFloat(value))
+	}
+
+	return nil
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func floatChangeCaller(handler interface{}, params ...interface{}) {
+	handler.(func(float64, float64))(params[0].(float64), params[1].(float64))
+}
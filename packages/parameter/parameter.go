@@ -1,5 +1,9 @@
 package parameter
 
+import (
+	"github.com/iotaledger/goshimmer/packages/events"
+)
+
 var boolParameters = make(map[string]*BoolParameter)
 
 func AddBool(name string, defaultValue bool, description string) *BoolParameter {
@@ -12,6 +16,9 @@ func AddBool(name string, defaultValue bool, description string) *BoolParameter
 		DefaultValue: defaultValue,
 		Value:        &defaultValue,
 		Description:  description,
+		Events: BoolParameterEvents{
+			Change: events.NewEvent(boolChangeCaller),
+		},
 	}
 
 	boolParameters[name] = newParameter
@@ -29,6 +36,32 @@ func GetBools() map[string]*BoolParameter {
 	return boolParameters
 }
 
+// Returns the current value of the parameter (it can be read safely while the parameter is reloaded).
+func (parameter *BoolParameter) GetValue() bool {
+	parameter.valueMutex.RLock()
+	defer parameter.valueMutex.RUnlock()
+
+	return *parameter.Value
+}
+
+func (parameter *BoolParameter) SetValue(value bool) {
+	parameter.valueMutex.Lock()
+	oldValue := *parameter.Value
+	*parameter.Value = value
+	parameter.valueMutex.Unlock()
+
+	if oldValue != value {
+		parameter.Events.Change.Trigger(oldValue, value)
+	}
+}
+
+// Allows the parameter to be changed while the node is running (i.e. by reloading the configuration).
+func (parameter *BoolParameter) MakeReloadable() *BoolParameter {
+	parameter.Reloadable = true
+
+	return parameter
+}
+
 var intParameters = make(map[string]*IntParameter)
 
 func AddInt(name string, defaultValue int, description string) *IntParameter {
@@ -41,6 +74,9 @@ func AddInt(name string, defaultValue int, description string) *IntParameter {
 		DefaultValue: defaultValue,
 		Value:        &defaultValue,
 		Description:  description,
+		Events: IntParameterEvents{
+			Change: events.NewEvent(intChangeCaller),
+		},
 	}
 
 	intParameters[name] = newParameter
@@ -58,6 +94,32 @@ func GetInts() map[string]*IntParameter {
 	return intParameters
 }
 
+// Returns the current value of the parameter (it can be read safely while the parameter is reloaded).
+func (parameter *IntParameter) GetValue() int {
+	parameter.valueMutex.RLock()
+	defer parameter.valueMutex.RUnlock()
+
+	return *parameter.Value
+}
+
+func (parameter *IntParameter) SetValue(value int) {
+	parameter.valueMutex.Lock()
+	oldValue := *parameter.Value
+	*parameter.Value = value
+	parameter.valueMutex.Unlock()
+
+	if oldValue != value {
+		parameter.Events.Change.Trigger(oldValue, value)
+	}
+}
+
+// Allows the parameter to be changed while the node is running (i.e. by reloading the configuration).
+func (parameter *IntParameter) MakeReloadable() *IntParameter {
+	parameter.Reloadable = true
+
+	return parameter
+}
+
 var stringParameters = make(map[string]*StringParameter)
 
 func AddString(name string, defaultValue string, description string) *StringParameter {
@@ -70,6 +132,9 @@ func AddString(name string, defaultValue string, description string) *StringPara
 		DefaultValue: defaultValue,
 		Value:        &defaultValue,
 		Description:  description,
+		Events: StringParameterEvents{
+			Change: events.NewEvent(stringChangeCaller),
+		},
 	}
 
 	stringParameters[name] = newParameter
@@ -87,6 +152,32 @@ func GetStrings() map[string]*StringParameter {
 	return stringParameters
 }
 
+// Returns the current value of the parameter (it can be read safely while the parameter is reloaded).
+func (parameter *StringParameter) GetValue() string {
+	parameter.valueMutex.RLock()
+	defer parameter.valueMutex.RUnlock()
+
+	return *parameter.Value
+}
+
+func (parameter *StringParameter) SetValue(value string) {
+	parameter.valueMutex.Lock()
+	oldValue := *parameter.Value
+	*parameter.Value = value
+	parameter.valueMutex.Unlock()
+
+	if oldValue != value {
+		parameter.Events.Change.Trigger(oldValue, value)
+	}
+}
+
+// Allows the parameter to be changed while the node is running (i.e. by reloading the configuration).
+func (parameter *StringParameter) MakeReloadable() *StringParameter {
+	parameter.Reloadable = true
+
+	return parameter
+}
+
 var plugins = make(map[string]int)
 
 func AddPlugin(name string, status int) {
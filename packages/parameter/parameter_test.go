@@ -0,0 +1,121 @@
+package parameter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/events"
+)
+
+func TestDurationParameter(t *testing.T) {
+	timeout := AddDuration("TEST/TIMEOUT", 10*time.Second, "").SetRange(time.Second, time.Minute)
+
+	var changes []time.Duration
+	timeout.Events.Change.Attach(events.NewClosure(func(oldValue time.Duration, newValue time.Duration) {
+		changes = append(changes, oldValue, newValue)
+	}))
+
+	if err := timeout.Set("30"); err != nil || *timeout.Value != 30*time.Second {
+		t.Error("plain numbers should be interpreted as seconds:", *timeout.Value, err)
+	}
+	if err := timeout.Set("1m"); err != nil || *timeout.Value != time.Minute {
+		t.Error("durations should be parsed:", *timeout.Value, err)
+	}
+	if err := timeout.Set("2m"); err == nil || *timeout.Value != time.Minute {
+		t.Error("values above the maximum should be rejected:", *timeout.Value)
+	}
+	if err := timeout.Set("1m"); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(changes) != 4 || changes[0] != 10*time.Second || changes[3] != time.Minute {
+		t.Error("expected two change events (and none for rejected or unchanged values) but got", changes)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("a default value outside of the range should panic")
+		}
+	}()
+	AddDuration("TEST/INVALID_TIMEOUT", time.Hour, "").SetRange(time.Second, time.Minute)
+}
+
+func TestUintParameter(t *testing.T) {
+	workerCount := AddUint("TEST/WORKER_COUNT", 5000, "").SetRange(1, 10000)
+
+	if err := workerCount.Set("-1"); err == nil {
+		t.Error("negative values should be rejected")
+	}
+	if err := workerCount.SetValue(0); err == nil {
+		t.Error("values below the minimum should be rejected")
+	}
+	if err := LoadConfig(map[string]interface{}{"test": map[string]interface{}{"workerCount": 100.0}}); err != nil || *workerCount.Value != 100 {
+		t.Error("the config value was not applied:", *workerCount.Value, err)
+	}
+}
+
+func TestFloatParameter(t *testing.T) {
+	share := AddFloat("TEST/SHARE", 0.5, "").SetRange(0, 1)
+
+	if err := share.Set("0.25"); err != nil || *share.Value != 0.25 {
+		t.Error("the value was not parsed:", *share.Value, err)
+	}
+	if err := share.Set("NaN"); err == nil {
+		t.Error("NaN should be rejected")
+	}
+	if err := share.Set("1.5"); err == nil {
+		t.Error("values above the maximum should be rejected")
+	}
+}
+
+func TestStringSliceParameter(t *testing.T) {
+	entryNodes := AddStringSlice("TEST/ENTRY_NODES", []string{"a"}, "")
+
+	changeCount := 0
+	entryNodes.Events.Change.Attach(events.NewClosure(func(oldValue []string, newValue []string) {
+		changeCount++
+	}))
+
+	if err := entryNodes.Set("a, b c"); err != nil || entryNodes.String() != "a,b,c" {
+		t.Error("the value should be split at commas and whitespace:", entryNodes.String(), err)
+	}
+	if err := LoadConfig(map[string]interface{}{"TEST/ENTRY_NODES": []interface{}{"a", "b", "c"}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadConfig(map[string]interface{}{"TEST/ENTRY_NODES": []interface{}{"d"}}); err != nil || entryNodes.String() != "d" {
+		t.Error("lists in the config should replace the value:", entryNodes.String(), err)
+	}
+
+	if changeCount != 2 {
+		t.Error("expected 2 change events but got", changeCount)
+	}
+
+	if len(entryNodes.DefaultValue) != 1 || entryNodes.DefaultValue[0] != "a" {
+		t.Error("the default value should not be modified:", entryNodes.DefaultValue)
+	}
+}
+
+func TestGetValue_ConcurrentReload(t *testing.T) {
+	limit := AddUint("TEST/CONCURRENT_LIMIT", 0, "").MakeReloadable()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		for i := uint64(1); i <= 1000; i++ {
+			if err := limit.SetValue(i); err != nil {
+				t.Error(err)
+			}
+		}
+	}()
+
+	for lastValue := uint64(0); lastValue != 1000; {
+		value := limit.GetValue()
+		if value < lastValue {
+			t.Fatal("value went backwards:", lastValue, value)
+		}
+		lastValue = value
+	}
+
+	<-done
+}
@@ -0,0 +1,113 @@
+package parameter
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// Applies the values of a configuration file (see LoadConfigFile) to the reloadable parameters that are accepted by the
+// filter (nil = all of them). The values of all other parameters are ignored, so the file that configured the node at
+// startup can be reloaded as a whole.
+func ReloadConfigFile(path string, filter func(name string) bool) error {
+	config, err := readConfigFile(path)
+	if err != nil {
+		return err
+	}
+
+	return loadConfig(config, reloadableFilter(filter))
+}
+
+// Applies the environment variables (see LoadEnvironment) to the reloadable parameters that are accepted by the filter
+// (nil = all of them).
+func ReloadEnvironment(prefix string, filter func(name string) bool) error {
+	return loadEnvironment(prefix, reloadableFilter(filter))
+}
+
+// Changes the value of a reloadable parameter - the name is matched like the keys of a configuration file.
+func SetReloadableValue(key string, value interface{}) error {
+	name, exists := getNormalizedParameterNames()[normalizeKey(key)]
+	if !exists {
+		return newUnknownKeysError([]string{key})
+	}
+
+	if !IsReloadable(name) {
+		return errors.Wrap(ErrNotReloadable, name)
+	}
+
+	return SetValue(name, value)
+}
+
+func IsReloadable(name string) bool {
+	if parameter, exists := boolParameters[name]; exists {
+		return parameter.Reloadable
+	}
+	if parameter, exists := intParameters[name]; exists {
+		return parameter.Reloadable
+	}
+	if parameter, exists := stringParameters[name]; exists {
+		return parameter.Reloadable
+	}
+	if parameter, exists := stringSliceParameters[name]; exists {
+		return parameter.Reloadable
+	}
+	if parameter, exists := durationParameters[name]; exists {
+		return parameter.Reloadable
+	}
+	if parameter, exists := floatParameters[name]; exists {
+		return parameter.Reloadable
+	}
+	if parameter, exists := uintParameters[name]; exists {
+		return parameter.Reloadable
+	}
+
+	return false
+}
+
+// Returns the current values of all reloadable parameters (formatted like the values of their command line flags).
+func GetReloadableValues() map[string]string {
+	result := make(map[string]string)
+	for name, parameter := range boolParameters {
+		if parameter.Reloadable {
+			result[name] = fmt.Sprint(parameter.GetValue())
+		}
+	}
+	for name, parameter := range intParameters {
+		if parameter.Reloadable {
+			result[name] = fmt.Sprint(parameter.GetValue())
+		}
+	}
+	for name, parameter := range stringParameters {
+		if parameter.Reloadable {
+			result[name] = parameter.GetValue()
+		}
+	}
+	for name, parameter := range stringSliceParameters {
+		if parameter.Reloadable {
+			result[name] = parameter.String()
+		}
+	}
+	for name, parameter := range durationParameters {
+		if parameter.Reloadable {
+			result[name] = parameter.String()
+		}
+	}
+	for name, parameter := range floatParameters {
+		if parameter.Reloadable {
+			result[name] = parameter.String()
+		}
+	}
+	for name, parameter := range uintParameters {
+		if parameter.Reloadable {
+			result[name] = parameter.String()
+		}
+	}
+
+	return result
+}
+
+func reloadableFilter(filter func(name string) bool) func(name string) bool {
+	return func(name string) bool {
+		return IsReloadable(name) && (filter == nil || filter(name))
+	}
+}
@@ -0,0 +1,124 @@
+package parameter
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/iotaledger/goshimmer/packages/events"
+)
+
+type StringSliceParameter struct {
+	Name         string
+	Value        *[]string
+	DefaultValue []string
+	Description  string
+	Reloadable   bool
+	Events       StringSliceParameterEvents
+	valueMutex   sync.RWMutex
+}
+
+type StringSliceParameterEvents struct {
+	// func(oldValue []string, newValue []string)
+	Change *events.Event
+}
+
+var stringSliceParameters = make(map[string]*StringSliceParameter)
+
+func AddStringSlice(name string, defaultValue []string, description string) *StringSliceParameter {
+	if _, exists := stringSliceParameters[name]; exists {
+		panic("duplicate parameter - \"" + name + "\" was defined already")
+	}
+
+	value := append([]string{}, defaultValue...)
+
+	newParameter := &StringSliceParameter{
+		Name:         name,
+		DefaultValue: defaultValue,
+		Value:        &value,
+		Description:  description,
+		Events: StringSliceParameterEvents{
+			Change: events.NewEvent(stringSliceChangeCaller),
+		},
+	}
+
+	stringSliceParameters[name] = newParameter
+
+	Events.AddStringSlice.Trigger(newParameter)
+
+	return newParameter
+}
+
+func GetStringSlice(name string) *StringSliceParameter {
+	return stringSliceParameters[name]
+}
+
+func GetStringSlices() map[string]*StringSliceParameter {
+	return stringSliceParameters
+}
+
+// Allows the parameter to be changed while the node is running (i.e. by reloading the configuration).
+func (parameter *StringSliceParameter) MakeReloadable() *StringSliceParameter {
+	parameter.Reloadable = true
+
+	return parameter
+}
+
+// Returns the current value of the parameter (it can be read safely while the parameter is reloaded - the returned
+// slice is replaced and not modified by later changes).
+func (parameter *StringSliceParameter) GetValue() []string {
+	parameter.valueMutex.RLock()
+	defer parameter.valueMutex.RUnlock()
+
+	return *parameter.Value
+}
+
+func (parameter *StringSliceParameter) SetValue(value []string) error {
+	parameter.valueMutex.Lock()
+	oldValue := *parameter.Value
+	if equalStringSlices(oldValue, value) {
+		parameter.valueMutex.Unlock()
+
+		return nil
+	}
+	newValue := append([]string{}, value...)
+	*parameter.Value = newValue
+	parameter.valueMutex.Unlock()
+
+	parameter.Events.Change.Trigger(oldValue, newValue)
+
+	return nil
+}
+
+// Splits the value at commas and whitespace and sets the resulting elements (implements flag.Value).
+func (parameter *StringSliceParameter) Set(value string) error {
+	return parameter.SetValue(strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	}))
+}
+
+func (parameter *StringSliceParameter) String() string {
+	if parameter.Value == nil {
+		return ""
+	}
+
+	return strings.Join(parameter.GetValue(), ",")
+}
+
+func equalStringSlices(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func stringSliceChangeCaller(handler interface{}, params ...interface{}) {
+	handler.(func([]string, []string))(params[0].([]string), params[1].([]string))
+}
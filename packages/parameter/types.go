@@ -1,10 +1,24 @@
 package parameter
 
+import (
+	"sync"
+
+	"github.com/iotaledger/goshimmer/packages/events"
+)
+
 type BoolParameter struct {
 	Name         string
 	Value        *bool
 	DefaultValue bool
 	Description  string
+	Reloadable   bool
+	Events       BoolParameterEvents
+	valueMutex   sync.RWMutex
+}
+
+type BoolParameterEvents struct {
+	// func(oldValue bool, newValue bool)
+	Change *events.Event
 }
 
 type IntParameter struct {
@@ -12,6 +26,14 @@ type IntParameter struct {
 	Value        *int
 	DefaultValue int
 	Description  string
+	Reloadable   bool
+	Events       IntParameterEvents
+	valueMutex   sync.RWMutex
+}
+
+type IntParameterEvents struct {
+	// func(oldValue int, newValue int)
+	Change *events.Event
 }
 
 type StringParameter struct {
@@ -19,6 +41,14 @@ type StringParameter struct {
 	Value        *string
 	DefaultValue string
 	Description  string
+	Reloadable   bool
+	Events       StringParameterEvents
+	valueMutex   sync.RWMutex
+}
+
+type StringParameterEvents struct {
+	// func(oldValue string, newValue string)
+	Change *events.Event
 }
 
 type IntParameterConsumer = func(param *IntParameter)
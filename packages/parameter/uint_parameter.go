@@ -0,0 +1,134 @@
+package parameter
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/pkg/errors"
+)
+
+type UintParameter struct {
+	Name         string
+	Value        *uint64
+	DefaultValue uint64
+	Description  string
+	MinValue     uint64
+	MaxValue     uint64
+	Reloadable   bool
+	Events       UintParameterEvents
+	limited      bool
+	valueMutex   sync.RWMutex
+}
+
+type UintParameterEvents struct {
+	// func(oldValue uint64, newValue uint64)
+	Change *events.Event
+}
+
+var uintParameters = make(map[string]*UintParameter)
+
+func AddUint(name string, defaultValue uint64, description string) *UintParameter {
+	if _, exists := uintParameters[name]; exists {
+		panic("duplicate parameter - \"" + name + "\" was defined already")
+	}
+
+	newParameter := &UintParameter{
+		Name:         name,
+		DefaultValue: defaultValue,
+		Value:        &defaultValue,
+		Description:  description,
+		Events: UintParameterEvents{
+			Change: events.NewEvent(uintChangeCaller),
+		},
+	}
+
+	uintParameters[name] = newParameter
+
+	Events.AddUint.Trigger(newParameter)
+
+	return newParameter
+}
+
+func GetUint(name string) *UintParameter {
+	return uintParameters[name]
+}
+
+func GetUints() map[string]*UintParameter {
+	return uintParameters
+}
+
+// Limits the values of the parameter (it panics if the current value lies outside of the limits).
+func (parameter *UintParameter) SetRange(minValue uint64, maxValue uint64) *UintParameter {
+	parameter.MinValue = minValue
+	parameter.MaxValue = maxValue
+	parameter.limited = true
+
+	if err := parameter.validate(*parameter.Value); err != nil {
+		panic(err)
+	}
+
+	return parameter
+}
+
+// Allows the parameter to be changed while the node is running (i.e. by reloading the configuration).
+func (parameter *UintParameter) MakeReloadable() *UintParameter {
+	parameter.Reloadable = true
+
+	return parameter
+}
+
+func (parameter *UintParameter) SetValue(value uint64) error {
+	if err := parameter.validate(value); err != nil {
+		return err
+	}
+
+	parameter.valueMutex.Lock()
+	oldValue := *parameter.Value
+	*parameter.Value = value
+	parameter.valueMutex.Unlock()
+
+	if oldValue != value {
+		parameter.Events.Change.Trigger(oldValue, value)
+	}
+
+	return nil
+}
+
+// Returns the current value of the parameter (it can be read safely while the parameter is reloaded).
+func (parameter *UintParameter) GetValue() uint64 {
+	parameter.valueMutex.RLock()
+	defer parameter.valueMutex.RUnlock()
+
+	return *parameter.Value
+}
+
+// Parses the value and sets it (implements flag.Value).
+func (parameter *UintParameter) Set(value string) error {
+	parsedValue, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return errors.Wrap(ErrInvalidValue, "expected an unsigned integer but got "+value)
+	}
+
+	return parameter.SetValue(parsedValue)
+}
+
+func (parameter *UintParameter) String() string {
+	if parameter.Value == nil {
+		return ""
+	}
+
+	return strconv.FormatUint(parameter.GetValue(), 10)
+}
+
+func (parameter *UintParameter) validate(value uint64) error {
+	if parameter.limited && (value < parameter.MinValue || value > parameter.MaxValue) {
+		return errors.Wrap(ErrValueOutOfRange, parameter.Name+" must lie between "+strconv.FormatUint(parameter.MinValue, 10)+" and "+strconv.FormatUint(parameter.MaxValue, 10)+" but was "+strconv.FormatUint(value, 10))
+	}
+
+	return nil
+}
+
+func uintChangeCaller(handler interface{}, params ...interface{}) {
+	handler.(func(uint64, uint64))(params[0].(uint64), params[1].(uint64))
+}
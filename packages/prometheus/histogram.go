@@ -0,0 +1,65 @@
+package prometheus
+
+import (
+	"sort"
+	"sync"
+)
+
+// Counts the observed values in buckets with the given upper bounds (an additional bucket for all values above the
+// largest bound is added automatically).
+type Histogram struct {
+	upperBounds  []float64
+	bucketCounts []uint64
+	count        uint64
+	sum          float64
+	mutex        sync.RWMutex
+}
+
+func NewHistogram(upperBounds ...float64) *Histogram {
+	sortedUpperBounds := append([]float64{}, upperBounds...)
+	sort.Float64s(sortedUpperBounds)
+
+	return &Histogram{
+		upperBounds:  sortedUpperBounds,
+		bucketCounts: make([]uint64, len(sortedUpperBounds)+1),
+	}
+}
+
+func (histogram *Histogram) Observe(value float64) {
+	bucketIndex := sort.SearchFloat64s(histogram.upperBounds, value)
+
+	histogram.mutex.Lock()
+	histogram.bucketCounts[bucketIndex]++
+	histogram.count++
+	histogram.sum += value
+	histogram.mutex.Unlock()
+}
+
+// Returns a consistent copy of the current state of the histogram.
+func (histogram *Histogram) Snapshot() HistogramSnapshot {
+	histogram.mutex.RLock()
+	defer histogram.mutex.RUnlock()
+
+	cumulativeCounts := make([]uint64, len(histogram.bucketCounts))
+	var cumulativeCount uint64
+	for i, bucketCount := range histogram.bucketCounts {
+		cumulativeCount += bucketCount
+		cumulativeCounts[i] = cumulativeCount
+	}
+
+	return HistogramSnapshot{
+		UpperBounds:      append([]float64{}, histogram.upperBounds...),
+		CumulativeCounts: cumulativeCounts,
+		Count:            histogram.count,
+		Sum:              histogram.sum,
+	}
+}
+
+// The state of a histogram - CumulativeCounts contains the amount of values that were less than or equal to the
+// corresponding upper bound (the last entry belongs to the implicit +Inf bucket and equals Count).
+type HistogramSnapshot struct {
+	UpperBounds      []float64 `json:"upperBounds"`
+	CumulativeCounts []uint64  `json:"cumulativeCounts"`
+	Count            uint64    `json:"count"`
+	Sum              float64   `json:"sum"`
+}
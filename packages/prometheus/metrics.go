@@ -0,0 +1,40 @@
+package prometheus
+
+import (
+	"math"
+	"sync/atomic"
+)
+
+// A monotonically increasing value (i.e. the amount of processed bundles).
+type Counter struct {
+	count uint64
+}
+
+func (counter *Counter) Inc() {
+	atomic.AddUint64(&counter.count, 1)
+}
+
+func (counter *Counter) Add(delta uint64) {
+	atomic.AddUint64(&counter.count, delta)
+}
+
+func (counter *Counter) Get() uint64 {
+	return atomic.LoadUint64(&counter.count)
+}
+
+func (counter *Counter) value() float64 {
+	return float64(counter.Get())
+}
+
+// A value that can go up and down (i.e. the size of a queue).
+type Gauge struct {
+	bits uint64
+}
+
+func (gauge *Gauge) Set(value float64) {
+	atomic.StoreUint64(&gauge.bits, math.Float64bits(value))
+}
+
+func (gauge *Gauge) Get() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&gauge.bits))
+}
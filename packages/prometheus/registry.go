@@ -0,0 +1,229 @@
+package prometheus
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Registry collects metrics and renders them in the Prometheus text exposition format. The values of all metrics are
+// read when the registry is written, so a metric can also be backed by a function that samples a value on demand.
+type Registry struct {
+	families    map[string]*family
+	familyNames []string
+	mutex       sync.RWMutex
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		families:    make(map[string]*family),
+		familyNames: make([]string, 0),
+	}
+}
+
+// Creates and registers a counter that is increased manually.
+func (registry *Registry) NewCounter(name string, help string, labels Labels) *Counter {
+	counter := &Counter{}
+	registry.register(name, help, TYPE_COUNTER, labels, counter.value)
+
+	return counter
+}
+
+// Creates and registers a gauge that is set manually.
+func (registry *Registry) NewGauge(name string, help string, labels Labels) *Gauge {
+	gauge := &Gauge{}
+	registry.register(name, help, TYPE_GAUGE, labels, gauge.Get)
+
+	return gauge
+}
+
+// Registers a counter whose value is sampled from the given function (i.e. a counter that is maintained by another
+// package anyway).
+func (registry *Registry) NewCounterFunc(name string, help string, labels Labels, valueFunc func() float64) {
+	registry.register(name, help, TYPE_COUNTER, labels, valueFunc)
+}
+
+// Registers a gauge whose value is sampled from the given function.
+func (registry *Registry) NewGaugeFunc(name string, help string, labels Labels, valueFunc func() float64) {
+	registry.register(name, help, TYPE_GAUGE, labels, valueFunc)
+}
+
+// Registers a histogram that is updated by another package (i.e. the solidification latency of the metrics plugin).
+func (registry *Registry) RegisterHistogram(name string, help string, labels Labels, histogram *Histogram) {
+	if _, exists := labels["le"]; exists {
+		panic("invalid label name - \"le\" is reserved for the buckets of histogram \"" + name + "\"")
+	}
+
+	registry.registerSample(name, help, TYPE_HISTOGRAM, labels, &sample{labels: labels.render(), histogramLabels: labels, histogram: histogram})
+}
+
+// Writes all metrics in the Prometheus text exposition format (the families in the order they were registered).
+func (registry *Registry) Write(writer io.Writer) error {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	bufferedWriter := bufio.NewWriter(writer)
+	for _, name := range registry.familyNames {
+		registry.families[name].write(bufferedWriter)
+	}
+
+	return bufferedWriter.Flush()
+}
+
+func (registry *Registry) register(name string, help string, metricType string, labels Labels, valueFunc func() float64) {
+	registry.registerSample(name, help, metricType, labels, &sample{labels: labels.render(), valueFunc: valueFunc})
+}
+
+func (registry *Registry) registerSample(name string, help string, metricType string, labels Labels, newSample *sample) {
+	if !metricNameRegExp.MatchString(name) {
+		panic("invalid metric name - \"" + name + "\"")
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	existingFamily, exists := registry.families[name]
+	if !exists {
+		existingFamily = &family{
+			name:       name,
+			help:       help,
+			metricType: metricType,
+			samples:    make([]*sample, 0, 1),
+		}
+
+		registry.families[name] = existingFamily
+		registry.familyNames = append(registry.familyNames, name)
+	} else if existingFamily.metricType != metricType {
+		panic("metric \"" + name + "\" was registered with a different type already")
+	}
+
+	for _, existingSample := range existingFamily.samples {
+		if existingSample.labels == newSample.labels {
+			panic("duplicate metric - \"" + name + newSample.labels + "\" was registered already")
+		}
+	}
+
+	existingFamily.samples = append(existingFamily.samples, newSample)
+}
+
+// region family ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// all metrics that share the same name (they only differ in their labels)
+type family struct {
+	name       string
+	help       string
+	metricType string
+	samples    []*sample
+}
+
+func (family *family) write(writer *bufio.Writer) {
+	writer.WriteString("# HELP " + family.name + " " + helpEscaper.Replace(family.help) + "\n")
+	writer.WriteString("# TYPE " + family.name + " " + family.metricType + "\n")
+
+	for _, sample := range family.samples {
+		if sample.histogram != nil {
+			sample.writeHistogram(writer, family.name)
+		} else {
+			writer.WriteString(family.name + sample.labels + " " + formatValue(sample.valueFunc()) + "\n")
+		}
+	}
+}
+
+type sample struct {
+	labels          string
+	valueFunc       func() float64
+	histogramLabels Labels
+	histogram       *Histogram
+}
+
+// writes the cumulative buckets (labeled with their upper bound), the sum and the count of a histogram
+func (sample *sample) writeHistogram(writer *bufio.Writer, name string) {
+	snapshot := sample.histogram.Snapshot()
+
+	bucketLabels := make(Labels, len(sample.histogramLabels)+1)
+	for labelName, labelValue := range sample.histogramLabels {
+		bucketLabels[labelName] = labelValue
+	}
+
+	for i, cumulativeCount := range snapshot.CumulativeCounts {
+		if i < len(snapshot.UpperBounds) {
+			bucketLabels["le"] = formatValue(snapshot.UpperBounds[i])
+		} else {
+			bucketLabels["le"] = formatValue(math.Inf(1))
+		}
+
+		writer.WriteString(name + "_bucket" + bucketLabels.render() + " " + strconv.FormatUint(cumulativeCount, 10) + "\n")
+	}
+
+	writer.WriteString(name + "_sum" + sample.labels + " " + formatValue(snapshot.Sum) + "\n")
+	writer.WriteString(name + "_count" + sample.labels + " " + strconv.FormatUint(snapshot.Count, 10) + "\n")
+}
+
+func formatValue(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region labels ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// The labels that distinguish the metrics of the same family (i.e. the name of a cache).
+type Labels map[string]string
+
+// renders the labels sorted by their names, so the same labels always result in the same string
+func (labels Labels) render() string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		if !labelNameRegExp.MatchString(name) {
+			panic("invalid label name - \"" + name + "\"")
+		}
+
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	renderedLabels := make([]string, len(names))
+	for i, name := range names {
+		renderedLabels[i] = name + "=\"" + labelValueEscaper.Replace(labels[name]) + "\""
+	}
+
+	return "{" + strings.Join(renderedLabels, ",") + "}"
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region constants and variables //////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	TYPE_COUNTER   = "counter"
+	TYPE_GAUGE     = "gauge"
+	TYPE_HISTOGRAM = "histogram"
+)
+
+var (
+	metricNameRegExp = regexp.MustCompile(`^[a-zA-Z_:][a-zA-Z0-9_:]*$`)
+	labelNameRegExp  = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+	helpEscaper       = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+	labelValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
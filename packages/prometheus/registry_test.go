@@ -0,0 +1,98 @@
+package prometheus
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+func TestRegistry_Write(t *testing.T) {
+	registry := NewRegistry()
+
+	counter := registry.NewCounter("test_bundles_total", "amount of processed bundles", nil)
+	counter.Inc()
+	counter.Add(2)
+
+	gauge := registry.NewGauge("test_queue_size", "size of the queue", Labels{"queue": "solidifier"})
+	gauge.Set(1.5)
+
+	registry.NewGaugeFunc("test_queue_size", "size of the queue", Labels{"queue": "bundle \"processor\""}, func() float64 {
+		return math.Inf(1)
+	})
+	registry.NewCounterFunc("test_cache_hits_total", "cache hits\nper cache", Labels{"name": "tx", "cache": "lru"}, func() float64 {
+		return 42
+	})
+
+	var buffer bytes.Buffer
+	if err := registry.Write(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedOutput := `# HELP test_bundles_total amount of processed bundles
+# TYPE test_bundles_total counter
+test_bundles_total 3
+# HELP test_queue_size size of the queue
+# TYPE test_queue_size gauge
+test_queue_size{queue="solidifier"} 1.5
+test_queue_size{queue="bundle \"processor\""} +Inf
+# HELP test_cache_hits_total cache hits\nper cache
+# TYPE test_cache_hits_total counter
+test_cache_hits_total{cache="lru",name="tx"} 42
+`
+	if buffer.String() != expectedOutput {
+		t.Error("unexpected output", buffer.String())
+	}
+}
+
+func TestRegistry_WriteHistogram(t *testing.T) {
+	histogram := NewHistogram(1, 0.5)
+	histogram.Observe(0.2)
+	histogram.Observe(0.5)
+	histogram.Observe(0.7)
+	histogram.Observe(3)
+
+	registry := NewRegistry()
+	registry.RegisterHistogram("test_latency_seconds", "latency", Labels{"stage": "solid"}, histogram)
+
+	var buffer bytes.Buffer
+	if err := registry.Write(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	expectedOutput := `# HELP test_latency_seconds latency
+# TYPE test_latency_seconds histogram
+test_latency_seconds_bucket{le="0.5",stage="solid"} 2
+test_latency_seconds_bucket{le="1",stage="solid"} 3
+test_latency_seconds_bucket{le="+Inf",stage="solid"} 4
+test_latency_seconds_sum{stage="solid"} 4.4
+test_latency_seconds_count{stage="solid"} 4
+`
+	if buffer.String() != expectedOutput {
+		t.Error("unexpected output", buffer.String())
+	}
+}
+
+func TestRegistry_RegisterDuplicate(t *testing.T) {
+	registry := NewRegistry()
+	registry.NewCounter("test_total", "", Labels{"a": "b"})
+
+	assertPanics(t, "duplicate metric", func() {
+		registry.NewCounter("test_total", "", Labels{"a": "b"})
+	})
+	assertPanics(t, "type mismatch", func() {
+		registry.NewGauge("test_total", "", Labels{"a": "c"})
+	})
+	assertPanics(t, "invalid name", func() {
+		registry.NewGauge("test-gauge", "", nil)
+	})
+}
+
+func assertPanics(t *testing.T, description string, f func()) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic:", description)
+		}
+	}()
+
+	f()
+}
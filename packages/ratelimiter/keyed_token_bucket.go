@@ -0,0 +1,73 @@
+package ratelimiter
+
+import (
+	"sync"
+	"time"
+)
+
+// KeyedTokenBucket keeps a separate TokenBucket for every key (i.e. for every peer), so that a single noisy peer can
+// not use up the limit of all others.
+type KeyedTokenBucket struct {
+	rate    float64
+	burst   int
+	maxKeys int
+	buckets map[string]*keyedBucketEntry
+	mutex   sync.Mutex
+}
+
+type keyedBucketEntry struct {
+	bucket   *TokenBucket
+	lastUsed time.Time
+}
+
+// Creates buckets with the given rate and burst - at most maxKeys buckets are remembered (the least recently used one
+// is forgotten if a new key arrives).
+func NewKeyedTokenBucket(rate float64, burst int, maxKeys int) *KeyedTokenBucket {
+	if maxKeys < 1 {
+		maxKeys = 1
+	}
+
+	return &KeyedTokenBucket{
+		rate:    rate,
+		burst:   burst,
+		maxKeys: maxKeys,
+		buckets: make(map[string]*keyedBucketEntry),
+	}
+}
+
+// Takes a single token from the bucket of the given key and returns false if the bucket was empty.
+func (keyedBucket *KeyedTokenBucket) Allow(key string) bool {
+	keyedBucket.mutex.Lock()
+
+	now := time.Now()
+
+	entry, exists := keyedBucket.buckets[key]
+	if !exists {
+		if len(keyedBucket.buckets) >= keyedBucket.maxKeys {
+			keyedBucket.removeLeastRecentlyUsed()
+		}
+
+		entry = &keyedBucketEntry{
+			bucket: NewTokenBucket(keyedBucket.rate, keyedBucket.burst),
+		}
+		keyedBucket.buckets[key] = entry
+	}
+	entry.lastUsed = now
+
+	keyedBucket.mutex.Unlock()
+
+	return entry.bucket.Allow()
+}
+
+func (keyedBucket *KeyedTokenBucket) removeLeastRecentlyUsed() {
+	var oldestKey string
+	var oldestEntry *keyedBucketEntry
+	for key, entry := range keyedBucket.buckets {
+		if oldestEntry == nil || entry.lastUsed.Before(oldestEntry.lastUsed) {
+			oldestKey = key
+			oldestEntry = entry
+		}
+	}
+
+	delete(keyedBucket.buckets, oldestKey)
+}
@@ -0,0 +1,89 @@
+package ratelimiter
+
+import (
+	"sync"
+	"time"
+)
+
+// TokenBucket limits the rate of events to a constant refill rate while allowing short bursts.
+type TokenBucket struct {
+	rate       float64
+	burst      float64
+	tokens     float64
+	lastRefill time.Time
+	mutex      sync.Mutex
+}
+
+// Creates a bucket that refills rate tokens per second and holds at most burst tokens - a rate <= 0 disables the limit.
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &TokenBucket{
+		rate:       rate,
+		burst:      float64(burst),
+		tokens:     float64(burst),
+		lastRefill: time.Now(),
+	}
+}
+
+// Takes a single token from the bucket and returns false if the bucket was empty.
+func (bucket *TokenBucket) Allow() bool {
+	return bucket.AllowN(1)
+}
+
+// Takes n tokens from the bucket and returns false (without taking any tokens) if there are not enough of them.
+func (bucket *TokenBucket) AllowN(n int) bool {
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+
+	if bucket.rate <= 0 {
+		return true
+	}
+
+	bucket.refill(time.Now())
+
+	if bucket.tokens < float64(n) {
+		return false
+	}
+
+	bucket.tokens -= float64(n)
+
+	return true
+}
+
+// Changes the refill rate and the burst of the bucket (tokens that exceed the new burst are dropped and a previously
+// unlimited bucket starts full).
+func (bucket *TokenBucket) SetLimit(rate float64, burst int) {
+	if burst < 1 {
+		burst = 1
+	}
+
+	bucket.mutex.Lock()
+	defer bucket.mutex.Unlock()
+
+	bucket.refill(time.Now())
+
+	// an unlimited bucket did not use its tokens, so it starts full
+	if bucket.rate <= 0 {
+		bucket.tokens = float64(burst)
+	}
+
+	bucket.rate = rate
+	bucket.burst = float64(burst)
+	if bucket.tokens > bucket.burst {
+		bucket.tokens = bucket.burst
+	}
+}
+
+func (bucket *TokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(bucket.lastRefill); elapsed > 0 {
+		bucket.tokens += elapsed.Seconds() * bucket.rate
+		if bucket.tokens > bucket.burst {
+			bucket.tokens = bucket.burst
+		}
+
+		bucket.lastRefill = now
+	}
+}
@@ -0,0 +1,82 @@
+package ratelimiter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTokenBucket(t *testing.T) {
+	bucket := NewTokenBucket(100, 10)
+
+	for i := 0; i < 10; i++ {
+		if !bucket.Allow() {
+			t.Fatal("burst was not allowed", i)
+		}
+	}
+	if bucket.Allow() {
+		t.Error("empty bucket allowed an event")
+	}
+
+	time.Sleep(50 * time.Millisecond)
+
+	if !bucket.AllowN(4) {
+		t.Error("bucket was not refilled")
+	}
+	if bucket.AllowN(11) {
+		t.Error("bucket allowed more than its burst")
+	}
+}
+
+func TestTokenBucket_Unlimited(t *testing.T) {
+	bucket := NewTokenBucket(0, 1)
+
+	for i := 0; i < 1000; i++ {
+		if !bucket.Allow() {
+			t.Fatal("unlimited bucket denied an event")
+		}
+	}
+}
+
+func TestTokenBucket_SetLimit(t *testing.T) {
+	bucket := NewTokenBucket(0, 1)
+
+	bucket.SetLimit(0.001, 2)
+	for i := 0; i < 2; i++ {
+		if !bucket.Allow() {
+			t.Fatal("burst was not allowed", i)
+		}
+	}
+	if bucket.Allow() {
+		t.Error("the new limit was not applied")
+	}
+
+	bucket.SetLimit(0, 1)
+	if !bucket.Allow() {
+		t.Error("the limit was not removed")
+	}
+}
+
+func TestKeyedTokenBucket(t *testing.T) {
+	keyedBucket := NewKeyedTokenBucket(0.001, 2, 2)
+
+	for i := 0; i < 2; i++ {
+		if !keyedBucket.Allow("peer1") {
+			t.Fatal("burst was not allowed", i)
+		}
+	}
+	if keyedBucket.Allow("peer1") {
+		t.Error("empty bucket allowed an event")
+	}
+
+	// the buckets of the other keys are independent
+	if !keyedBucket.Allow("peer2") {
+		t.Error("the limit of a different key was used up")
+	}
+
+	// peer1 is forgotten (and starts with a full bucket again) when a third key arrives
+	keyedBucket.Allow("peer2")
+	keyedBucket.Allow("peer3")
+	if !keyedBucket.Allow("peer1") {
+		t.Error("the least recently used bucket was not forgotten")
+	}
+}
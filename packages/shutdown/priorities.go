@@ -0,0 +1,21 @@
+package shutdown
+
+// The shutdown priorities of the background workers - the daemon stops the workers with the highest priority first, so
+// the interfaces to the outside world are closed before the data they feed into the tangle gets flushed.
+const (
+	PRIORITY_TANGLE_CACHES = iota
+	PRIORITY_BUNDLE_PROCESSOR
+	PRIORITY_SOLIDIFIER
+	PRIORITY_GOSSIP
+	PRIORITY_AUTOPEERING
+	PRIORITY_METRICS
+	PRIORITY_ANALYSIS
+	PRIORITY_PROMETHEUS
+	PRIORITY_ZEROMQ
+	PRIORITY_SPAMMER
+	PRIORITY_WEBAPI
+	PRIORITY_DASHBOARD
+	PRIORITY_STATUSSCREEN
+	PRIORITY_CONFIGURATION
+	PRIORITY_PLUGINS
+)
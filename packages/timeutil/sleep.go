@@ -2,13 +2,11 @@ package timeutil
 
 import (
 	"time"
-
-	"github.com/iotaledger/goshimmer/packages/daemon"
 )
 
-func Sleep(interval time.Duration) bool {
+func Sleep(interval time.Duration, shutdownSignal <-chan struct{}) bool {
 	select {
-	case <-daemon.ShutdownSignal:
+	case <-shutdownSignal:
 		return false
 
 	case <-time.After(interval):
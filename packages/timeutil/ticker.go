@@ -2,16 +2,14 @@ package timeutil
 
 import (
 	"time"
-
-	"github.com/iotaledger/goshimmer/packages/daemon"
 )
 
-func Ticker(handler func(), interval time.Duration) {
+func Ticker(handler func(), interval time.Duration, shutdownSignal <-chan struct{}) {
 	ticker := time.NewTicker(interval)
 ticker:
 	for {
 		select {
-		case <-daemon.ShutdownSignal:
+		case <-shutdownSignal:
 			break ticker
 		case <-ticker.C:
 			handler()
@@ -2,12 +2,14 @@ package transactionspammer
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/iotaledger/goshimmer/plugins/gossip"
 
 	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/model/value_transaction"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/plugins/tipselection"
 )
 
@@ -19,21 +21,25 @@ var shutdownSignal chan int
 
 var sentCounter = uint(0)
 
+var currentTps uint64
+
 func Start(tps uint) {
 	startMutex.Lock()
 
+	SetTPS(tps)
+
 	if !spamming {
 		shutdownSignal = make(chan int, 1)
 
 		func(shutdownSignal chan int) {
-			daemon.BackgroundWorker("Transaction Spammer", func() {
+			daemon.BackgroundWorker("Transaction Spammer", func(daemonShutdownSignal <-chan struct{}) {
 				for {
 					start := time.Now()
 					totalSentCounter := int64(0)
 
 					for {
 						select {
-						case <-daemon.ShutdownSignal:
+						case <-daemonShutdownSignal:
 							return
 
 						case <-shutdownSignal:
@@ -50,9 +56,9 @@ func Start(tps uint) {
 							tx.SetBranchTransactionHash(tipselection.GetRandomTip())
 							tx.SetTrunkTransactionHash(tipselection.GetRandomTip())
 
-							gossip.Events.ReceiveTransaction.Trigger(tx.MetaTransaction)
+							gossip.IssueTransaction(tx.MetaTransaction)
 
-							if sentCounter >= tps {
+							if sentCounter >= uint(atomic.LoadUint64(&currentTps)) {
 								duration := time.Since(start)
 
 								if duration < time.Second {
@@ -66,7 +72,7 @@ func Start(tps uint) {
 						}
 					}
 				}
-			})
+			}, shutdown.PRIORITY_SPAMMER)
 		}(shutdownSignal)
 
 		spamming = true
@@ -75,6 +81,11 @@ func Start(tps uint) {
 	startMutex.Unlock()
 }
 
+// Changes the amount of transactions per second that a running (or the next) spammer sends.
+func SetTPS(tps uint) {
+	atomic.StoreUint64(&currentTps, uint64(tps))
+}
+
 func Stop() {
 	startMutex.Lock()
 
@@ -48,6 +48,14 @@ func (wp *WorkerPool) Submit(params ...interface{}) (result chan interface{}) {
 	return
 }
 
+// Returns the amount of submitted tasks that are still waiting for a worker.
+func (wp *WorkerPool) GetPendingQueueSize() int {
+	wp.mutex.RLock()
+	defer wp.mutex.RUnlock()
+
+	return len(wp.calls)
+}
+
 func (wp *WorkerPool) Start() {
 	wp.mutex.Lock()
 
@@ -0,0 +1,8 @@
+package client
+
+import "time"
+
+const (
+	// the interval in which the metrics of the node are reported to the analysis server
+	STATUS_REPORT_INTERVAL = 5 * time.Second
+)
@@ -9,43 +9,50 @@ import (
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/network"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/timeutil"
 	"github.com/iotaledger/goshimmer/plugins/analysis/types/addnode"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/capabilities"
 	"github.com/iotaledger/goshimmer/plugins/analysis/types/connectnodes"
 	"github.com/iotaledger/goshimmer/plugins/analysis/types/disconnectnodes"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodeinfo"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodestatus"
 	"github.com/iotaledger/goshimmer/plugins/analysis/types/ping"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/acceptedneighbors"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/metrics"
+	"github.com/iotaledger/goshimmer/plugins/tipselection"
 )
 
 func Run(plugin *node.Plugin) {
-	daemon.BackgroundWorker("Analysis Client", func() {
+	daemon.BackgroundWorker("Analysis Client", func(shutdownSignal <-chan struct{}) {
 		shuttingDown := false
 
 		for !shuttingDown {
 			select {
-			case <-daemon.ShutdownSignal:
+			case <-shutdownSignal:
 				return
 
 			default:
 				if conn, err := net.Dial("tcp", *SERVER_ADDRESS.Value); err != nil {
-					plugin.LogDebug("Could not connect to reporting server: " + err.Error())
+					plugin.Log.Debug("Could not connect to reporting server", "error", err)
 
-					timeutil.Sleep(1 * time.Second)
+					timeutil.Sleep(1*time.Second, shutdownSignal)
 				} else {
 					managedConn := network.NewManagedConnection(conn)
 					eventDispatchers := getEventDispatchers(managedConn)
+					serverCapabilities := receiveCapabilities(managedConn)
 
 					reportCurrentStatus(eventDispatchers)
 					setupHooks(managedConn, eventDispatchers)
 
-					shuttingDown = keepConnectionAlive(managedConn)
+					shuttingDown = keepConnectionAlive(plugin, managedConn, eventDispatchers, serverCapabilities, shutdownSignal)
 				}
 			}
 		}
-	})
+	}, shutdown.PRIORITY_ANALYSIS)
 }
 
 func getEventDispatchers(conn *network.ManagedConnection) *EventDispatchers {
@@ -59,6 +66,12 @@ func getEventDispatchers(conn *network.ManagedConnection) *EventDispatchers {
 		DisconnectNodes: func(sourceId []byte, targetId []byte) {
 			conn.Write((&disconnectnodes.Packet{SourceId: sourceId, TargetId: targetId}).Marshal())
 		},
+		NodeInfo: func(version string, plugins []string) {
+			conn.Write((&nodeinfo.Packet{Version: version, Plugins: plugins}).Marshal())
+		},
+		NodeStatus: func(status *nodestatus.Packet) {
+			conn.Write(status.Marshal())
+		},
 	}
 }
 
@@ -68,6 +81,41 @@ func reportCurrentStatus(eventDispatchers *EventDispatchers) {
 	reportChosenNeighbors(eventDispatchers)
 }
 
+// Collects the capabilities packet that the server sends in response to our initial add node packet. Servers that do
+// not know the node info and node status packets never send it (and close the connection when they receive one of
+// these packets), so they are only reported after the server announced them.
+func receiveCapabilities(conn *network.ManagedConnection) <-chan *capabilities.Packet {
+	result := make(chan *capabilities.Packet, 1)
+
+	var receiveBuffer []byte
+	onReceiveData := events.NewClosure(func(data []byte) {
+		// the event can not be detached while it is triggered, so the packet is only ignored once it was received
+		if len(receiveBuffer) >= capabilities.MARSHALED_TOTAL_SIZE {
+			return
+		}
+
+		receiveBuffer = append(receiveBuffer, data...)
+		if len(receiveBuffer) < capabilities.MARSHALED_TOTAL_SIZE {
+			return
+		}
+
+		if packet, err := capabilities.Unmarshal(receiveBuffer); err == nil {
+			result <- packet
+		}
+	})
+
+	var onClose *events.Closure
+	onClose = events.NewClosure(func() {
+		conn.Events.ReceiveData.Detach(onReceiveData)
+		conn.Events.Close.Detach(onClose)
+	})
+
+	conn.Events.ReceiveData.Attach(onReceiveData)
+	conn.Events.Close.Attach(onClose)
+
+	return result
+}
+
 func setupHooks(conn *network.ManagedConnection, eventDispatchers *EventDispatchers) {
 	// define hooks ////////////////////////////////////////////////////////////////////////////////////////////////////
 
@@ -123,19 +171,51 @@ func reportChosenNeighbors(dispatchers *EventDispatchers) {
 	}
 }
 
-func keepConnectionAlive(conn *network.ManagedConnection) bool {
-	go conn.Read(make([]byte, 1))
+func getNodeStatus() *nodestatus.Packet {
+	return &nodestatus.Packet{
+		ReceivedTPS:           metrics.GetReceivedTPS(),
+		TipsCount:             uint64(tipselection.GetTipsCount()),
+		SolidTransactionCount: metrics.GetSolidTransactionCount(),
+		ChosenNeighborCount:   uint16(len(chosenneighbors.INSTANCE.Peers)),
+		AcceptedNeighborCount: uint16(len(acceptedneighbors.INSTANCE.Peers)),
+		KnownPeerCount:        uint32(len(knownpeers.INSTANCE.Peers)),
+	}
+}
+
+func keepConnectionAlive(plugin *node.Plugin, conn *network.ManagedConnection, eventDispatchers *EventDispatchers, serverCapabilities <-chan *capabilities.Packet, shutdownSignal <-chan struct{}) bool {
+	go conn.Read(make([]byte, capabilities.MARSHALED_TOTAL_SIZE))
+
+	reportStatus := false
 
 	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+
+	statusTicker := time.NewTicker(STATUS_REPORT_INTERVAL)
+	defer statusTicker.Stop()
+
 	for {
 		select {
-		case <-daemon.ShutdownSignal:
+		case <-shutdownSignal:
 			return true
 
 		case <-ticker.C:
 			if _, err := conn.Write((&ping.Packet{}).Marshal()); err != nil {
 				return false
 			}
+
+		case announcedCapabilities := <-serverCapabilities:
+			if announcedCapabilities.Supports(capabilities.NODE_INFO) {
+				eventDispatchers.NodeInfo(node.VERSION, plugin.Node.GetLoadedPlugins())
+			}
+
+			if reportStatus = announcedCapabilities.Supports(capabilities.NODE_STATUS); reportStatus {
+				eventDispatchers.NodeStatus(getNodeStatus())
+			}
+
+		case <-statusTicker.C:
+			if reportStatus {
+				eventDispatchers.NodeStatus(getNodeStatus())
+			}
 		}
 	}
 }
@@ -1,7 +1,13 @@
 package client
 
+import (
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodestatus"
+)
+
 type EventDispatchers struct {
 	AddNode         func(nodeId []byte)
 	ConnectNodes    func(sourceId []byte, targetId []byte)
 	DisconnectNodes func(sourceId []byte, targetId []byte)
+	NodeInfo        func(version string, plugins []string)
+	NodeStatus      func(status *nodestatus.Packet)
 }
@@ -1,8 +1,6 @@
 package analysis
 
 import (
-	"github.com/iotaledger/goshimmer/packages/daemon"
-	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/plugins/analysis/client"
 	"github.com/iotaledger/goshimmer/plugins/analysis/server"
@@ -15,10 +13,6 @@ func configure(plugin *node.Plugin) {
 	if *server.SERVER_PORT.Value != 0 {
 		webinterface.Configure(plugin)
 		server.Configure(plugin)
-
-		daemon.Events.Shutdown.Attach(events.NewClosure(func() {
-			server.Shutdown(plugin)
-		}))
 	}
 }
 
@@ -4,8 +4,11 @@ import (
 	"time"
 
 	"github.com/iotaledger/goshimmer/plugins/analysis/types/addnode"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/capabilities"
 	"github.com/iotaledger/goshimmer/plugins/analysis/types/connectnodes"
 	"github.com/iotaledger/goshimmer/plugins/analysis/types/disconnectnodes"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodeinfo"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodestatus"
 	"github.com/iotaledger/goshimmer/plugins/analysis/types/ping"
 	"github.com/iotaledger/goshimmer/plugins/analysis/types/removenode"
 )
@@ -21,4 +24,9 @@ const (
 	STATE_REMOVE_NODE      = removenode.MARSHALED_PACKET_HEADER
 	STATE_CONNECT_NODES    = connectnodes.MARSHALED_PACKET_HEADER
 	STATE_DISCONNECT_NODES = disconnectnodes.MARSHALED_PACKET_HEADER
+	STATE_NODE_INFO        = nodeinfo.MARSHALED_PACKET_HEADER
+	STATE_NODE_STATUS      = nodestatus.MARSHALED_PACKET_HEADER
+
+	// the packets (beyond the original ones) that the server announces to its clients
+	SUPPORTED_CAPABILITIES = capabilities.NODE_INFO | capabilities.NODE_STATUS
 )
@@ -2,6 +2,8 @@ package server
 
 import (
 	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodeinfo"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodestatus"
 )
 
 var Events = struct {
@@ -11,6 +13,8 @@ var Events = struct {
 	DisconnectNodes *events.Event
 	NodeOnline      *events.Event
 	NodeOffline     *events.Event
+	NodeInfo        *events.Event
+	NodeStatus      *events.Event
 	Error           *events.Event
 }{
 	events.NewEvent(stringCaller),
@@ -19,6 +23,8 @@ var Events = struct {
 	events.NewEvent(stringStringCaller),
 	events.NewEvent(stringCaller),
 	events.NewEvent(stringCaller),
+	events.NewEvent(nodeInfoCaller),
+	events.NewEvent(nodeStatusCaller),
 	events.NewEvent(errorCaller),
 }
 
@@ -28,4 +34,10 @@ func stringCaller(handler interface{}, params ...interface{}) {
 func stringStringCaller(handler interface{}, params ...interface{}) {
 	handler.(func(string, string))(params[0].(string), params[1].(string))
 }
+func nodeInfoCaller(handler interface{}, params ...interface{}) {
+	handler.(func(string, *nodeinfo.Packet))(params[0].(string), params[1].(*nodeinfo.Packet))
+}
+func nodeStatusCaller(handler interface{}, params ...interface{}) {
+	handler.(func(string, *nodestatus.Packet))(params[0].(string), params[1].(*nodestatus.Packet))
+}
 func errorCaller(handler interface{}, params ...interface{}) { handler.(func(error))(params[0].(error)) }
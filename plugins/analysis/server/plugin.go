@@ -3,16 +3,19 @@ package server
 import (
 	"encoding/hex"
 	"math"
-	"strconv"
 
 	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/network"
 	"github.com/iotaledger/goshimmer/packages/network/tcp"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/plugins/analysis/types/addnode"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/capabilities"
 	"github.com/iotaledger/goshimmer/plugins/analysis/types/connectnodes"
 	"github.com/iotaledger/goshimmer/plugins/analysis/types/disconnectnodes"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodeinfo"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodestatus"
 	"github.com/iotaledger/goshimmer/plugins/analysis/types/ping"
 	"github.com/iotaledger/goshimmer/plugins/analysis/types/removenode"
 	"github.com/pkg/errors"
@@ -25,10 +28,10 @@ func Configure(plugin *node.Plugin) {
 
 	server.Events.Connect.Attach(events.NewClosure(HandleConnection))
 	server.Events.Error.Attach(events.NewClosure(func(err error) {
-		plugin.LogFailure("error in server: " + err.Error())
+		plugin.Log.Failure("error in server", "error", err)
 	}))
 	server.Events.Start.Attach(events.NewClosure(func() {
-		plugin.LogSuccess("Starting Server (port " + strconv.Itoa(*SERVER_PORT.Value) + ") ... done")
+		plugin.Log.Success("Starting Server ... done", "port", *SERVER_PORT.Value)
 	}))
 	server.Events.Shutdown.Attach(events.NewClosure(func() {
 		plugin.LogSuccess("Stopping Server ... done")
@@ -36,11 +39,17 @@ func Configure(plugin *node.Plugin) {
 }
 
 func Run(plugin *node.Plugin) {
-	daemon.BackgroundWorker("Analysis Server", func() {
-		plugin.LogInfo("Starting Server (port " + strconv.Itoa(*SERVER_PORT.Value) + ") ...")
+	daemon.BackgroundWorker("Analysis Server", func(shutdownSignal <-chan struct{}) {
+		plugin.Log.Info("Starting Server ...", "port", *SERVER_PORT.Value)
+
+		go func() {
+			<-shutdownSignal
+
+			Shutdown(plugin)
+		}()
 
 		server.Listen(*SERVER_PORT.Value)
-	})
+	}, shutdown.PRIORITY_ANALYSIS)
 }
 
 func Shutdown(plugin *node.Plugin) {
@@ -78,6 +87,8 @@ func HandleConnection(conn *network.ManagedConnection) {
 		removenode.MARSHALED_TOTAL_SIZE,
 		connectnodes.MARSHALED_TOTAL_SIZE,
 		disconnectnodes.MARSHALED_PACKET_HEADER,
+		nodeinfo.MARSHALED_TOTAL_SIZE,
+		nodestatus.MARSHALED_TOTAL_SIZE,
 	)
 
 	go conn.Read(make([]byte, maxPacketsSize))
@@ -125,6 +136,12 @@ func processIncomingPacket(connectionState *byte, receiveBuffer *[]byte, conn *n
 
 		case STATE_REMOVE_NODE:
 			*receiveBuffer = make([]byte, removenode.MARSHALED_TOTAL_SIZE)
+
+		case STATE_NODE_INFO:
+			*receiveBuffer = make([]byte, nodeinfo.MARSHALED_TOTAL_SIZE)
+
+		case STATE_NODE_STATUS:
+			*receiveBuffer = make([]byte, nodestatus.MARSHALED_TOTAL_SIZE)
 		}
 	}
 
@@ -154,6 +171,12 @@ func processIncomingPacket(connectionState *byte, receiveBuffer *[]byte, conn *n
 
 	case STATE_REMOVE_NODE:
 		processIncomingAddNodePacket(connectionState, receiveBuffer, conn, data, offset, connectedNodeId)
+
+	case STATE_NODE_INFO:
+		processIncomingNodeInfoPacket(connectionState, receiveBuffer, conn, data, offset, connectedNodeId)
+
+	case STATE_NODE_STATUS:
+		processIncomingNodeStatusPacket(connectionState, receiveBuffer, conn, data, offset, connectedNodeId)
 	}
 }
 
@@ -187,6 +210,16 @@ func parsePackageHeader(data []byte) (ConnectionState, []byte, error) {
 
 		connectionState = STATE_REMOVE_NODE
 
+	case nodeinfo.MARSHALED_PACKET_HEADER:
+		receiveBuffer = make([]byte, nodeinfo.MARSHALED_TOTAL_SIZE)
+
+		connectionState = STATE_NODE_INFO
+
+	case nodestatus.MARSHALED_PACKET_HEADER:
+		receiveBuffer = make([]byte, nodestatus.MARSHALED_TOTAL_SIZE)
+
+		connectionState = STATE_NODE_STATUS
+
 	default:
 		return 0, nil, errors.New("invalid package header")
 	}
@@ -217,6 +250,9 @@ func processIncomingAddNodePacket(connectionState *byte, receiveBuffer *[]byte,
 				*connectedNodeId = nodeId
 
 				Events.NodeOnline.Trigger(nodeId)
+
+				// clients only send the newer packets once they know that we understand them
+				conn.Write((&capabilities.Packet{Flags: SUPPORTED_CAPABILITIES}).Marshal())
 			}
 		}
 
@@ -309,3 +345,55 @@ func processIncomingDisconnectNodesPacket(connectionState *byte, receiveBuffer *
 		}
 	}
 }
+
+func processIncomingNodeInfoPacket(connectionState *byte, receiveBuffer *[]byte, conn *network.ManagedConnection, data []byte, offset *int, connectedNodeId *string) {
+	remainingCapacity := int(math.Min(float64(nodeinfo.MARSHALED_TOTAL_SIZE-*offset), float64(len(data))))
+
+	copy((*receiveBuffer)[*offset:], data[:remainingCapacity])
+
+	if *offset+len(data) < nodeinfo.MARSHALED_TOTAL_SIZE {
+		*offset += len(data)
+	} else {
+		if nodeInfoPacket, err := nodeinfo.Unmarshal(*receiveBuffer); err != nil {
+			Events.Error.Trigger(err)
+
+			conn.Close()
+
+			return
+		} else {
+			Events.NodeInfo.Trigger(*connectedNodeId, nodeInfoPacket)
+		}
+
+		*connectionState = STATE_CONSECUTIVE
+
+		if *offset+len(data) > nodeinfo.MARSHALED_TOTAL_SIZE {
+			processIncomingPacket(connectionState, receiveBuffer, conn, data[remainingCapacity:], offset, connectedNodeId)
+		}
+	}
+}
+
+func processIncomingNodeStatusPacket(connectionState *byte, receiveBuffer *[]byte, conn *network.ManagedConnection, data []byte, offset *int, connectedNodeId *string) {
+	remainingCapacity := int(math.Min(float64(nodestatus.MARSHALED_TOTAL_SIZE-*offset), float64(len(data))))
+
+	copy((*receiveBuffer)[*offset:], data[:remainingCapacity])
+
+	if *offset+len(data) < nodestatus.MARSHALED_TOTAL_SIZE {
+		*offset += len(data)
+	} else {
+		if nodeStatusPacket, err := nodestatus.Unmarshal(*receiveBuffer); err != nil {
+			Events.Error.Trigger(err)
+
+			conn.Close()
+
+			return
+		} else {
+			Events.NodeStatus.Trigger(*connectedNodeId, nodeStatusPacket)
+		}
+
+		*connectionState = STATE_CONSECUTIVE
+
+		if *offset+len(data) > nodestatus.MARSHALED_TOTAL_SIZE {
+			processIncomingPacket(connectionState, receiveBuffer, conn, data[remainingCapacity:], offset, connectedNodeId)
+		}
+	}
+}
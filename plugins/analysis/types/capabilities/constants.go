@@ -0,0 +1,24 @@
+package capabilities
+
+const (
+	// the packet is sent by the server (in response to the initial add node packet), so its header only has to differ
+	// from the other packets that a server sends
+	MARSHALED_PACKET_HEADER = 0x07
+
+	MARSHALED_PACKET_HEADER_START = 0
+	MARSHALED_PACKET_HEADER_SIZE  = 1
+	MARSHALED_PACKET_HEADER_END   = MARSHALED_PACKET_HEADER_START + MARSHALED_PACKET_HEADER_SIZE
+
+	MARSHALED_FLAGS_START = MARSHALED_PACKET_HEADER_END
+	MARSHALED_FLAGS_SIZE  = 1
+	MARSHALED_FLAGS_END   = MARSHALED_FLAGS_START + MARSHALED_FLAGS_SIZE
+
+	MARSHALED_TOTAL_SIZE = MARSHALED_FLAGS_END
+)
+
+const (
+	// the server understands the node info packet
+	NODE_INFO = byte(1 << iota)
+	// the server understands the node status packet
+	NODE_STATUS
+)
@@ -0,0 +1,36 @@
+package capabilities
+
+import "github.com/pkg/errors"
+
+// Packet announces the packets that a server understands. Servers that predate the node info and node status packets
+// close the connection when they receive them (the stream can not be resynchronized after an unknown packet), so
+// clients only send these packets after the server announced them.
+type Packet struct {
+	Flags byte
+}
+
+func Unmarshal(data []byte) (*Packet, error) {
+	if len(data) < MARSHALED_TOTAL_SIZE || data[MARSHALED_PACKET_HEADER_START] != MARSHALED_PACKET_HEADER {
+		return nil, errors.New("malformed capabilities packet")
+	}
+
+	unmarshaledPacket := &Packet{
+		Flags: data[MARSHALED_FLAGS_START],
+	}
+
+	return unmarshaledPacket, nil
+}
+
+func (packet *Packet) Marshal() []byte {
+	marshaledPackage := make([]byte, MARSHALED_TOTAL_SIZE)
+
+	marshaledPackage[MARSHALED_PACKET_HEADER_START] = MARSHALED_PACKET_HEADER
+	marshaledPackage[MARSHALED_FLAGS_START] = packet.Flags
+
+	return marshaledPackage
+}
+
+// Returns true if the server understands all of the given packets (i.e. NODE_INFO | NODE_STATUS).
+func (packet *Packet) Supports(flags byte) bool {
+	return packet.Flags&flags == flags
+}
@@ -0,0 +1,25 @@
+package capabilities
+
+import (
+	"testing"
+)
+
+func TestPacket_MarshalUnmarshal(t *testing.T) {
+	packet := &Packet{Flags: NODE_INFO | NODE_STATUS}
+
+	restoredPacket, err := Unmarshal(packet.Marshal())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !restoredPacket.Supports(NODE_INFO) || !restoredPacket.Supports(NODE_STATUS) {
+		t.Error("the restored packet differs from the original one:", restoredPacket)
+	}
+
+	if (&Packet{Flags: NODE_INFO}).Supports(NODE_INFO | NODE_STATUS) {
+		t.Error("a server that only understands node info packets should not support node status packets")
+	}
+
+	if _, err := Unmarshal([]byte{MARSHALED_PACKET_HEADER}); err == nil {
+		t.Error("a truncated packet should be rejected")
+	}
+}
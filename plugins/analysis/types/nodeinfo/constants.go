@@ -0,0 +1,24 @@
+package nodeinfo
+
+const (
+	MARSHALED_PACKET_HEADER = 0x05
+
+	MARSHALED_PACKET_HEADER_START = 0
+	MARSHALED_PACKET_HEADER_SIZE  = 1
+	MARSHALED_PACKET_HEADER_END   = MARSHALED_PACKET_HEADER_START + MARSHALED_PACKET_HEADER_SIZE
+
+	MARSHALED_VERSION_START = MARSHALED_PACKET_HEADER_END
+	MARSHALED_VERSION_SIZE  = 16
+	MARSHALED_VERSION_END   = MARSHALED_VERSION_START + MARSHALED_VERSION_SIZE
+
+	MARSHALED_PLUGINS_START = MARSHALED_VERSION_END
+	MARSHALED_PLUGINS_SIZE  = 1024
+	MARSHALED_PLUGINS_END   = MARSHALED_PLUGINS_START + MARSHALED_PLUGINS_SIZE
+
+	MARSHALED_TOTAL_SIZE = MARSHALED_PLUGINS_END
+)
+
+const (
+	// the names of the plugins are joined by this separator (plugin names do not contain it)
+	PLUGIN_SEPARATOR = ","
+)
@@ -0,0 +1,65 @@
+package nodeinfo
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Packet describes the software of the reporting node - it is sent once after the initial add node packet.
+type Packet struct {
+	Version string   `json:"version"`
+	Plugins []string `json:"plugins"`
+}
+
+func Unmarshal(data []byte) (*Packet, error) {
+	if len(data) < MARSHALED_TOTAL_SIZE || data[0] != MARSHALED_PACKET_HEADER {
+		return nil, errors.New("malformed node info packet")
+	}
+
+	unmarshaledPackage := &Packet{
+		Version: unmarshalString(data[MARSHALED_VERSION_START:MARSHALED_VERSION_END]),
+		Plugins: make([]string, 0),
+	}
+
+	if plugins := unmarshalString(data[MARSHALED_PLUGINS_START:MARSHALED_PLUGINS_END]); plugins != "" {
+		unmarshaledPackage.Plugins = strings.Split(plugins, PLUGIN_SEPARATOR)
+	}
+
+	return unmarshaledPackage, nil
+}
+
+// Marshals the packet - a version or a list of plugins that exceeds the available space gets truncated (the list
+// only contains plugin names that fit completely).
+func (packet *Packet) Marshal() []byte {
+	marshaledPackage := make([]byte, MARSHALED_TOTAL_SIZE)
+
+	marshaledPackage[MARSHALED_PACKET_HEADER_START] = MARSHALED_PACKET_HEADER
+	copy(marshaledPackage[MARSHALED_VERSION_START:MARSHALED_VERSION_END], packet.Version)
+
+	plugins := ""
+	for _, plugin := range packet.Plugins {
+		extendedPlugins := plugin
+		if plugins != "" {
+			extendedPlugins = plugins + PLUGIN_SEPARATOR + plugin
+		}
+
+		if len(extendedPlugins) > MARSHALED_PLUGINS_SIZE {
+			break
+		}
+		plugins = extendedPlugins
+	}
+	copy(marshaledPackage[MARSHALED_PLUGINS_START:MARSHALED_PLUGINS_END], plugins)
+
+	return marshaledPackage
+}
+
+// the strings are padded with zero bytes
+func unmarshalString(data []byte) string {
+	if end := bytes.IndexByte(data, 0); end != -1 {
+		return string(data[:end])
+	}
+
+	return string(data)
+}
@@ -0,0 +1,34 @@
+package nodeinfo
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPacket_MarshalUnmarshal(t *testing.T) {
+	packet := &Packet{Version: "0.0.1", Plugins: []string{"Autopeering", "Gossip", "Analysis"}}
+
+	restoredPacket, err := Unmarshal(packet.Marshal())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restoredPacket.Version != packet.Version || strings.Join(restoredPacket.Plugins, ",") != "Autopeering,Gossip,Analysis" {
+		t.Error("the restored packet differs from the original one:", restoredPacket)
+	}
+
+	if restoredPacket, err := Unmarshal((&Packet{}).Marshal()); err != nil || len(restoredPacket.Plugins) != 0 {
+		t.Error("an empty packet should have no plugins:", restoredPacket, err)
+	}
+}
+
+func TestPacket_MarshalTruncatesPlugins(t *testing.T) {
+	longName := strings.Repeat("x", 600)
+
+	restoredPacket, err := Unmarshal((&Packet{Plugins: []string{"Gossip", longName, longName}}).Marshal())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(restoredPacket.Plugins) != 2 || restoredPacket.Plugins[1] != longName {
+		t.Error("only the plugins that fit completely should be marshaled:", len(restoredPacket.Plugins))
+	}
+}
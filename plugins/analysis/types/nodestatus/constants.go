@@ -0,0 +1,35 @@
+package nodestatus
+
+const (
+	MARSHALED_PACKET_HEADER = 0x06
+
+	MARSHALED_PACKET_HEADER_START = 0
+	MARSHALED_PACKET_HEADER_SIZE  = 1
+	MARSHALED_PACKET_HEADER_END   = MARSHALED_PACKET_HEADER_START + MARSHALED_PACKET_HEADER_SIZE
+
+	MARSHALED_RECEIVED_TPS_START = MARSHALED_PACKET_HEADER_END
+	MARSHALED_RECEIVED_TPS_SIZE  = 8
+	MARSHALED_RECEIVED_TPS_END   = MARSHALED_RECEIVED_TPS_START + MARSHALED_RECEIVED_TPS_SIZE
+
+	MARSHALED_TIPS_COUNT_START = MARSHALED_RECEIVED_TPS_END
+	MARSHALED_TIPS_COUNT_SIZE  = 8
+	MARSHALED_TIPS_COUNT_END   = MARSHALED_TIPS_COUNT_START + MARSHALED_TIPS_COUNT_SIZE
+
+	MARSHALED_SOLID_TRANSACTION_COUNT_START = MARSHALED_TIPS_COUNT_END
+	MARSHALED_SOLID_TRANSACTION_COUNT_SIZE  = 8
+	MARSHALED_SOLID_TRANSACTION_COUNT_END   = MARSHALED_SOLID_TRANSACTION_COUNT_START + MARSHALED_SOLID_TRANSACTION_COUNT_SIZE
+
+	MARSHALED_CHOSEN_NEIGHBOR_COUNT_START = MARSHALED_SOLID_TRANSACTION_COUNT_END
+	MARSHALED_CHOSEN_NEIGHBOR_COUNT_SIZE  = 2
+	MARSHALED_CHOSEN_NEIGHBOR_COUNT_END   = MARSHALED_CHOSEN_NEIGHBOR_COUNT_START + MARSHALED_CHOSEN_NEIGHBOR_COUNT_SIZE
+
+	MARSHALED_ACCEPTED_NEIGHBOR_COUNT_START = MARSHALED_CHOSEN_NEIGHBOR_COUNT_END
+	MARSHALED_ACCEPTED_NEIGHBOR_COUNT_SIZE  = 2
+	MARSHALED_ACCEPTED_NEIGHBOR_COUNT_END   = MARSHALED_ACCEPTED_NEIGHBOR_COUNT_START + MARSHALED_ACCEPTED_NEIGHBOR_COUNT_SIZE
+
+	MARSHALED_KNOWN_PEER_COUNT_START = MARSHALED_ACCEPTED_NEIGHBOR_COUNT_END
+	MARSHALED_KNOWN_PEER_COUNT_SIZE  = 4
+	MARSHALED_KNOWN_PEER_COUNT_END   = MARSHALED_KNOWN_PEER_COUNT_START + MARSHALED_KNOWN_PEER_COUNT_SIZE
+
+	MARSHALED_TOTAL_SIZE = MARSHALED_KNOWN_PEER_COUNT_END
+)
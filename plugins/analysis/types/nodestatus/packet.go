@@ -0,0 +1,46 @@
+package nodestatus
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+// Packet contains the current metrics of the reporting node - it is sent periodically.
+type Packet struct {
+	ReceivedTPS           uint64 `json:"receivedTps"`
+	TipsCount             uint64 `json:"tipsCount"`
+	SolidTransactionCount uint64 `json:"solidTransactionCount"`
+	ChosenNeighborCount   uint16 `json:"chosenNeighborCount"`
+	AcceptedNeighborCount uint16 `json:"acceptedNeighborCount"`
+	KnownPeerCount        uint32 `json:"knownPeerCount"`
+}
+
+func Unmarshal(data []byte) (*Packet, error) {
+	if len(data) < MARSHALED_TOTAL_SIZE || data[0] != MARSHALED_PACKET_HEADER {
+		return nil, errors.New("malformed node status packet")
+	}
+
+	return &Packet{
+		ReceivedTPS:           binary.BigEndian.Uint64(data[MARSHALED_RECEIVED_TPS_START:MARSHALED_RECEIVED_TPS_END]),
+		TipsCount:             binary.BigEndian.Uint64(data[MARSHALED_TIPS_COUNT_START:MARSHALED_TIPS_COUNT_END]),
+		SolidTransactionCount: binary.BigEndian.Uint64(data[MARSHALED_SOLID_TRANSACTION_COUNT_START:MARSHALED_SOLID_TRANSACTION_COUNT_END]),
+		ChosenNeighborCount:   binary.BigEndian.Uint16(data[MARSHALED_CHOSEN_NEIGHBOR_COUNT_START:MARSHALED_CHOSEN_NEIGHBOR_COUNT_END]),
+		AcceptedNeighborCount: binary.BigEndian.Uint16(data[MARSHALED_ACCEPTED_NEIGHBOR_COUNT_START:MARSHALED_ACCEPTED_NEIGHBOR_COUNT_END]),
+		KnownPeerCount:        binary.BigEndian.Uint32(data[MARSHALED_KNOWN_PEER_COUNT_START:MARSHALED_KNOWN_PEER_COUNT_END]),
+	}, nil
+}
+
+func (packet *Packet) Marshal() []byte {
+	marshaledPackage := make([]byte, MARSHALED_TOTAL_SIZE)
+
+	marshaledPackage[MARSHALED_PACKET_HEADER_START] = MARSHALED_PACKET_HEADER
+	binary.BigEndian.PutUint64(marshaledPackage[MARSHALED_RECEIVED_TPS_START:MARSHALED_RECEIVED_TPS_END], packet.ReceivedTPS)
+	binary.BigEndian.PutUint64(marshaledPackage[MARSHALED_TIPS_COUNT_START:MARSHALED_TIPS_COUNT_END], packet.TipsCount)
+	binary.BigEndian.PutUint64(marshaledPackage[MARSHALED_SOLID_TRANSACTION_COUNT_START:MARSHALED_SOLID_TRANSACTION_COUNT_END], packet.SolidTransactionCount)
+	binary.BigEndian.PutUint16(marshaledPackage[MARSHALED_CHOSEN_NEIGHBOR_COUNT_START:MARSHALED_CHOSEN_NEIGHBOR_COUNT_END], packet.ChosenNeighborCount)
+	binary.BigEndian.PutUint16(marshaledPackage[MARSHALED_ACCEPTED_NEIGHBOR_COUNT_START:MARSHALED_ACCEPTED_NEIGHBOR_COUNT_END], packet.AcceptedNeighborCount)
+	binary.BigEndian.PutUint32(marshaledPackage[MARSHALED_KNOWN_PEER_COUNT_START:MARSHALED_KNOWN_PEER_COUNT_END], packet.KnownPeerCount)
+
+	return marshaledPackage
+}
@@ -0,0 +1,42 @@
+package history
+
+import "time"
+
+const (
+	EVENT_TYPE_ADD_NODE         = EventType(1)
+	EVENT_TYPE_REMOVE_NODE      = EventType(2)
+	EVENT_TYPE_CONNECT_NODES    = EventType(3)
+	EVENT_TYPE_DISCONNECT_NODES = EventType(4)
+	EVENT_TYPE_NODE_ONLINE      = EventType(5)
+	EVENT_TYPE_NODE_OFFLINE     = EventType(6)
+)
+
+const (
+	MARSHALED_TYPE_START      = 0
+	MARSHALED_TIME_START      = MARSHALED_TYPE_END
+	MARSHALED_SOURCE_ID_START = MARSHALED_TIME_END
+	MARSHALED_TARGET_ID_START = MARSHALED_SOURCE_ID_END
+
+	MARSHALED_TYPE_END      = MARSHALED_TYPE_START + MARSHALED_TYPE_SIZE
+	MARSHALED_TIME_END      = MARSHALED_TIME_START + MARSHALED_TIME_SIZE
+	MARSHALED_SOURCE_ID_END = MARSHALED_SOURCE_ID_START + MARSHALED_NODE_ID_SIZE
+	MARSHALED_TARGET_ID_END = MARSHALED_TARGET_ID_START + MARSHALED_NODE_ID_SIZE
+
+	MARSHALED_TYPE_SIZE    = 1
+	MARSHALED_TIME_SIZE    = 8
+	MARSHALED_NODE_ID_SIZE = 20
+
+	MARSHALED_TOTAL_SIZE = MARSHALED_TARGET_ID_END
+)
+
+const (
+	// the events are stored by their time followed by a sequence number (events can share the same timestamp)
+	KEY_TIME_SIZE     = 8
+	KEY_SEQUENCE_SIZE = 4
+	KEY_SIZE          = KEY_TIME_SIZE + KEY_SEQUENCE_SIZE
+
+	DATABASE_NAME = "analysis_history"
+
+	// the interval in which the events that are older than the retention time are compacted
+	PRUNING_INTERVAL = 1 * time.Hour
+)
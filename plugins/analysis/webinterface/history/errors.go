@@ -0,0 +1,8 @@
+package history
+
+import "github.com/pkg/errors"
+
+var (
+	ErrMalformedEvent   = errors.New("malformed analysis event")
+	ErrUnknownEventType = errors.New("unknown analysis event type")
+)
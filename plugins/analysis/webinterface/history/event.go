@@ -0,0 +1,73 @@
+package history
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type EventType = byte
+
+// Event is a recorded change of the network topology (the TargetId is only used by the (dis)connect events).
+type Event struct {
+	Type     EventType `json:"type"`
+	Time     time.Time `json:"time"`
+	SourceId string    `json:"sourceId"`
+	TargetId string    `json:"targetId,omitempty"`
+}
+
+func Unmarshal(data []byte) (*Event, error) {
+	if len(data) < MARSHALED_TOTAL_SIZE {
+		return nil, ErrMalformedEvent
+	}
+
+	event := &Event{
+		Type:     data[MARSHALED_TYPE_START],
+		Time:     time.Unix(0, int64(binary.BigEndian.Uint64(data[MARSHALED_TIME_START:MARSHALED_TIME_END]))),
+		SourceId: hex.EncodeToString(data[MARSHALED_SOURCE_ID_START:MARSHALED_SOURCE_ID_END]),
+	}
+
+	switch event.Type {
+	case EVENT_TYPE_CONNECT_NODES, EVENT_TYPE_DISCONNECT_NODES:
+		event.TargetId = hex.EncodeToString(data[MARSHALED_TARGET_ID_START:MARSHALED_TARGET_ID_END])
+	case EVENT_TYPE_ADD_NODE, EVENT_TYPE_REMOVE_NODE, EVENT_TYPE_NODE_ONLINE, EVENT_TYPE_NODE_OFFLINE:
+	default:
+		return nil, ErrUnknownEventType
+	}
+
+	return event, nil
+}
+
+func (event *Event) Marshal() ([]byte, error) {
+	result := make([]byte, MARSHALED_TOTAL_SIZE)
+
+	result[MARSHALED_TYPE_START] = event.Type
+	binary.BigEndian.PutUint64(result[MARSHALED_TIME_START:MARSHALED_TIME_END], uint64(event.Time.UnixNano()))
+
+	if err := marshalNodeId(result[MARSHALED_SOURCE_ID_START:MARSHALED_SOURCE_ID_END], event.SourceId); err != nil {
+		return nil, err
+	}
+	if event.TargetId != "" {
+		if err := marshalNodeId(result[MARSHALED_TARGET_ID_START:MARSHALED_TARGET_ID_END], event.TargetId); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func marshalNodeId(result []byte, nodeId string) error {
+	decodedNodeId, err := hex.DecodeString(nodeId)
+	if err != nil {
+		return errors.Wrap(ErrMalformedEvent, err.Error())
+	}
+	if len(decodedNodeId) != MARSHALED_NODE_ID_SIZE {
+		return errors.Wrap(ErrMalformedEvent, "invalid node id length")
+	}
+
+	copy(result, decodedNodeId)
+
+	return nil
+}
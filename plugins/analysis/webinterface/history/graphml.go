@@ -0,0 +1,80 @@
+package history
+
+import (
+	"encoding/xml"
+	"strconv"
+)
+
+type graphML struct {
+	XMLName xml.Name     `xml:"graphml"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	Id       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	Id          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	Id   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Id     string `xml:"id,attr"`
+	Source string `xml:"source,attr"`
+	Target string `xml:"target,attr"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// Exports the topology as a (directed) GraphML document.
+func (topology *Topology) MarshalGraphML() ([]byte, error) {
+	document := graphML{
+		Xmlns: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{Id: "online", For: "node", AttrName: "online", AttrType: "boolean"},
+		},
+		Graph: graphMLGraph{
+			Id:          "goshimmer-" + topology.Time.UTC().Format("20060102T150405Z"),
+			EdgeDefault: "directed",
+			Nodes:       make([]graphMLNode, len(topology.Nodes)),
+			Edges:       make([]graphMLEdge, len(topology.Links)),
+		},
+	}
+
+	for i, node := range topology.Nodes {
+		document.Graph.Nodes[i] = graphMLNode{
+			Id:   node.Id,
+			Data: []graphMLData{{Key: "online", Value: strconv.FormatBool(node.Online)}},
+		}
+	}
+	for i, link := range topology.Links {
+		document.Graph.Edges[i] = graphMLEdge{
+			Id:     "e" + strconv.Itoa(i),
+			Source: link.SourceId,
+			Target: link.TargetId,
+		}
+	}
+
+	marshaledDocument, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), marshaledDocument...), nil
+}
@@ -0,0 +1,248 @@
+package history
+
+import (
+	"encoding/binary"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/daemon"
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/timeutil"
+	"github.com/iotaledger/goshimmer/plugins/analysis/server"
+)
+
+var historyDb database.Database
+
+var once sync.Once
+
+var sequenceNumber uint32
+
+// serializes the pruning with the recording of new events (so no event gets lost while the old ones are compacted)
+var pruningMutex sync.RWMutex
+
+func Configure(plugin *node.Plugin) {
+	closeStaleSessions(plugin)
+
+	record := func(eventType EventType, sourceId string, targetId string) {
+		if err := recordEvent(&Event{Type: eventType, Time: time.Now(), SourceId: sourceId, TargetId: targetId}); err != nil {
+			plugin.Log.Failure("failed to record analysis event", "error", err)
+		}
+	}
+
+	server.Events.AddNode.Attach(events.NewClosure(func(nodeId string) {
+		record(EVENT_TYPE_ADD_NODE, nodeId, "")
+	}))
+	server.Events.RemoveNode.Attach(events.NewClosure(func(nodeId string) {
+		record(EVENT_TYPE_REMOVE_NODE, nodeId, "")
+	}))
+	server.Events.NodeOnline.Attach(events.NewClosure(func(nodeId string) {
+		record(EVENT_TYPE_NODE_ONLINE, nodeId, "")
+	}))
+	server.Events.NodeOffline.Attach(events.NewClosure(func(nodeId string) {
+		// connections that closed before the node identified itself have no id
+		if nodeId != "" {
+			record(EVENT_TYPE_NODE_OFFLINE, nodeId, "")
+		}
+	}))
+	server.Events.ConnectNodes.Attach(events.NewClosure(func(sourceId string, targetId string) {
+		record(EVENT_TYPE_CONNECT_NODES, sourceId, targetId)
+	}))
+	server.Events.DisconnectNodes.Attach(events.NewClosure(func(sourceId string, targetId string) {
+		record(EVENT_TYPE_DISCONNECT_NODES, sourceId, targetId)
+	}))
+}
+
+func Run(plugin *node.Plugin) {
+	if *RETENTION.Value == 0 {
+		return
+	}
+
+	daemon.BackgroundWorker("Analysis History Pruner", func(shutdownSignal <-chan struct{}) {
+		prune := func() {
+			if prunedEvents, err := PruneEvents(time.Now().Add(-*RETENTION.Value)); err != nil {
+				plugin.Log.Failure("failed to prune the analysis history", "error", err)
+			} else if prunedEvents != 0 {
+				plugin.Log.Info("pruned the analysis history", "events", prunedEvents)
+			}
+		}
+
+		prune()
+		timeutil.Ticker(prune, PRUNING_INTERVAL, shutdownSignal)
+	}, shutdown.PRIORITY_ANALYSIS)
+}
+
+// Returns the recorded events that happened in the given time range (both ends are inclusive) ordered by their time.
+func GetEvents(from time.Time, to time.Time) ([]*Event, error) {
+	pruningMutex.RLock()
+	defer pruningMutex.RUnlock()
+
+	recordedEvents, _, err := getEvents(from, to)
+
+	return recordedEvents, err
+}
+
+// Returns the topology of the network at the given time.
+func GetTopology(at time.Time) (*Topology, error) {
+	recordedEvents, err := GetEvents(time.Unix(0, 0), at)
+	if err != nil {
+		return nil, err
+	}
+
+	return ReplayEvents(recordedEvents, at), nil
+}
+
+// Replaces the events that happened before the given time by a snapshot of the topology at that time (the topology of
+// later times stays the same) and returns the amount of removed events.
+func PruneEvents(before time.Time) (int, error) {
+	pruningMutex.Lock()
+	defer pruningMutex.Unlock()
+
+	prunedEvents, keys, err := getEvents(time.Unix(0, 0), before)
+	if err != nil || len(prunedEvents) == 0 {
+		return 0, err
+	}
+
+	snapshot := ReplayEvents(prunedEvents, before)
+
+	for _, key := range keys {
+		if err := getDb().Delete(key); err != nil {
+			return 0, err
+		}
+	}
+
+	for _, node := range snapshot.Nodes {
+		if err := storeEvent(&Event{Type: EVENT_TYPE_ADD_NODE, Time: before, SourceId: node.Id}); err != nil {
+			return 0, err
+		}
+		if node.Online {
+			if err := storeEvent(&Event{Type: EVENT_TYPE_NODE_ONLINE, Time: before, SourceId: node.Id}); err != nil {
+				return 0, err
+			}
+		}
+	}
+	for _, link := range snapshot.Links {
+		if err := storeEvent(&Event{Type: EVENT_TYPE_CONNECT_NODES, Time: before, SourceId: link.SourceId, TargetId: link.TargetId}); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(prunedEvents), nil
+}
+
+// The nodes that were connected when the server stopped never sent an offline event, so they are marked offline before
+// the server accepts new connections (reconnecting nodes go online again).
+func closeStaleSessions(plugin *node.Plugin) {
+	now := time.Now()
+
+	topology, err := GetTopology(now)
+	if err != nil {
+		plugin.Log.Failure("failed to load the analysis history", "error", err)
+
+		return
+	}
+
+	closedSessions := 0
+	for _, node := range topology.Nodes {
+		if !node.Online {
+			continue
+		}
+
+		if err := recordEvent(&Event{Type: EVENT_TYPE_NODE_OFFLINE, Time: now, SourceId: node.Id}); err != nil {
+			plugin.Log.Failure("failed to record analysis event", "error", err)
+
+			return
+		}
+		closedSessions++
+	}
+
+	if closedSessions != 0 {
+		plugin.Log.Info("closed the sessions of nodes that were online before the restart", "nodes", closedSessions)
+	}
+}
+
+// returns the events of the given time range and their keys (only the keys of the range are iterated)
+func getEvents(from time.Time, to time.Time) ([]*Event, [][]byte, error) {
+	resultEvents := make([]*Event, 0)
+	resultKeys := make([][]byte, 0)
+
+	var unmarshalErr error
+	err := getDb().ForEachFrom(timeKey(from), func(key []byte, value []byte) bool {
+		if len(key) != KEY_SIZE {
+			return true
+		}
+
+		if time.Unix(0, int64(binary.BigEndian.Uint64(key[:KEY_TIME_SIZE]))).After(to) {
+			return false
+		}
+
+		event, err := Unmarshal(value)
+		if err != nil {
+			unmarshalErr = err
+
+			return false
+		}
+
+		resultEvents = append(resultEvents, event)
+		resultKeys = append(resultKeys, key)
+
+		return true
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if unmarshalErr != nil {
+		return nil, nil, unmarshalErr
+	}
+
+	// the keys are iterated in order already but we do not want to rely on the database for the correctness
+	sort.SliceStable(resultEvents, func(i, j int) bool {
+		return resultEvents[i].Time.Before(resultEvents[j].Time)
+	})
+
+	return resultEvents, resultKeys, nil
+}
+
+func recordEvent(event *Event) error {
+	pruningMutex.RLock()
+	defer pruningMutex.RUnlock()
+
+	return storeEvent(event)
+}
+
+func storeEvent(event *Event) error {
+	marshaledEvent, err := event.Marshal()
+	if err != nil {
+		return err
+	}
+
+	key := timeKey(event.Time)
+	binary.BigEndian.PutUint32(key[KEY_TIME_SIZE:], atomic.AddUint32(&sequenceNumber, 1))
+
+	return getDb().Set(key, marshaledEvent)
+}
+
+// returns a key that is sorted in front of all events of the given time (the sequence number is 0)
+func timeKey(eventTime time.Time) []byte {
+	key := make([]byte, KEY_SIZE)
+	binary.BigEndian.PutUint64(key[:KEY_TIME_SIZE], uint64(eventTime.UnixNano()))
+
+	return key
+}
+
+func getDb() database.Database {
+	once.Do(func() {
+		db, err := database.Get(DATABASE_NAME)
+		if err != nil {
+			panic(err)
+		}
+
+		historyDb = db
+	})
+
+	return historyDb
+}
@@ -0,0 +1,67 @@
+package history
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+)
+
+func TestPruneEvents(t *testing.T) {
+	directory, err := ioutil.TempDir("", "history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+	database.DIRECTORY.SetValue(directory)
+
+	start := time.Now()
+	at := func(seconds int) time.Time {
+		return start.Add(time.Duration(seconds) * time.Second)
+	}
+
+	for _, event := range []*Event{
+		{Type: EVENT_TYPE_ADD_NODE, Time: at(0), SourceId: nodeA},
+		{Type: EVENT_TYPE_NODE_ONLINE, Time: at(0), SourceId: nodeA},
+		{Type: EVENT_TYPE_ADD_NODE, Time: at(1), SourceId: nodeB},
+		{Type: EVENT_TYPE_CONNECT_NODES, Time: at(2), SourceId: nodeA, TargetId: nodeB},
+		{Type: EVENT_TYPE_ADD_NODE, Time: at(3), SourceId: nodeC},
+		{Type: EVENT_TYPE_DISCONNECT_NODES, Time: at(5), SourceId: nodeA, TargetId: nodeB},
+	} {
+		if err := recordEvent(event); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if recordedEvents, err := GetEvents(at(1), at(3)); err != nil || len(recordedEvents) != 3 || recordedEvents[0].SourceId != nodeB {
+		t.Error("expected the 3 events between t=1 and t=3 but got", recordedEvents, err)
+	}
+
+	topologyBefore, err := GetTopology(at(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if prunedEvents, err := PruneEvents(at(3)); err != nil || prunedEvents != 5 {
+		t.Error("expected the 5 events up to t=3 to be pruned but got", prunedEvents, err)
+	}
+
+	// the snapshot consists of the 3 nodes, the online event of A and the link from A to B
+	if recordedEvents, err := GetEvents(at(0), at(3)); err != nil || len(recordedEvents) != 5 {
+		t.Error("expected the snapshot to replace the pruned events but got", recordedEvents, err)
+	}
+
+	topologyAfter, err := GetTopology(at(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(topologyAfter.Nodes) != len(topologyBefore.Nodes) || len(topologyAfter.Links) != 1 || !topologyAfter.Nodes[0].Online {
+		t.Error("the topology changed by pruning:", topologyBefore, topologyAfter)
+	}
+
+	if topology, err := GetTopology(at(5)); err != nil || len(topology.Links) != 0 {
+		t.Error("the events after the pruned range should still apply:", topology, err)
+	}
+}
@@ -0,0 +1,11 @@
+package history
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/parameter"
+)
+
+var (
+	RETENTION = parameter.AddDuration("ANALYSIS/HISTORY-RETENTION", 7*24*time.Hour, "time after which recorded topology events are merged into a snapshot of the topology (0 = keep all events)")
+)
@@ -0,0 +1,100 @@
+package history
+
+import (
+	"sort"
+	"time"
+)
+
+// Topology is the state of the network at a certain time.
+type Topology struct {
+	Time  time.Time `json:"time"`
+	Nodes []*Node   `json:"nodes"`
+	Links []*Link   `json:"links"`
+}
+
+type Node struct {
+	Id     string `json:"id"`
+	Online bool   `json:"online"`
+}
+
+type Link struct {
+	SourceId string `json:"sourceId"`
+	TargetId string `json:"targetId"`
+}
+
+// Replays the given (time ordered) events up to the given time and returns the resulting topology.
+func ReplayEvents(events []*Event, at time.Time) *Topology {
+	nodes := make(map[string]bool)
+	links := make(map[string]map[string]bool)
+
+	for _, event := range events {
+		if event.Time.After(at) {
+			break
+		}
+
+		switch event.Type {
+		case EVENT_TYPE_ADD_NODE:
+			if _, exists := nodes[event.SourceId]; !exists {
+				nodes[event.SourceId] = false
+			}
+
+		case EVENT_TYPE_REMOVE_NODE:
+			delete(nodes, event.SourceId)
+			delete(links, event.SourceId)
+			for _, targetMap := range links {
+				delete(targetMap, event.SourceId)
+			}
+
+		case EVENT_TYPE_NODE_ONLINE:
+			nodes[event.SourceId] = true
+
+		case EVENT_TYPE_NODE_OFFLINE:
+			if _, exists := nodes[event.SourceId]; exists {
+				nodes[event.SourceId] = false
+			}
+
+		case EVENT_TYPE_CONNECT_NODES:
+			targetMap, exists := links[event.SourceId]
+			if !exists {
+				targetMap = make(map[string]bool)
+
+				links[event.SourceId] = targetMap
+			}
+			targetMap[event.TargetId] = true
+
+		case EVENT_TYPE_DISCONNECT_NODES:
+			if targetMap, exists := links[event.SourceId]; exists {
+				delete(targetMap, event.TargetId)
+			}
+		}
+	}
+
+	topology := &Topology{
+		Time:  at,
+		Nodes: make([]*Node, 0, len(nodes)),
+		Links: make([]*Link, 0),
+	}
+
+	for nodeId, online := range nodes {
+		topology.Nodes = append(topology.Nodes, &Node{Id: nodeId, Online: online})
+	}
+	for sourceId, targetMap := range links {
+		for targetId := range targetMap {
+			topology.Links = append(topology.Links, &Link{SourceId: sourceId, TargetId: targetId})
+		}
+	}
+
+	// sort the results so that exports of the same topology are identical
+	sort.Slice(topology.Nodes, func(i, j int) bool {
+		return topology.Nodes[i].Id < topology.Nodes[j].Id
+	})
+	sort.Slice(topology.Links, func(i, j int) bool {
+		if topology.Links[i].SourceId != topology.Links[j].SourceId {
+			return topology.Links[i].SourceId < topology.Links[j].SourceId
+		}
+
+		return topology.Links[i].TargetId < topology.Links[j].TargetId
+	})
+
+	return topology
+}
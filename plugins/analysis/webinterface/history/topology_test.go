@@ -0,0 +1,101 @@
+package history
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+const (
+	nodeA = "0000000000000000000000000000000000000001"
+	nodeB = "0000000000000000000000000000000000000002"
+	nodeC = "0000000000000000000000000000000000000003"
+)
+
+func TestEvent_MarshalUnmarshal(t *testing.T) {
+	event := &Event{Type: EVENT_TYPE_CONNECT_NODES, Time: time.Unix(0, 1234567890), SourceId: nodeA, TargetId: nodeB}
+
+	marshaledEvent, err := event.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restoredEvent, err := Unmarshal(marshaledEvent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restoredEvent.Type != event.Type || !restoredEvent.Time.Equal(event.Time) || restoredEvent.SourceId != nodeA || restoredEvent.TargetId != nodeB {
+		t.Error("the restored event differs from the original one:", restoredEvent)
+	}
+
+	if _, err := (&Event{Type: EVENT_TYPE_ADD_NODE, SourceId: "invalid"}).Marshal(); err == nil {
+		t.Error("an event with an invalid node id should not be marshaled")
+	}
+
+	marshaledEvent[MARSHALED_TYPE_START] = 255
+	if _, err := Unmarshal(marshaledEvent); err != ErrUnknownEventType {
+		t.Error("expected ErrUnknownEventType but got", err)
+	}
+}
+
+func TestReplayEvents(t *testing.T) {
+	start := time.Now()
+	at := func(seconds int) time.Time {
+		return start.Add(time.Duration(seconds) * time.Second)
+	}
+
+	recordedEvents := []*Event{
+		{Type: EVENT_TYPE_ADD_NODE, Time: at(0), SourceId: nodeA},
+		{Type: EVENT_TYPE_NODE_ONLINE, Time: at(0), SourceId: nodeA},
+		{Type: EVENT_TYPE_ADD_NODE, Time: at(1), SourceId: nodeB},
+		{Type: EVENT_TYPE_ADD_NODE, Time: at(1), SourceId: nodeC},
+		{Type: EVENT_TYPE_CONNECT_NODES, Time: at(2), SourceId: nodeA, TargetId: nodeB},
+		{Type: EVENT_TYPE_CONNECT_NODES, Time: at(2), SourceId: nodeC, TargetId: nodeA},
+		{Type: EVENT_TYPE_DISCONNECT_NODES, Time: at(3), SourceId: nodeA, TargetId: nodeB},
+		{Type: EVENT_TYPE_REMOVE_NODE, Time: at(4), SourceId: nodeA},
+	}
+
+	topology := ReplayEvents(recordedEvents, at(2))
+	if len(topology.Nodes) != 3 || len(topology.Links) != 2 {
+		t.Fatalf("expected 3 nodes and 2 links at t=2 but got %d nodes and %d links", len(topology.Nodes), len(topology.Links))
+	}
+	if topology.Nodes[0].Id != nodeA || !topology.Nodes[0].Online || topology.Nodes[1].Online {
+		t.Error("wrong online states:", topology.Nodes[0], topology.Nodes[1])
+	}
+
+	if topology := ReplayEvents(recordedEvents, at(3)); len(topology.Links) != 1 || topology.Links[0].SourceId != nodeC {
+		t.Error("the disconnected link should be gone at t=3:", topology.Links)
+	}
+
+	if topology := ReplayEvents(recordedEvents, at(4)); len(topology.Nodes) != 2 || len(topology.Links) != 0 {
+		t.Error("the removed node and its links should be gone at t=4:", topology.Nodes, topology.Links)
+	}
+
+	if topology := ReplayEvents(recordedEvents, at(-1)); len(topology.Nodes) != 0 {
+		t.Error("there should be no nodes before the first event:", topology.Nodes)
+	}
+}
+
+func TestTopology_MarshalGraphML(t *testing.T) {
+	topology := &Topology{
+		Time:  time.Unix(0, 0),
+		Nodes: []*Node{{Id: nodeA, Online: true}, {Id: nodeB}},
+		Links: []*Link{{SourceId: nodeA, TargetId: nodeB}},
+	}
+
+	graphML, err := topology.MarshalGraphML()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{
+		`<graph id="goshimmer-19700101T000000Z" edgedefault="directed">`,
+		`<node id="` + nodeA + `">`,
+		`<data key="online">true</data>`,
+		`<edge id="e0" source="` + nodeA + `" target="` + nodeB + `"></edge>`,
+	} {
+		if !bytes.Contains(graphML, []byte(expected)) {
+			t.Errorf("missing %v in:\n%s", expected, graphML)
+		}
+	}
+}
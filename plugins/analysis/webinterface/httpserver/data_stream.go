@@ -1,10 +1,14 @@
 package httpserver
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/plugins/analysis/server"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodeinfo"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodestatus"
+	"github.com/iotaledger/goshimmer/plugins/analysis/webinterface/nodestatistics"
 	"github.com/iotaledger/goshimmer/plugins/analysis/webinterface/recordedevents"
 	"github.com/iotaledger/goshimmer/plugins/analysis/webinterface/types"
 	"golang.org/x/net/websocket"
@@ -19,6 +23,8 @@ func dataStream(ws *websocket.Conn) {
 			DisconnectNodes: func(sourceId string, targetId string) { fmt.Fprint(ws, "c"+sourceId+targetId) },
 			NodeOnline:      func(nodeId string) { fmt.Fprint(ws, "O"+nodeId) },
 			NodeOffline:     func(nodeId string) { fmt.Fprint(ws, "o"+nodeId) },
+			NodeInfo:        func(nodeId string, info *nodeinfo.Packet) { fmt.Fprint(ws, "I"+nodeId+marshalJSON(info)) },
+			NodeStatus:      func(nodeId string, status *nodestatus.Packet) { fmt.Fprint(ws, "S"+nodeId+marshalJSON(status)) },
 		}
 
 		addNodeClosure := events.NewClosure(eventHandlers.AddNode)
@@ -27,6 +33,8 @@ func dataStream(ws *websocket.Conn) {
 		disconnectNodesClosure := events.NewClosure(eventHandlers.DisconnectNodes)
 		nodeOnlineClosure := events.NewClosure(eventHandlers.NodeOnline)
 		nodeOfflineClosure := events.NewClosure(eventHandlers.NodeOffline)
+		nodeInfoClosure := events.NewClosure(eventHandlers.NodeInfo)
+		nodeStatusClosure := events.NewClosure(eventHandlers.NodeStatus)
 
 		server.Events.AddNode.Attach(addNodeClosure)
 		server.Events.RemoveNode.Attach(removeNodeClosure)
@@ -34,8 +42,13 @@ func dataStream(ws *websocket.Conn) {
 		server.Events.DisconnectNodes.Attach(disconnectNodesClosure)
 		server.Events.NodeOnline.Attach(nodeOnlineClosure)
 		server.Events.NodeOffline.Attach(nodeOfflineClosure)
+		server.Events.NodeInfo.Attach(nodeInfoClosure)
+		server.Events.NodeStatus.Attach(nodeStatusClosure)
 
-		go recordedevents.Replay(eventHandlers)
+		go func() {
+			recordedevents.Replay(eventHandlers)
+			nodestatistics.Replay(eventHandlers)
+		}()
 
 		buf := make([]byte, 1)
 	readFromWebsocket:
@@ -53,5 +66,16 @@ func dataStream(ws *websocket.Conn) {
 		server.Events.DisconnectNodes.Detach(disconnectNodesClosure)
 		server.Events.NodeOnline.Detach(nodeOnlineClosure)
 		server.Events.NodeOffline.Detach(nodeOfflineClosure)
+		server.Events.NodeInfo.Detach(nodeInfoClosure)
+		server.Events.NodeStatus.Detach(nodeStatusClosure)
 	}()
 }
+
+func marshalJSON(value interface{}) string {
+	marshaledValue, err := json.Marshal(value)
+	if err != nil {
+		return "{}"
+	}
+
+	return string(marshaledValue)
+}
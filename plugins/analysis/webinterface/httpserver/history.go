@@ -0,0 +1,95 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/iotaledger/goshimmer/plugins/analysis/webinterface/history"
+)
+
+// Returns the topology at the given time (?time=<unix seconds or RFC3339>, default now) as JSON or GraphML
+// (?format=json|graphml).
+func historyTopology(w http.ResponseWriter, r *http.Request) {
+	at, err := parseTime(r.URL.Query().Get("time"), time.Now())
+	if err != nil {
+		http.Error(w, "invalid time: "+err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	topology, err := history.GetTopology(at)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	switch format := r.URL.Query().Get("format"); format {
+	case "", "json":
+		writeJSON(w, topology)
+
+	case "graphml":
+		graphML, err := topology.MarshalGraphML()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/graphml+xml")
+		w.Header().Set("Content-Disposition", "attachment; filename=\"topology-"+strconv.FormatInt(at.Unix(), 10)+".graphml\"")
+		w.Write(graphML)
+
+	default:
+		http.Error(w, "unknown format: "+format+" (expected json or graphml)", http.StatusBadRequest)
+	}
+}
+
+// Returns the recorded events in the given time range (?from=...&to=..., both default to the whole history).
+func historyEvents(w http.ResponseWriter, r *http.Request) {
+	from, err := parseTime(r.URL.Query().Get("from"), time.Unix(0, 0))
+	if err != nil {
+		http.Error(w, "invalid from: "+err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	to, err := parseTime(r.URL.Query().Get("to"), time.Now())
+	if err != nil {
+		http.Error(w, "invalid to: "+err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	recordedEvents, err := history.GetEvents(from, to)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(w, recordedEvents)
+}
+
+// accepts unix timestamps (in seconds) and RFC3339 formatted times
+func parseTime(value string, defaultTime time.Time) (time.Time, error) {
+	if value == "" {
+		return defaultTime, nil
+	}
+
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+func writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -7,13 +7,26 @@ import (
 
 func index(w http.ResponseWriter, r *http.Request) {
 	fmt.Fprintf(w, `<head>
-  <style> body { margin: 0; } </style>
+  <style>
+    body { margin: 0; }
+    #history { position: absolute; top: 10px; left: 10px; z-index: 10; color: white; font-family: sans-serif; font-size: 12px; }
+    #history a { color: white; }
+    #statistics { position: absolute; top: 10px; right: 10px; z-index: 10; color: white; font-family: sans-serif; font-size: 12px; text-align: right; white-space: pre; }
+  </style>
 
   <script src="https://unpkg.com/3d-force-graph"></script>
   <!--<script src="../../dist/3d-force-graph.js"></script>-->
 </head>
 
 <body>
+  <div id="history">
+    <input type="datetime-local" id="history-time" step="1">
+    <button onclick="timeTravel()">Show</button>
+    <button onclick="goLive()">Live</button>
+    <span id="history-status">live</span>
+    - export: <a href="#" onclick="exportTopology('json')">JSON</a> / <a href="#" onclick="exportTopology('graphml')">GraphML</a>
+  </div>
+  <div id="statistics"></div>
   <div id="3d-graph"></div>
 
   <script>
@@ -25,7 +38,13 @@ func index(w http.ResponseWriter, r *http.Request) {
         }, 1000);
 	};
 
+	var live = true;
+
 	socket.onmessage = function (e) {
+        if (!live) {
+          return;
+        }
+
         switch (e.data[0]) {
           case "_":
             // do nothing - its just a ping
@@ -54,6 +73,14 @@ func index(w http.ResponseWriter, r *http.Request) {
           case "o":
              setNodeOffline(e.data.substr(1));
           break;
+
+          case "I":
+             setNodeInfo(e.data.substr(1, 40), JSON.parse(e.data.substr(41)));
+          break;
+
+          case "S":
+             setNodeStatus(e.data.substr(1, 40), JSON.parse(e.data.substr(41)));
+          break;
         }
 	};
 
@@ -73,6 +100,7 @@ func index(w http.ResponseWriter, r *http.Request) {
         .onNodeHover(node => elem.style.cursor = node ? 'pointer' : null)
         .onNodeClick(removeNodeX)
         .nodeColor(node => node.online ? 'rgba(0,255,0,1)' : 'rgba(255,255,255,1)')
+        .nodeLabel(nodeLabel)
         .graphData(data);
 
     var updateRequired = true;
@@ -143,9 +171,105 @@ func index(w http.ResponseWriter, r *http.Request) {
       updateGraph();
     }
 
+    function setNodeInfo(nodeId, info) {
+      if (nodeId in nodesById) {
+        nodesById[nodeId].info = info;
+      }
+    }
+
+    function setNodeStatus(nodeId, status) {
+      if (nodeId in nodesById) {
+        nodesById[nodeId].status = status;
+      }
+    }
+
+    function nodeLabel(node) {
+      var label = node.id;
+
+      if (node.info) {
+        label += "<br>version: " + node.info.version + "<br>plugins: " + node.info.plugins.join(", ");
+      }
+
+      if (node.status) {
+        label += "<br>received TPS: " + node.status.receivedTps +
+          "<br>tips: " + node.status.tipsCount +
+          "<br>solid transactions: " + node.status.solidTransactionCount +
+          "<br>neighbors: " + node.status.chosenNeighborCount + " chosen / " + node.status.acceptedNeighborCount + " accepted" +
+          "<br>known peers: " + node.status.knownPeerCount;
+      }
+
+      return label;
+    }
+
+    function formatDistribution(distribution) {
+      return Object.keys(distribution).sort().map(key => key + ": " + distribution[key]).join("\n");
+    }
+
+    function updateStatistics() {
+      fetch("/statistics")
+        .then(response => response.json())
+        .then(statistics => {
+          document.getElementById("statistics").textContent =
+            "reporting nodes: " + statistics.reportingNodeCount + "\n" +
+            "received TPS: " + statistics.totalReceivedTps + " total / " + statistics.averageReceivedTps.toFixed(1) + " average\n" +
+            "tips: " + statistics.averageTipsCount.toFixed(1) + " average\n" +
+            "solid transactions: " + statistics.maxSolidTransactionCount + " max\n" +
+            "neighbors: " + statistics.averageChosenNeighborCount.toFixed(1) + " chosen / " + statistics.averageAcceptedNeighborCount.toFixed(1) + " accepted average\n" +
+            "known peers: " + statistics.averageKnownPeerCount.toFixed(1) + " average\n\n" +
+            "versions:\n" + formatDistribution(statistics.versions) + "\n\n" +
+            "plugins:\n" + formatDistribution(statistics.plugins);
+        });
+    }
+
+    updateStatistics();
+    setInterval(updateStatistics, 5000);
+
     function removeNodeX(node) {
       removeNode(node.id)
     }
+
+    function getHistoryTime() {
+      var value = document.getElementById("history-time").value;
+      if (value === "") {
+        return Math.floor(Date.now() / 1000);
+      }
+
+      return Math.floor(new Date(value).getTime() / 1000);
+    }
+
+    function timeTravel() {
+      var time = getHistoryTime();
+
+      fetch("/history/topology?time=" + time)
+        .then(response => response.json())
+        .then(topology => {
+          live = false;
+
+          nodesById = {};
+          existingLinks = {};
+          data.nodes = [];
+          data.links = [];
+
+          topology.nodes.forEach(node => {
+            addNode(node.id);
+            if (node.online) {
+              setNodeOnline(node.id);
+            }
+          });
+          topology.links.forEach(link => connectNodes(link.sourceId, link.targetId));
+
+          document.getElementById("history-status").textContent = "showing " + new Date(time * 1000).toLocaleString();
+        });
+    }
+
+    function goLive() {
+      // the live state is replayed by the server when the page connects again
+      window.location.reload();
+    }
+
+    function exportTopology(format) {
+      window.location.href = "/history/topology?format=" + format + "&time=" + (live ? Math.floor(Date.now() / 1000) : getHistoryTime());
+    }
   </script>
 </body>`)
 }
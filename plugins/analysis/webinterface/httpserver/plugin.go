@@ -5,8 +5,8 @@ import (
 	"time"
 
 	"github.com/iotaledger/goshimmer/packages/daemon"
-	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"golang.org/x/net/context"
 	"golang.org/x/net/websocket"
 )
@@ -21,18 +21,23 @@ func Configure(plugin *node.Plugin) {
 	httpServer = &http.Server{Addr: ":80", Handler: router}
 
 	router.Handle("/datastream", websocket.Handler(dataStream))
+	router.HandleFunc("/history/topology", historyTopology)
+	router.HandleFunc("/history/events", historyEvents)
+	router.HandleFunc("/statistics", statistics)
 	router.HandleFunc("/", index)
-
-	daemon.Events.Shutdown.Attach(events.NewClosure(func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 0*time.Second)
-		defer cancel()
-
-		httpServer.Shutdown(ctx)
-	}))
 }
 
 func Run(plugin *node.Plugin) {
-	daemon.BackgroundWorker("Analysis HTTP Server", func() {
+	daemon.BackgroundWorker("Analysis HTTP Server", func(shutdownSignal <-chan struct{}) {
+		go func() {
+			<-shutdownSignal
+
+			ctx, cancel := context.WithTimeout(context.Background(), 0*time.Second)
+			defer cancel()
+
+			httpServer.Shutdown(ctx)
+		}()
+
 		httpServer.ListenAndServe()
-	})
+	}, shutdown.PRIORITY_ANALYSIS)
 }
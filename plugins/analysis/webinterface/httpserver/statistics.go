@@ -0,0 +1,12 @@
+package httpserver
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/plugins/analysis/webinterface/nodestatistics"
+)
+
+// Returns the aggregated reports (versions, plugins, TPS, tips, solidity and neighbors) of all online nodes.
+func statistics(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, nodestatistics.GetStatistics())
+}
@@ -0,0 +1,143 @@
+package nodestatistics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/analysis/server"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodeinfo"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodestatus"
+	"github.com/iotaledger/goshimmer/plugins/analysis/webinterface/types"
+)
+
+var nodes = make(map[string]*NodeStatistics)
+
+var lock sync.RWMutex
+
+func Configure(plugin *node.Plugin) {
+	server.Events.NodeOnline.Attach(events.NewClosure(func(nodeId string) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		getOrCreate(nodeId).Online = true
+	}))
+
+	server.Events.NodeOffline.Attach(events.NewClosure(func(nodeId string) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		if nodeStatistics, exists := nodes[nodeId]; exists {
+			nodeStatistics.Online = false
+		}
+	}))
+
+	server.Events.RemoveNode.Attach(events.NewClosure(func(nodeId string) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		delete(nodes, nodeId)
+	}))
+
+	server.Events.NodeInfo.Attach(events.NewClosure(func(nodeId string, info *nodeinfo.Packet) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		nodeStatistics := getOrCreate(nodeId)
+		nodeStatistics.Info = info
+		nodeStatistics.LastUpdate = time.Now()
+	}))
+
+	server.Events.NodeStatus.Attach(events.NewClosure(func(nodeId string, status *nodestatus.Packet) {
+		lock.Lock()
+		defer lock.Unlock()
+
+		nodeStatistics := getOrCreate(nodeId)
+		nodeStatistics.Status = status
+		nodeStatistics.LastUpdate = time.Now()
+	}))
+}
+
+// Returns the aggregated statistics of all online nodes.
+func GetStatistics() *Statistics {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	return aggregate(nodes)
+}
+
+func Replay(handlers *types.EventHandlers) {
+	lock.RLock()
+	defer lock.RUnlock()
+
+	for nodeId, nodeStatistics := range nodes {
+		if nodeStatistics.Info != nil {
+			handlers.NodeInfo(nodeId, nodeStatistics.Info)
+		}
+		if nodeStatistics.Status != nil {
+			handlers.NodeStatus(nodeId, nodeStatistics.Status)
+		}
+	}
+}
+
+func aggregate(nodes map[string]*NodeStatistics) *Statistics {
+	result := &Statistics{
+		Versions: make(map[string]int),
+		Plugins:  make(map[string]int),
+	}
+
+	// the averages only include the nodes that already reported their status
+	var statusCount, tipsCount, chosenNeighborCount, acceptedNeighborCount, knownPeerCount uint64
+	for _, nodeStatistics := range nodes {
+		if !nodeStatistics.Online || (nodeStatistics.Info == nil && nodeStatistics.Status == nil) {
+			continue
+		}
+
+		result.ReportingNodeCount++
+
+		if info := nodeStatistics.Info; info != nil {
+			result.Versions[info.Version]++
+			for _, plugin := range info.Plugins {
+				result.Plugins[plugin]++
+			}
+		}
+
+		if status := nodeStatistics.Status; status != nil {
+			statusCount++
+			result.TotalReceivedTPS += status.ReceivedTPS
+			tipsCount += status.TipsCount
+			chosenNeighborCount += uint64(status.ChosenNeighborCount)
+			acceptedNeighborCount += uint64(status.AcceptedNeighborCount)
+			knownPeerCount += uint64(status.KnownPeerCount)
+
+			if status.SolidTransactionCount > result.MaxSolidTransactionCount {
+				result.MaxSolidTransactionCount = status.SolidTransactionCount
+			}
+		}
+	}
+
+	if statusCount != 0 {
+		nodeCount := float64(statusCount)
+
+		result.AverageReceivedTPS = float64(result.TotalReceivedTPS) / nodeCount
+		result.AverageTipsCount = float64(tipsCount) / nodeCount
+		result.AverageChosenNeighborCount = float64(chosenNeighborCount) / nodeCount
+		result.AverageAcceptedNeighborCount = float64(acceptedNeighborCount) / nodeCount
+		result.AverageKnownPeerCount = float64(knownPeerCount) / nodeCount
+	}
+
+	return result
+}
+
+// expects the lock to be held
+func getOrCreate(nodeId string) *NodeStatistics {
+	nodeStatistics, exists := nodes[nodeId]
+	if !exists {
+		nodeStatistics = &NodeStatistics{}
+
+		nodes[nodeId] = nodeStatistics
+	}
+
+	return nodeStatistics
+}
@@ -0,0 +1,49 @@
+package nodestatistics
+
+import (
+	"testing"
+
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodeinfo"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodestatus"
+)
+
+func TestAggregate(t *testing.T) {
+	statistics := aggregate(map[string]*NodeStatistics{
+		"a": {
+			Online: true,
+			Info:   &nodeinfo.Packet{Version: "0.0.1", Plugins: []string{"Gossip", "Analysis"}},
+			Status: &nodestatus.Packet{ReceivedTPS: 10, TipsCount: 4, SolidTransactionCount: 100, ChosenNeighborCount: 4, AcceptedNeighborCount: 2},
+		},
+		"b": {
+			Online: true,
+			Info:   &nodeinfo.Packet{Version: "0.0.2", Plugins: []string{"Gossip"}},
+			Status: &nodestatus.Packet{ReceivedTPS: 20, TipsCount: 8, SolidTransactionCount: 300, ChosenNeighborCount: 2, AcceptedNeighborCount: 4},
+		},
+		// only reported its info so far
+		"c": {
+			Online: true,
+			Info:   &nodeinfo.Packet{Version: "0.0.1"},
+		},
+		// offline nodes are ignored
+		"d": {
+			Status: &nodestatus.Packet{ReceivedTPS: 1000},
+		},
+	})
+
+	if statistics.ReportingNodeCount != 3 {
+		t.Error("expected 3 reporting nodes but got", statistics.ReportingNodeCount)
+	}
+	if statistics.Versions["0.0.1"] != 2 || statistics.Versions["0.0.2"] != 1 || statistics.Plugins["Gossip"] != 2 || statistics.Plugins["Analysis"] != 1 {
+		t.Error("wrong version or plugin distribution:", statistics.Versions, statistics.Plugins)
+	}
+	if statistics.TotalReceivedTPS != 30 || statistics.AverageReceivedTPS != 15 || statistics.AverageTipsCount != 6 {
+		t.Error("wrong TPS or tips aggregation:", statistics.TotalReceivedTPS, statistics.AverageReceivedTPS, statistics.AverageTipsCount)
+	}
+	if statistics.MaxSolidTransactionCount != 300 || statistics.AverageChosenNeighborCount != 3 || statistics.AverageAcceptedNeighborCount != 3 {
+		t.Error("wrong solidity or neighbor aggregation:", statistics)
+	}
+
+	if emptyStatistics := aggregate(map[string]*NodeStatistics{}); emptyStatistics.ReportingNodeCount != 0 || emptyStatistics.AverageReceivedTPS != 0 {
+		t.Error("the statistics of an empty network should be zero:", emptyStatistics)
+	}
+}
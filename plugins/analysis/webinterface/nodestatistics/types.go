@@ -0,0 +1,30 @@
+package nodestatistics
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodeinfo"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodestatus"
+)
+
+// NodeStatistics contains the last reported info and status of a node.
+type NodeStatistics struct {
+	Online     bool               `json:"online"`
+	Info       *nodeinfo.Packet   `json:"info,omitempty"`
+	Status     *nodestatus.Packet `json:"status,omitempty"`
+	LastUpdate time.Time          `json:"lastUpdate"`
+}
+
+// Statistics aggregates the reports of all online nodes.
+type Statistics struct {
+	ReportingNodeCount           int            `json:"reportingNodeCount"`
+	Versions                     map[string]int `json:"versions"`
+	Plugins                      map[string]int `json:"plugins"`
+	TotalReceivedTPS             uint64         `json:"totalReceivedTps"`
+	AverageReceivedTPS           float64        `json:"averageReceivedTps"`
+	AverageTipsCount             float64        `json:"averageTipsCount"`
+	MaxSolidTransactionCount     uint64         `json:"maxSolidTransactionCount"`
+	AverageChosenNeighborCount   float64        `json:"averageChosenNeighborCount"`
+	AverageAcceptedNeighborCount float64        `json:"averageAcceptedNeighborCount"`
+	AverageKnownPeerCount        float64        `json:"averageKnownPeerCount"`
+}
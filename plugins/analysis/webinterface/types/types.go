@@ -1,5 +1,10 @@
 package types
 
+import (
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodeinfo"
+	"github.com/iotaledger/goshimmer/plugins/analysis/types/nodestatus"
+)
+
 type EventHandlers = struct {
 	AddNode         func(nodeId string)
 	RemoveNode      func(nodeId string)
@@ -7,6 +12,8 @@ type EventHandlers = struct {
 	DisconnectNodes func(sourceId string, targetId string)
 	NodeOnline      func(nodeId string)
 	NodeOffline     func(nodeId string)
+	NodeInfo        func(nodeId string, info *nodeinfo.Packet)
+	NodeStatus      func(nodeId string, status *nodestatus.Packet)
 }
 
 type EventHandlersConsumer = func(handler *EventHandlers)
@@ -0,0 +1,134 @@
+package inspection
+
+import (
+	"sort"
+	"time"
+
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/acceptedneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/neighborhood"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerlist"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerregister"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/reputation"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/salt"
+)
+
+// Returns all known peers (sorted by their last sighting, most recent first).
+func GetKnownPeers() []*KnownPeer {
+	peers := copyPeers(knownpeers.INSTANCE)
+
+	// the reputations are not created on demand - inspecting the peers should not change their state
+	reputations := knownpeers.GetReputations()
+
+	now := time.Now()
+	result := make([]*KnownPeer, len(peers))
+	for i, knownPeer := range peers {
+		result[i] = &KnownPeer{
+			Identifier:  knownPeer.Identity.StringIdentifier,
+			Address:     knownPeer.Address.String(),
+			PeeringPort: knownPeer.PeeringPort,
+			GossipPort:  knownPeer.GossipPort,
+			Score:       reputation.NEUTRAL_SCORE,
+		}
+
+		if peerReputation, exists := reputations[knownPeer.Identity.StringIdentifier]; exists {
+			result[i].FirstSeen = peerReputation.GetFirstSeen()
+			result[i].LastSeen = peerReputation.GetLastSeen()
+			result[i].Uptime = int64(peerReputation.GetUptime() / time.Second)
+			result[i].Score = peerReputation.GetScore(now)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].LastSeen.After(result[j].LastSeen)
+	})
+
+	return result
+}
+
+// Returns the current neighborhood with the distances of the peers to our public salt (sorted by their distance).
+func GetNeighborhood() []*Neighbor {
+	return newNeighbors(neighborhood.LIST_INSTANCE, chosenneighbors.OWN_DISTANCE)
+}
+
+// Returns the neighbors that we chose (their distance is based on our public salt).
+func GetChosenNeighbors() *NeighborSet {
+	chosenneighbors.FurthestNeighborLock.RLock()
+	furthestNeighbor, furthestNeighborDistance := chosenneighbors.FURTHEST_NEIGHBOR, chosenneighbors.FURTHEST_NEIGHBOR_DISTANCE
+	chosenneighbors.FurthestNeighborLock.RUnlock()
+
+	return newNeighborSet(copyPeers(chosenneighbors.INSTANCE), chosenneighbors.OWN_DISTANCE, furthestNeighbor, furthestNeighborDistance)
+}
+
+// Returns the neighbors that chose us (their distance is based on our private salt).
+func GetAcceptedNeighbors() *NeighborSet {
+	acceptedneighbors.FurthestNeighborLock.RLock()
+	furthestNeighbor, furthestNeighborDistance := acceptedneighbors.FURTHEST_NEIGHBOR, acceptedneighbors.FURTHEST_NEIGHBOR_DISTANCE
+	acceptedneighbors.FurthestNeighborLock.RUnlock()
+
+	return newNeighborSet(copyPeers(acceptedneighbors.INSTANCE), acceptedneighbors.OWN_DISTANCE, furthestNeighbor, furthestNeighborDistance)
+}
+
+func GetSalts() *Salts {
+	now := time.Now()
+
+	return &Salts{
+		Public:  newSalt(saltmanager.PUBLIC_SALT, now),
+		Private: newSalt(saltmanager.PRIVATE_SALT, now),
+	}
+}
+
+func copyPeers(register *peerregister.PeerRegister) peerlist.PeerList {
+	defer register.Lock()()
+
+	return register.List()
+}
+
+func newNeighborSet(peers peerlist.PeerList, distance func(p *peer.Peer) uint64, furthestNeighbor *peer.Peer, furthestNeighborDistance uint64) *NeighborSet {
+	result := &NeighborSet{
+		Neighbors: newNeighbors(peers, distance),
+	}
+
+	if furthestNeighbor != nil {
+		result.FurthestNeighbor = newNeighbor(furthestNeighbor, furthestNeighborDistance)
+	}
+
+	return result
+}
+
+func newNeighbors(peers peerlist.PeerList, distance func(p *peer.Peer) uint64) []*Neighbor {
+	result := make([]*Neighbor, len(peers))
+	for i, neighbor := range peers {
+		result[i] = newNeighbor(neighbor, distance(neighbor))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Distance < result[j].Distance
+	})
+
+	return result
+}
+
+func newNeighbor(neighbor *peer.Peer, distance uint64) *Neighbor {
+	return &Neighbor{
+		Identifier:  neighbor.Identity.StringIdentifier,
+		Address:     neighbor.Address.String(),
+		PeeringPort: neighbor.PeeringPort,
+		GossipPort:  neighbor.GossipPort,
+		Distance:    distance,
+	}
+}
+
+func newSalt(saltToInspect *salt.Salt, now time.Time) Salt {
+	if saltToInspect == nil {
+		return Salt{}
+	}
+
+	return Salt{
+		ExpirationTime: saltToInspect.ExpirationTime,
+		ExpiresIn:      int64(saltToInspect.ExpirationTime.Sub(now) / time.Second),
+	}
+}
@@ -0,0 +1,42 @@
+package inspection
+
+import (
+	"time"
+)
+
+type KnownPeer struct {
+	Identifier  string    `json:"identifier"`
+	Address     string    `json:"address"`
+	PeeringPort uint16    `json:"peeringPort"`
+	GossipPort  uint16    `json:"gossipPort"`
+	FirstSeen   time.Time `json:"firstSeen"`
+	LastSeen    time.Time `json:"lastSeen"`
+	// the uptime in seconds
+	Uptime int64   `json:"uptime"`
+	Score  float64 `json:"score"`
+}
+
+type Neighbor struct {
+	Identifier  string `json:"identifier"`
+	Address     string `json:"address"`
+	PeeringPort uint16 `json:"peeringPort"`
+	GossipPort  uint16 `json:"gossipPort"`
+	Distance    uint64 `json:"distance"`
+}
+
+type NeighborSet struct {
+	Neighbors []*Neighbor `json:"neighbors"`
+	// nil if the set is empty
+	FurthestNeighbor *Neighbor `json:"furthestNeighbor,omitempty"`
+}
+
+type Salt struct {
+	ExpirationTime time.Time `json:"expirationTime"`
+	// the remaining lifetime in seconds
+	ExpiresIn int64 `json:"expiresIn"`
+}
+
+type Salts struct {
+	Public  Salt `json:"public"`
+	Private Salt `json:"private"`
+}
@@ -6,20 +6,27 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/salt"
 )
 
 var DISTANCE = func(anchor *peer.Peer) func(p *peer.Peer) uint64 {
 	return func(p *peer.Peer) uint64 {
-		saltedIdentifier := make([]byte, len(anchor.Identity.Identifier)+len(saltmanager.PRIVATE_SALT.Bytes))
-		copy(saltedIdentifier[0:], anchor.Identity.Identifier)
-		copy(saltedIdentifier[len(anchor.Identity.Identifier):], saltmanager.PRIVATE_SALT.Bytes)
-
-		return hash(saltedIdentifier) ^ hash(p.Identity.Identifier)
+		return GetSaltedDistance(anchor, saltmanager.PRIVATE_SALT, p)
 	}
 }
 
 var OWN_DISTANCE func(p *peer.Peer) uint64
 
+// Returns the distance of the peer to the anchor when the identifier of the anchor is salted with the given (private)
+// salt - this allows other nodes (i.e. the simulation) to use the same metric without the global salt.
+func GetSaltedDistance(anchor *peer.Peer, privateSalt *salt.Salt, p *peer.Peer) uint64 {
+	saltedIdentifier := make([]byte, len(anchor.Identity.Identifier)+len(privateSalt.Bytes))
+	copy(saltedIdentifier[0:], anchor.Identity.Identifier)
+	copy(saltedIdentifier[len(anchor.Identity.Identifier):], privateSalt.Bytes)
+
+	return hash(saltedIdentifier) ^ hash(p.Identity.Identifier)
+}
+
 func configureOwnDistance() {
 	OWN_DISTANCE = DISTANCE(ownpeer.INSTANCE)
 }
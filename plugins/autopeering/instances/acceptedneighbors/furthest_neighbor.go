@@ -13,6 +13,14 @@ var FURTHEST_NEIGHBOR_DISTANCE = uint64(0)
 
 var FurthestNeighborLock sync.RWMutex
 
+// Returns the neighbor with the largest distance to us and its distance (nil if there are no neighbors).
+func GetFurthestNeighbor() (*peer.Peer, uint64) {
+	FurthestNeighborLock.RLock()
+	defer FurthestNeighborLock.RUnlock()
+
+	return FURTHEST_NEIGHBOR, FURTHEST_NEIGHBOR_DISTANCE
+}
+
 func configureFurthestNeighbor() {
 	INSTANCE.Events.Add.Attach(events.NewClosure(func(p *peer.Peer) {
 		FurthestNeighborLock.Lock()
@@ -5,4 +5,5 @@ import "github.com/iotaledger/goshimmer/packages/node"
 func Configure(plugin *node.Plugin) {
 	configureOwnDistance()
 	configureFurthestNeighbor()
+	configureReevaluation()
 }
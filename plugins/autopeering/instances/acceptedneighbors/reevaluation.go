@@ -0,0 +1,28 @@
+package acceptedneighbors
+
+import (
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/salt"
+)
+
+func configureReevaluation() {
+	saltmanager.Events.UpdatePrivateSalt.Attach(events.NewClosure(func(*salt.Salt) {
+		Reevaluate()
+	}))
+}
+
+// Recomputes the furthest neighbor after the distances changed (our private salt is part of the distance, so all
+// distances change when it is rotated).
+func Reevaluate() {
+	defer INSTANCE.Lock()()
+
+	FurthestNeighborLock.Lock()
+	defer FurthestNeighborLock.Unlock()
+
+	FURTHEST_NEIGHBOR = nil
+	FURTHEST_NEIGHBOR_DISTANCE = uint64(0)
+	for _, neighbor := range INSTANCE.Peers {
+		updateFurthestNeighbor(neighbor)
+	}
+}
@@ -1,8 +1,12 @@
 package chosenneighbors
 
 import (
+	"sort"
+
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/neighborhood"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerlist"
 )
 
@@ -15,5 +19,33 @@ func configureCandidates() {
 }
 
 func updateNeighborCandidates() {
-	CANDIDATES = neighborhood.LIST_INSTANCE.Sort(DISTANCE(ownpeer.INSTANCE))
+	CANDIDATES = SortCandidates(neighborhood.LIST_INSTANCE.Clone(), ownpeer.INSTANCE, knownpeers.IsUnreliable)
+}
+
+// Sorts the candidates by their distance to the anchor while peers of the cluster of the anchor are preferred over
+// peers of other clusters and unreliable peers (that failed to answer repeatedly) are only contacted after the reliable
+// ones.
+func SortCandidates(candidates peerlist.PeerList, anchor *peer.Peer, isUnreliable func(p *peer.Peer) bool) peerlist.PeerList {
+	distance := DISTANCE(anchor)
+
+	unreliable := make(map[string]bool, len(candidates))
+	for _, candidate := range candidates {
+		unreliable[candidate.Identity.StringIdentifier] = isUnreliable(candidate)
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		iCrossCluster, jCrossCluster := neighborhood.IsCrossClusterPeerOf(anchor, candidates[i]), neighborhood.IsCrossClusterPeerOf(anchor, candidates[j])
+		if iCrossCluster != jCrossCluster {
+			return jCrossCluster
+		}
+
+		iUnreliable, jUnreliable := unreliable[candidates[i].Identity.StringIdentifier], unreliable[candidates[j].Identity.StringIdentifier]
+		if iUnreliable != jUnreliable {
+			return jUnreliable
+		}
+
+		return distance(candidates[i]) < distance(candidates[j])
+	})
+
+	return candidates
 }
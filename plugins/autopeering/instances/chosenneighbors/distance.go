@@ -7,13 +7,16 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
 )
 
+// Returns the metric that the chosen neighbors of the anchor are selected by. The identifier of the anchor is salted with
+// its public salt, so every rotation of the public salt reshuffles the chosen neighbors (the salted identifier used to be
+// computed but not hashed, which made the distance - and therefore the neighbors - independent of the salt).
 var DISTANCE = func(anchor *peer.Peer) func(p *peer.Peer) uint64 {
 	return func(p *peer.Peer) uint64 {
 		saltedIdentifier := make([]byte, len(anchor.Identity.Identifier)+len(anchor.Salt.Bytes))
 		copy(saltedIdentifier[0:], anchor.Identity.Identifier)
 		copy(saltedIdentifier[len(anchor.Identity.Identifier):], anchor.Salt.Bytes)
 
-		return hash(anchor.Identity.Identifier) ^ hash(p.Identity.Identifier)
+		return hash(saltedIdentifier) ^ hash(p.Identity.Identifier)
 	}
 }
 
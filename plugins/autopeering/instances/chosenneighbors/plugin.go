@@ -8,4 +8,5 @@ func Configure(plugin *node.Plugin) {
 	configureCandidates()
 	configureOwnDistance()
 	configureFurthestNeighbor()
+	configureReevaluation()
 }
@@ -0,0 +1,32 @@
+package chosenneighbors
+
+import (
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/salt"
+)
+
+func configureReevaluation() {
+	saltmanager.Events.UpdatePublicSalt.Attach(events.NewClosure(func(*salt.Salt) {
+		Reevaluate()
+	}))
+}
+
+// Recomputes the furthest neighbor and the order of the candidates after the distances changed (our public salt is
+// part of the distance, so all distances change when it is rotated).
+func Reevaluate() {
+	defer INSTANCE.Lock()()
+
+	FurthestNeighborLock.Lock()
+	FURTHEST_NEIGHBOR = nil
+	FURTHEST_NEIGHBOR_DISTANCE = uint64(0)
+	for _, neighbor := range INSTANCE.Peers {
+		if distance := OWN_DISTANCE(neighbor); distance > FURTHEST_NEIGHBOR_DISTANCE {
+			FURTHEST_NEIGHBOR = neighbor
+			FURTHEST_NEIGHBOR_DISTANCE = distance
+		}
+	}
+	FurthestNeighborLock.Unlock()
+
+	updateNeighborCandidates()
+}
@@ -22,7 +22,7 @@ func Configure(node *node.Plugin) {
 func parseEntryNodes() peerlist.PeerList {
 	result := make(peerlist.PeerList, 0)
 
-	for _, entryNodeDefinition := range strings.Fields(*parameters.ENTRY_NODES.Value) {
+	for _, entryNodeDefinition := range *parameters.ENTRY_NODES.Value {
 		if entryNodeDefinition == "" {
 			continue
 		}
@@ -44,40 +44,25 @@ func parseEntryNodes() peerlist.PeerList {
 			}
 		}
 
-		addressBits := strings.Split(identityBits[1], ":")
-		switch len(addressBits) {
-		case 2:
-			host := addressBits[0]
-			port, err := strconv.Atoi(addressBits[1])
-			if err != nil {
-				panic("error while parsing port of entry in list of entry nodes")
-			}
-
-			ip := net.ParseIP(host)
-			if ip == nil {
-				panic("error while parsing ip of entry in list of entry nodes")
-			}
-
-			entryNode.Address = ip
-			entryNode.PeeringPort = uint16(port)
-		case 6:
-			host := strings.Join(addressBits[:5], ":")
-			port, err := strconv.Atoi(addressBits[5])
-			if err != nil {
-				panic("error while parsing port of entry in list of entry nodes")
-			}
+		// IPv6 addresses have to be enclosed in square brackets ([::1]:14626)
+		host, portString, err := net.SplitHostPort(identityBits[1])
+		if err != nil {
+			panic("invalid entry in list of trusted entry nodes: " + entryNodeDefinition)
+		}
 
-			ip := net.ParseIP(host)
-			if ip == nil {
-				panic("error while parsing ip of entry in list of entry nodes")
-			}
+		port, err := strconv.Atoi(portString)
+		if err != nil {
+			panic("error while parsing port of entry in list of entry nodes")
+		}
 
-			entryNode.Address = ip
-			entryNode.PeeringPort = uint16(port)
-		default:
-			panic("invalid entry in list of trusted entry nodes: " + entryNodeDefinition)
+		ip := net.ParseIP(host)
+		if ip == nil {
+			panic("error while parsing ip of entry in list of entry nodes")
 		}
 
+		entryNode.Address = ip
+		entryNode.PeeringPort = uint16(port)
+
 		result = append(result, entryNode)
 	}
 
@@ -10,6 +10,8 @@ var INSTANCE *peerregister.PeerRegister
 
 func Configure(plugin *node.Plugin) {
 	INSTANCE = initKnownPeers()
+
+	configureReputations()
 }
 
 func initKnownPeers() *peerregister.PeerRegister {
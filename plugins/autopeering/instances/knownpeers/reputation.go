@@ -0,0 +1,123 @@
+package knownpeers
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/parameters"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/reputation"
+)
+
+func configureReputations() {
+	reputation.HALF_LIFE = *parameters.REPUTATION_HALF_LIFE.Value
+
+	INSTANCE.Events.Remove.Attach(events.NewClosure(func(p *peer.Peer) {
+		RemoveReputation(p.Identity.StringIdentifier)
+	}))
+}
+
+// Returns the reputation of the peer with the given identifier (it gets created if it does not exist, yet).
+func GetReputation(identifier string) *reputation.Reputation {
+	reputationsMutex.RLock()
+	result, exists := reputations[identifier]
+	reputationsMutex.RUnlock()
+
+	if !exists {
+		reputationsMutex.Lock()
+		if result, exists = reputations[identifier]; !exists {
+			result = reputation.New(time.Now())
+
+			reputations[identifier] = result
+		}
+		reputationsMutex.Unlock()
+	}
+
+	return result
+}
+
+// Returns all recorded reputations by the identifier of their peer.
+func GetReputations() map[string]*reputation.Reputation {
+	reputationsMutex.RLock()
+	defer reputationsMutex.RUnlock()
+
+	result := make(map[string]*reputation.Reputation, len(reputations))
+	for identifier, peerReputation := range reputations {
+		result[identifier] = peerReputation
+	}
+
+	return result
+}
+
+// Sets a reputation (i.e. when restoring it from the database).
+func SetReputation(identifier string, peerReputation *reputation.Reputation) {
+	reputationsMutex.Lock()
+	reputations[identifier] = peerReputation
+	reputationsMutex.Unlock()
+}
+
+func RemoveReputation(identifier string) {
+	reputationsMutex.Lock()
+	delete(reputations, identifier)
+	reputationsMutex.Unlock()
+}
+
+// Returns the reputation score of the peer (peers without reputation have a neutral score).
+func GetScore(p *peer.Peer) float64 {
+	reputationsMutex.RLock()
+	peerReputation, exists := reputations[p.Identity.StringIdentifier]
+	reputationsMutex.RUnlock()
+
+	if !exists {
+		return reputation.NEUTRAL_SCORE
+	}
+
+	return peerReputation.GetScore(time.Now())
+}
+
+// Returns the last time that we heard from the peer (the zero time if we never did).
+func GetLastSeen(p *peer.Peer) time.Time {
+	reputationsMutex.RLock()
+	peerReputation, exists := reputations[p.Identity.StringIdentifier]
+	reputationsMutex.RUnlock()
+
+	if !exists {
+		return time.Time{}
+	}
+
+	return peerReputation.GetLastSeen()
+}
+
+// Returns true if the peer failed so often that it should only be contacted after all other candidates.
+func IsUnreliable(p *peer.Peer) bool {
+	return GetScore(p) < reputation.UNRELIABLE_SCORE
+}
+
+func RecordSeen(p *peer.Peer) {
+	GetReputation(p.Identity.StringIdentifier).RecordSeen(time.Now())
+}
+
+func RecordPing(p *peer.Peer, success bool) {
+	GetReputation(p.Identity.StringIdentifier).RecordPing(time.Now(), success)
+}
+
+func RecordPingSent(p *peer.Peer) {
+	GetReputation(p.Identity.StringIdentifier).RecordPingSent(time.Now())
+}
+
+func RecordRequestSent(p *peer.Peer) {
+	GetReputation(p.Identity.StringIdentifier).RecordRequestSent(time.Now())
+}
+
+func RecordResponse(p *peer.Peer) {
+	GetReputation(p.Identity.StringIdentifier).RecordResponse(time.Now())
+}
+
+func RecordRequestFailure(p *peer.Peer) {
+	GetReputation(p.Identity.StringIdentifier).RecordRequestFailure(time.Now())
+}
+
+var reputations = make(map[string]*reputation.Reputation)
+
+var reputationsMutex sync.RWMutex
@@ -0,0 +1,5 @@
+package neighborchurn
+
+const (
+	MAX_RECORDED_ROTATIONS = 48
+)
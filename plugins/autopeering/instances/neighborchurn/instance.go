@@ -0,0 +1,45 @@
+package neighborchurn
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/acceptedneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/salt"
+)
+
+// the public salt determines the distance to the chosen neighbors
+var CHOSEN_NEIGHBORS = NewRotationHistory()
+
+// the private salt determines the distance to the accepted neighbors
+var ACCEPTED_NEIGHBORS = NewRotationHistory()
+
+// Measures how many neighbors get replaced after every salt rotation (neighbors that went offline are counted as
+// well, so the numbers are an upper bound for the churn caused by the rotation).
+func Configure(plugin *node.Plugin) {
+	saltmanager.Events.UpdatePublicSalt.Attach(events.NewClosure(func(*salt.Salt) {
+		recordRotation(plugin, "public", CHOSEN_NEIGHBORS, chosenneighbors.INSTANCE.Len())
+	}))
+	saltmanager.Events.UpdatePrivateSalt.Attach(events.NewClosure(func(*salt.Salt) {
+		recordRotation(plugin, "private", ACCEPTED_NEIGHBORS, acceptedneighbors.INSTANCE.Len())
+	}))
+
+	chosenneighbors.INSTANCE.Events.Remove.Attach(events.NewClosure(func(*peer.Peer) {
+		CHOSEN_NEIGHBORS.RecordReplacement()
+	}))
+	acceptedneighbors.INSTANCE.Events.Remove.Attach(events.NewClosure(func(*peer.Peer) {
+		ACCEPTED_NEIGHBORS.RecordReplacement()
+	}))
+}
+
+func recordRotation(plugin *node.Plugin, saltName string, history *RotationHistory, neighborCount int) {
+	if lastRotation, exists := history.GetLastRotation(); exists {
+		plugin.Log.Info("neighbors were replaced since the last salt rotation", "salt", saltName, "replacedNeighbors", lastRotation.ReplacedNeighbors, "neighborsBefore", lastRotation.NeighborsBefore)
+	}
+
+	history.RecordRotation(time.Now(), neighborCount)
+}
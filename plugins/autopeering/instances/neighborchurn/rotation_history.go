@@ -0,0 +1,85 @@
+package neighborchurn
+
+import (
+	"sync"
+	"time"
+)
+
+// Rotation records how many neighbors were replaced after a salt rotation (until the next rotation of the same salt).
+type Rotation struct {
+	Time              time.Time `json:"time"`
+	NeighborsBefore   int       `json:"neighborsBefore"`
+	ReplacedNeighbors int       `json:"replacedNeighbors"`
+}
+
+// RotationHistory keeps the churn statistics of the last rotations of a salt.
+type RotationHistory struct {
+	rotations         []*Rotation
+	totalReplacements uint64
+	mutex             sync.RWMutex
+}
+
+func NewRotationHistory() *RotationHistory {
+	return &RotationHistory{
+		rotations: make([]*Rotation, 0),
+	}
+}
+
+// Starts a new rotation (the oldest rotation is forgotten if there are more than MAX_RECORDED_ROTATIONS).
+func (history *RotationHistory) RecordRotation(now time.Time, neighborCount int) {
+	history.mutex.Lock()
+	defer history.mutex.Unlock()
+
+	history.rotations = append(history.rotations, &Rotation{
+		Time:            now,
+		NeighborsBefore: neighborCount,
+	})
+
+	if len(history.rotations) > MAX_RECORDED_ROTATIONS {
+		history.rotations = history.rotations[len(history.rotations)-MAX_RECORDED_ROTATIONS:]
+	}
+}
+
+// Counts a neighbor that was removed - it is attributed to the last rotation.
+func (history *RotationHistory) RecordReplacement() {
+	history.mutex.Lock()
+	defer history.mutex.Unlock()
+
+	history.totalReplacements++
+
+	if len(history.rotations) != 0 {
+		history.rotations[len(history.rotations)-1].ReplacedNeighbors++
+	}
+}
+
+// Returns copies of the recorded rotations (oldest first).
+func (history *RotationHistory) GetRotations() []Rotation {
+	history.mutex.RLock()
+	defer history.mutex.RUnlock()
+
+	result := make([]Rotation, len(history.rotations))
+	for i, rotation := range history.rotations {
+		result[i] = *rotation
+	}
+
+	return result
+}
+
+// Returns the last rotation (false if there was no rotation, yet).
+func (history *RotationHistory) GetLastRotation() (Rotation, bool) {
+	history.mutex.RLock()
+	defer history.mutex.RUnlock()
+
+	if len(history.rotations) == 0 {
+		return Rotation{}, false
+	}
+
+	return *history.rotations[len(history.rotations)-1], true
+}
+
+func (history *RotationHistory) GetTotalReplacements() uint64 {
+	history.mutex.RLock()
+	defer history.mutex.RUnlock()
+
+	return history.totalReplacements
+}
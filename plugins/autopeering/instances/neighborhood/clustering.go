@@ -0,0 +1,128 @@
+package neighborhood
+
+import (
+	"hash/fnv"
+	"sort"
+
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/parameters"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/constants"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerregister"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/request"
+)
+
+// Groups the known peers by their declared cluster: all peers of the cluster of the issuer are kept, while peers of
+// other clusters are only kept up to the configured share of cross cluster links (a node that does not declare a
+// cluster keeps all peers).
+func selectClusterNeighborhood(this *peerregister.PeerRegister, req *request.Request) *peerregister.PeerRegister {
+	return selectByCluster(this, req.Issuer, int(*parameters.CROSS_CLUSTER_LINKS.Value))
+}
+
+// Returns true if we declared a cluster and the peer does not belong to it.
+func IsCrossClusterPeer(p *peer.Peer) bool {
+	return IsCrossClusterPeerOf(ownpeer.INSTANCE, p)
+}
+
+// Returns true if the anchor declared a cluster and the peer does not belong to it.
+func IsCrossClusterPeerOf(anchor *peer.Peer, p *peer.Peer) bool {
+	return anchor.ClusterIdentifier != nil && !anchor.SharesClusterWith(p)
+}
+
+// Returns the maximum number of cross cluster neighbors for the given amount of neighbor slots (at least one link is
+// kept if the share is larger than 0, so that the clusters stay connected).
+func GetMaxCrossClusterNeighbors(neighborSlots int) int {
+	return getMaxCrossClusterNeighbors(neighborSlots, int(*parameters.CROSS_CLUSTER_LINKS.Value))
+}
+
+// Returns the amount of peers of the register that belong to another cluster than ours.
+func CountCrossClusterPeers(peers *peerregister.PeerRegister) int {
+	return countCrossClusterPeers(ownpeer.INSTANCE, peers)
+}
+
+// Returns true if the peer can be added to the given neighbors without exceeding the share of cross cluster links.
+func AcceptsCrossClusterLink(neighbors *peerregister.PeerRegister, p *peer.Peer) bool {
+	return AcceptsCrossClusterLinkOf(ownpeer.INSTANCE, neighbors, p, int(*parameters.CROSS_CLUSTER_LINKS.Value))
+}
+
+// Returns true if the peer can be added to the neighbors of the anchor without exceeding the given share of cross
+// cluster links.
+func AcceptsCrossClusterLinkOf(anchor *peer.Peer, neighbors *peerregister.PeerRegister, p *peer.Peer, crossClusterPercentage int) bool {
+	return !IsCrossClusterPeerOf(anchor, p) || neighbors.Contains(p.Identity.StringIdentifier) ||
+		countCrossClusterPeers(anchor, neighbors) < getMaxCrossClusterNeighbors(constants.NEIGHBOR_COUNT/2, crossClusterPercentage)
+}
+
+func selectByCluster(peers *peerregister.PeerRegister, anchor *peer.Peer, crossClusterPercentage int) *peerregister.PeerRegister {
+	filteredPeers := peerregister.New()
+
+	crossClusterPeers := make([]*peer.Peer, 0)
+	for id, p := range peers.Peers {
+		if IsCrossClusterPeerOf(anchor, p) {
+			crossClusterPeers = append(crossClusterPeers, p)
+		} else {
+			filteredPeers.Peers[id] = p
+		}
+	}
+
+	// as long as we do not know any peers of our own cluster, we stay connected to the rest of the network
+	crossClusterLimit := len(crossClusterPeers)
+	if len(filteredPeers.Peers) != 0 {
+		crossClusterLimit = getCrossClusterLimit(len(filteredPeers.Peers), crossClusterPercentage)
+	}
+
+	// the cross cluster peers are selected by their distance to the anchor, so the selection stays stable over time
+	anchorHash := hashIdentifier(anchor.Identity.Identifier)
+	sort.Slice(crossClusterPeers, func(i, j int) bool {
+		return anchorHash^hashIdentifier(crossClusterPeers[i].Identity.Identifier) < anchorHash^hashIdentifier(crossClusterPeers[j].Identity.Identifier)
+	})
+
+	for i := 0; i < crossClusterLimit && i < len(crossClusterPeers); i++ {
+		filteredPeers.Peers[crossClusterPeers[i].Identity.StringIdentifier] = crossClusterPeers[i]
+	}
+
+	return filteredPeers
+}
+
+func countCrossClusterPeers(anchor *peer.Peer, peers *peerregister.PeerRegister) (result int) {
+	for _, p := range peers.Peers {
+		if IsCrossClusterPeerOf(anchor, p) {
+			result++
+		}
+	}
+
+	return
+}
+
+// returns the amount of cross cluster peers that make up the given percentage when added to the same cluster peers
+func getCrossClusterLimit(sameClusterPeers int, crossClusterPercentage int) int {
+	switch {
+	case crossClusterPercentage <= 0:
+		return 0
+	case crossClusterPercentage >= 100:
+		return int(^uint(0) >> 1)
+	default:
+		return (sameClusterPeers*crossClusterPercentage + 100 - crossClusterPercentage - 1) / (100 - crossClusterPercentage)
+	}
+}
+
+func getMaxCrossClusterNeighbors(neighborSlots int, crossClusterPercentage int) int {
+	switch {
+	case crossClusterPercentage <= 0:
+		return 0
+	case crossClusterPercentage >= 100:
+		return neighborSlots
+	default:
+		if result := neighborSlots * crossClusterPercentage / 100; result > 0 {
+			return result
+		}
+
+		return 1
+	}
+}
+
+func hashIdentifier(data []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(data)
+
+	return h.Sum64()
+}
@@ -0,0 +1,91 @@
+package neighborhood
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/iotaledger/goshimmer/packages/identity"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerregister"
+)
+
+func TestSelectByCluster(t *testing.T) {
+	clusterA := []byte("cluster A")
+	clusterB := []byte("cluster B")
+
+	anchor := newClusterPeer(clusterA)
+
+	peers := peerregister.New()
+	for i := 0; i < 6; i++ {
+		p := newClusterPeer(clusterA)
+		peers.Peers[p.Identity.StringIdentifier] = p
+	}
+	for i := 0; i < 10; i++ {
+		p := newClusterPeer(clusterB)
+		peers.Peers[p.Identity.StringIdentifier] = p
+	}
+
+	for crossClusterPercentage, expectedCrossClusterPeers := range map[int]int{0: 0, 25: 2, 50: 6, 100: 10} {
+		selectedPeers := selectByCluster(peers, anchor, crossClusterPercentage)
+
+		crossClusterPeers := 0
+		for _, p := range selectedPeers.Peers {
+			if IsCrossClusterPeerOf(anchor, p) {
+				crossClusterPeers++
+			}
+		}
+
+		if len(selectedPeers.Peers)-crossClusterPeers != 6 {
+			t.Error("peers of the own cluster were dropped", crossClusterPercentage)
+		}
+		if crossClusterPeers != expectedCrossClusterPeers {
+			t.Error("unexpected amount of cross cluster peers for "+strconv.Itoa(crossClusterPercentage)+"%", crossClusterPeers)
+		}
+	}
+
+	// the selection of cross cluster peers is stable
+	firstSelection := selectByCluster(peers, anchor, 25)
+	for id := range selectByCluster(peers, anchor, 25).Peers {
+		if !firstSelection.Contains(id) {
+			t.Error("selection of cross cluster peers is not stable")
+		}
+	}
+
+	// peers without a declared cluster keep all peers and a new cluster stays connected to the rest of the network
+	if len(selectByCluster(peers, newClusterPeer(nil), 0).Peers) != 16 {
+		t.Error("peer without cluster did not keep all peers")
+	}
+	if len(selectByCluster(peers, newClusterPeer([]byte("cluster C")), 0).Peers) != 16 {
+		t.Error("peer of an unknown cluster did not keep all peers")
+	}
+}
+
+func TestGetMaxCrossClusterNeighbors(t *testing.T) {
+	for crossClusterPercentage, expectedResult := range map[int]int{0: 0, 10: 1, 25: 1, 50: 2, 100: 4} {
+		if result := getMaxCrossClusterNeighbors(4, crossClusterPercentage); result != expectedResult {
+			t.Error("unexpected amount of cross cluster neighbors for "+strconv.Itoa(crossClusterPercentage)+"%", result)
+		}
+	}
+}
+
+func newClusterPeer(clusterIdentifier []byte) *peer.Peer {
+	return &peer.Peer{
+		Identity:          identity.GenerateRandomIdentity(),
+		ClusterIdentifier: clusterIdentifier,
+	}
+}
+
+func TestApplyHysteresis(t *testing.T) {
+	if ApplyHysteresis(1000, 10) != 900 {
+		t.Error("wrong replacement distance", ApplyHysteresis(1000, 10))
+	}
+	if ApplyHysteresis(1000, 0) != 1000 || ApplyHysteresis(1000, -5) != 1000 {
+		t.Error("a hysteresis of 0 should not change the distance")
+	}
+	if ApplyHysteresis(1000, 150) != 0 {
+		t.Error("the hysteresis should be clamped to 100 percent")
+	}
+	if replacementDistance := ApplyHysteresis(^uint64(0), 50); replacementDistance < ^uint64(0)/100*49 || replacementDistance > ^uint64(0)/100*51 {
+		t.Error("overflow for large distances", ApplyHysteresis(^uint64(0), 50))
+	}
+}
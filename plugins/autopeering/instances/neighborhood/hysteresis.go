@@ -0,0 +1,23 @@
+package neighborhood
+
+import (
+	"github.com/iotaledger/goshimmer/plugins/autopeering/parameters"
+)
+
+// Returns the distance that a peer has to fall below to replace the furthest neighbor - requiring a peer to be
+// noticeably closer keeps us from swapping neighbors whose distances barely differ (i.e. after a salt rotation).
+func GetReplacementDistance(furthestNeighborDistance uint64) uint64 {
+	return ApplyHysteresis(furthestNeighborDistance, int(*parameters.NEIGHBOR_HYSTERESIS.Value))
+}
+
+// Reduces the distance by the given percentage (values outside of 0 - 100 are clamped).
+func ApplyHysteresis(distance uint64, hysteresisPercentage int) uint64 {
+	switch {
+	case hysteresisPercentage <= 0:
+		return distance
+	case hysteresisPercentage >= 100:
+		return 0
+	default:
+		return distance - distance/100*uint64(hysteresisPercentage)
+	}
+}
@@ -5,12 +5,12 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/timeutil"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/outgoingrequest"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerlist"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerregister"
-	"github.com/iotaledger/goshimmer/plugins/autopeering/types/request"
 )
 
 var INSTANCE *peerregister.PeerRegister
@@ -18,30 +18,28 @@ var INSTANCE *peerregister.PeerRegister
 var LIST_INSTANCE peerlist.PeerList
 
 // Selects a fixed neighborhood from all known peers - this allows nodes to "stay in the same circles" that share their
-// view on the ledger an is a preparation for economic clustering
-var NEIGHBORHOOD_SELECTOR = func(this *peerregister.PeerRegister, req *request.Request) *peerregister.PeerRegister {
-	filteredPeers := peerregister.New()
-	for id, peer := range this.Peers {
-		filteredPeers.Peers[id] = peer
-	}
-
-	return filteredPeers
-}
+// view on the ledger (economic clustering) while a share of cross cluster peers keeps the network connected
+var NEIGHBORHOOD_SELECTOR = selectClusterNeighborhood
 
 var lastUpdate = time.Now()
 
+// the amount of known peers at the last update (the neighborhood itself can be smaller due to the cluster selection)
+var lastKnownPeerCount int
+
 func Configure(plugin *node.Plugin) {
 	updateNeighborHood()
 }
 
 func Run(plugin *node.Plugin) {
-	daemon.BackgroundWorker("Neighborhood Updater", func() {
-		timeutil.Ticker(updateNeighborHood, 1*time.Second)
-	})
+	daemon.BackgroundWorker("Neighborhood Updater", func(shutdownSignal <-chan struct{}) {
+		timeutil.Ticker(updateNeighborHood, 1*time.Second, shutdownSignal)
+	}, shutdown.PRIORITY_AUTOPEERING)
 }
 
 func updateNeighborHood() {
-	if INSTANCE == nil || float64(len(INSTANCE.Peers))*1.2 <= float64(len(knownpeers.INSTANCE.Peers)) || lastUpdate.Before(time.Now().Add(-300*time.Second)) {
+	if INSTANCE == nil || float64(lastKnownPeerCount)*1.2 <= float64(len(knownpeers.INSTANCE.Peers)) || lastUpdate.Before(time.Now().Add(-300*time.Second)) {
+		lastKnownPeerCount = len(knownpeers.INSTANCE.Peers)
+
 		INSTANCE = knownpeers.INSTANCE.Filter(NEIGHBORHOOD_SELECTOR, outgoingrequest.INSTANCE)
 		LIST_INSTANCE = INSTANCE.List()
 
@@ -1,23 +1,28 @@
 package outgoingrequest
 
 import (
-	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
-	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/request"
-	"github.com/iotaledger/goshimmer/plugins/autopeering/types/salt"
 )
 
+// the (unsigned) request that describes our own peer (i.e. for selecting our neighborhood)
 var INSTANCE *request.Request
 
 func Configure(plugin *node.Plugin) {
 	INSTANCE = &request.Request{
 		Issuer: ownpeer.INSTANCE,
 	}
-	INSTANCE.Sign()
+}
+
+// Returns a freshly signed request for the recipient - receivers reject requests with a stamp they have seen before
+// (or that is addressed to another node), so the same marshaled request cannot be sent twice.
+func MarshalSigned(recipient *peer.Peer) []byte {
+	signedRequest := &request.Request{
+		Issuer: ownpeer.Snapshot(),
+	}
+	signedRequest.Sign(recipient.Identity)
 
-	saltmanager.Events.UpdatePublicSalt.Attach(events.NewClosure(func(salt *salt.Salt) {
-		INSTANCE.Sign()
-	}))
+	return signedRequest.Marshal()
 }
@@ -0,0 +1,200 @@
+package ownpeer
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/parameters"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/addressconsensus"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+)
+
+var Events = struct {
+	// triggered when the address that we advertise changes
+	UpdateAddress *events.Event
+	// triggered when the ports that we advertise change (i.e. because the NAT gateway mapped different ports)
+	UpdatePorts *events.Event
+}{
+	UpdateAddress: events.NewEvent(addressCaller),
+	UpdatePorts:   events.NewEvent(portsCaller),
+}
+
+func configureExternalAddress(plugin *node.Plugin) {
+	externalAddressConfigured = strings.TrimSpace(*parameters.EXTERNAL_ADDRESS.Value) != ""
+
+	ipv4Consensus = addressconsensus.New(*parameters.EXTERNAL_ADDRESS_MIN_VOTES.Value, addressconsensus.DEFAULT_OBSERVATION_TTL)
+	ipv6Consensus = addressconsensus.New(*parameters.EXTERNAL_ADDRESS_MIN_VOTES.Value, addressconsensus.DEFAULT_OBSERVATION_TTL)
+
+	Events.UpdateAddress.Attach(events.NewClosure(func(address net.IP) {
+		plugin.Log.Info("advertising external address", "address", address)
+	}))
+	Events.UpdatePorts.Attach(events.NewClosure(func(peeringPort uint16, gossipPort uint16) {
+		plugin.Log.Info("advertising external ports", "peeringPort", peeringPort, "gossipPort", gossipPort)
+	}))
+}
+
+// Records the source address that another peer observed when we contacted it. Votes are counted per address of the
+// reporter (and not per identity), so that many identities behind the same address can not outvote the others.
+func RecordObservedAddress(reporter *peer.Peer, observedAddress net.IP) {
+	if externalAddressConfigured || reporter.Address == nil || !isPublicAddress(observedAddress) {
+		return
+	}
+
+	consensus := ipv4Consensus
+	if observedAddress.To4() == nil {
+		consensus = ipv6Consensus
+	}
+
+	if _, exists := consensus.AddObservation(reporter.Address.String(), observedAddress, time.Now()); exists {
+		updateExternalAddress()
+	}
+}
+
+// Updates the address that we advertise (i.e. to the external address that the NAT gateway reported).
+func SetAddress(address net.IP) {
+	addressMutex.Lock()
+	if INSTANCE.Address.Equal(address) {
+		addressMutex.Unlock()
+
+		return
+	}
+	INSTANCE.Address = address
+	addressMutex.Unlock()
+
+	Events.UpdateAddress.Trigger(address)
+}
+
+// Updates the ports that we advertise (i.e. to the external ports that the NAT gateway mapped).
+func SetPorts(peeringPort uint16, gossipPort uint16) {
+	addressMutex.Lock()
+	if INSTANCE.PeeringPort == peeringPort && INSTANCE.GossipPort == gossipPort {
+		addressMutex.Unlock()
+
+		return
+	}
+	INSTANCE.PeeringPort = peeringPort
+	INSTANCE.GossipPort = gossipPort
+	addressMutex.Unlock()
+
+	Events.UpdatePorts.Trigger(peeringPort, gossipPort)
+}
+
+// Returns the address that we currently advertise.
+func GetAddress() net.IP {
+	addressMutex.RLock()
+	defer addressMutex.RUnlock()
+
+	return INSTANCE.Address
+}
+
+// Returns the peering port that we currently advertise.
+func GetPeeringPort() uint16 {
+	addressMutex.RLock()
+	defer addressMutex.RUnlock()
+
+	return INSTANCE.PeeringPort
+}
+
+// Returns the gossip port that we currently advertise.
+func GetGossipPort() uint16 {
+	addressMutex.RLock()
+	defer addressMutex.RUnlock()
+
+	return INSTANCE.GossipPort
+}
+
+// Returns a copy of our own peer with the currently advertised address and ports. Messages that describe our peer have
+// to use the copy, since the address and the ports of INSTANCE are updated concurrently (i.e. by the port mapping).
+func Snapshot() *peer.Peer {
+	addressMutex.RLock()
+	defer addressMutex.RUnlock()
+
+	return &peer.Peer{
+		Identity:             INSTANCE.Identity,
+		Address:              INSTANCE.Address,
+		AlternativeAddresses: INSTANCE.AlternativeAddresses,
+		ClusterIdentifier:    INSTANCE.ClusterIdentifier,
+		PeeringPort:          INSTANCE.PeeringPort,
+		GossipPort:           INSTANCE.GossipPort,
+		Salt:                 INSTANCE.Salt,
+	}
+}
+
+// Returns true if the external address was configured manually (it is not updated automatically in that case).
+func IsExternalAddressConfigured() bool {
+	return externalAddressConfigured
+}
+
+// adopts the address that the peers agree on (IPv4 is preferred since most peers are reachable via IPv4 only)
+func updateExternalAddress() {
+	now := time.Now()
+
+	address, exists := ipv4Consensus.GetAddress(now)
+	if !exists {
+		if address, exists = ipv6Consensus.GetAddress(now); !exists {
+			return
+		}
+	}
+
+	SetAddress(address)
+}
+
+// Returns true if the address can be reached from the internet (observations from peers in the same local network are
+// not a hint for our external address).
+func isPublicAddress(address net.IP) bool {
+	if address == nil || !address.IsGlobalUnicast() {
+		return false
+	}
+
+	for _, privateNetwork := range privateNetworks {
+		if privateNetwork.Contains(address) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func addressCaller(handler interface{}, params ...interface{}) {
+	handler.(func(net.IP))(params[0].(net.IP))
+}
+
+func portsCaller(handler interface{}, params ...interface{}) {
+	handler.(func(uint16, uint16))(params[0].(uint16), params[1].(uint16))
+}
+
+var externalAddressConfigured bool
+
+var ipv4Consensus *addressconsensus.AddressConsensus
+
+var ipv6Consensus *addressconsensus.AddressConsensus
+
+var addressMutex sync.RWMutex
+
+var privateNetworks = parseNetworks(
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	// carrier grade NAT
+	"100.64.0.0/10",
+	// unique local IPv6 addresses
+	"fc00::/7",
+)
+
+func parseNetworks(definitions ...string) []*net.IPNet {
+	result := make([]*net.IPNet, len(definitions))
+	for i, definition := range definitions {
+		_, network, err := net.ParseCIDR(definition)
+		if err != nil {
+			panic(err)
+		}
+
+		result[i] = network
+	}
+
+	return result
+}
@@ -1,7 +1,11 @@
 package ownpeer
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"net"
+	"strconv"
+	"strings"
 
 	"github.com/iotaledger/goshimmer/packages/accountability"
 	"github.com/iotaledger/goshimmer/packages/node"
@@ -11,14 +15,70 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/gossip"
 )
 
+// our own peer - its address and ports are updated at runtime, so they must only be read through the getters (or a
+// Snapshot), while the identity, the cluster and the salt can be read directly
 var INSTANCE *peer.Peer
 
 func Configure(plugin *node.Plugin) {
 	INSTANCE = &peer.Peer{
-		Identity:    accountability.OwnId(),
-		PeeringPort: uint16(*parameters.PORT.Value),
-		GossipPort:  uint16(*gossip.PORT.Value),
-		Address:     net.IPv4(0, 0, 0, 0),
-		Salt:        saltmanager.PUBLIC_SALT,
+		Identity:             accountability.OwnId(),
+		PeeringPort:          uint16(*parameters.PORT.Value),
+		GossipPort:           uint16(*gossip.PORT.Value),
+		Address:              parseExternalAddress(),
+		AlternativeAddresses: parseAdvertisedAddresses(),
+		ClusterIdentifier:    parseClusterIdentifier(),
+		Salt:                 saltmanager.PUBLIC_SALT,
 	}
+
+	configureExternalAddress(plugin)
+}
+
+// Returns the configured external address (or the unspecified address if it has to be discovered).
+func parseExternalAddress() net.IP {
+	addressDefinition := strings.TrimSpace(*parameters.EXTERNAL_ADDRESS.Value)
+	if addressDefinition == "" {
+		return net.IPv4(0, 0, 0, 0)
+	}
+
+	address := net.ParseIP(addressDefinition)
+	if address == nil {
+		panic("error while parsing external address: " + addressDefinition)
+	}
+
+	return address
+}
+
+func parseAdvertisedAddresses() []net.IP {
+	result := make([]net.IP, 0)
+
+	for _, addressDefinition := range *parameters.ADVERTISED_ADDRESSES.Value {
+		address := net.ParseIP(addressDefinition)
+		if address == nil {
+			panic("error while parsing advertised address: " + addressDefinition)
+		}
+
+		if len(result) == peer.MARSHALED_ALTERNATIVE_ADDRESSES_COUNT {
+			panic("too many advertised addresses (a peer can advertise at most " + strconv.Itoa(peer.MARSHALED_ALTERNATIVE_ADDRESSES_COUNT) + " further addresses)")
+		}
+
+		result = append(result, address)
+	}
+
+	return result
+}
+
+// Returns the hex encoded cluster fingerprint or the hash of any other (human readable) cluster name.
+func parseClusterIdentifier() []byte {
+	clusterDefinition := strings.TrimSpace(*parameters.CLUSTER.Value)
+	if clusterDefinition == "" {
+		return nil
+	}
+
+	if decodedIdentifier, err := hex.DecodeString(clusterDefinition); err == nil && len(decodedIdentifier) == peer.MARSHALED_CLUSTER_IDENTIFIER_SIZE {
+		return decodedIdentifier
+	}
+
+	hashedIdentifier := sha256.Sum256([]byte(clusterDefinition))
+
+	return hashedIdentifier[:]
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/entrynodes"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/neighborchurn"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/neighborhood"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/outgoingrequest"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
@@ -15,10 +16,11 @@ func Configure(plugin *node.Plugin) {
 	ownpeer.Configure(plugin)
 	entrynodes.Configure(plugin)
 	knownpeers.Configure(plugin)
-	neighborhood.Configure(plugin)
 	outgoingrequest.Configure(plugin)
+	neighborhood.Configure(plugin)
 	chosenneighbors.Configure(plugin)
 	acceptedneighbors.Configure(plugin)
+	neighborchurn.Configure(plugin)
 }
 
 func Run(plugin *node.Plugin) {
@@ -1,11 +1,26 @@
 package parameters
 
-import "github.com/iotaledger/goshimmer/packages/parameter"
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/parameter"
+)
 
 var (
-	ADDRESS         = parameter.AddString("AUTOPEERING/ADDRESS", "0.0.0.0", "address to bind for incoming peering requests")
-	ENTRY_NODES     = parameter.AddString("AUTOPEERING/ENTRY_NODES", "7f7a876a4236091257e650da8dcf195fbe3cb625@159.69.158.51:14626", "list of trusted entry nodes for auto peering")
-	PORT            = parameter.AddInt("AUTOPEERING/PORT", 14626, "tcp port for incoming peering requests")
-	ACCEPT_REQUESTS = parameter.AddBool("AUTOPEERING/ACCEPT_REQUESTS", true, "accept incoming autopeering requests")
-	SEND_REQUESTS   = parameter.AddBool("AUTOPEERING/SEND_REQUESTS", true, "send autopeering requests")
+	ADDRESS                    = parameter.AddStringSlice("AUTOPEERING/ADDRESS", nil, "list of addresses (IPv4 or IPv6) to bind for incoming peering requests (empty = all interfaces)")
+	ADVERTISED_ADDRESSES       = parameter.AddStringSlice("AUTOPEERING/ADVERTISED_ADDRESSES", nil, "list of further addresses (i.e. the IPv6 address of a dual stack node) that other peers can reach us on")
+	EXTERNAL_ADDRESS           = parameter.AddString("AUTOPEERING/EXTERNAL_ADDRESS", "", "public address that other peers can reach us on (empty = discover it from the addresses that other peers observe)")
+	EXTERNAL_ADDRESS_MIN_VOTES = parameter.AddInt("AUTOPEERING/EXTERNAL_ADDRESS_MIN_VOTES", 3, "amount of peers that have to observe the same source address before we advertise it as our external address")
+	NAT_PMP_GATEWAY            = parameter.AddString("AUTOPEERING/NAT_PMP_GATEWAY", "", "address of the router that port mappings are requested from via NAT-PMP (empty = no port mapping)")
+	ENTRY_NODES                = parameter.AddStringSlice("AUTOPEERING/ENTRY_NODES", []string{"7f7a876a4236091257e650da8dcf195fbe3cb625@159.69.158.51:14626"}, "list of trusted entry nodes for auto peering")
+	PORT                       = parameter.AddInt("AUTOPEERING/PORT", 14626, "tcp port for incoming peering requests")
+	ACCEPT_REQUESTS            = parameter.AddBool("AUTOPEERING/ACCEPT_REQUESTS", true, "accept incoming autopeering requests")
+	SEND_REQUESTS              = parameter.AddBool("AUTOPEERING/SEND_REQUESTS", true, "send autopeering requests")
+	CLUSTER                    = parameter.AddString("AUTOPEERING/CLUSTER", "", "identifier of the economic cluster (i.e. a fingerprint of the ledger view) that this node prefers to peer with (empty = no clustering)")
+	REPUTATION_HALF_LIFE       = parameter.AddDuration("AUTOPEERING/REPUTATION_HALF_LIFE", 24*time.Hour, "time after which the recorded successes and failures of a peer lose half of their weight").SetRange(time.Minute, 365*24*time.Hour)
+	DEAD_PEER_TIMEOUT          = parameter.AddDuration("AUTOPEERING/DEAD_PEER_TIMEOUT", 72*time.Hour, "time without any answer after which a failing peer is removed from the known peers").SetRange(time.Minute, 365*24*time.Hour)
+	PUBLIC_SALT_LIFETIME       = parameter.AddDuration("AUTOPEERING/PUBLIC_SALT_LIFETIME", 30*time.Minute, "time after which the public salt (that determines which neighbors we choose) is replaced")
+	PRIVATE_SALT_LIFETIME      = parameter.AddDuration("AUTOPEERING/PRIVATE_SALT_LIFETIME", 30*time.Minute, "time after which the private salt (that determines which neighbors we accept) is replaced")
+	NEIGHBOR_HYSTERESIS        = parameter.AddUint("AUTOPEERING/NEIGHBOR_HYSTERESIS", 10, "percentage by which a peer has to be closer than our furthest neighbor to replace it (limits the neighbor churn after salt rotations)").SetRange(0, 100)
+	CROSS_CLUSTER_LINKS        = parameter.AddUint("AUTOPEERING/CROSS_CLUSTER_LINKS", 25, "percentage of the neighbors that are chosen from other clusters to keep the network connected").SetRange(0, 100)
 )
@@ -2,12 +2,14 @@ package peerstorage
 
 import (
 	"bytes"
-	"strconv"
 	"sync"
 
+	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/database"
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/timeutil"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
 )
@@ -46,34 +48,53 @@ func removePeer(p *peer.Peer) {
 	}
 }
 
+// restores the known peers from the database - entries that can not be decoded (i.e. because they were stored by a
+// version with a different peer format, see peer.MARSHALED_TOTAL_SIZE) are removed instead of being restored
 func loadPeers(plugin *node.Plugin) {
 	var count int
+	var invalidKeys [][]byte
 
 	err := getDb().ForEach(func(key []byte, value []byte) {
 		peer, err := peer.Unmarshal(value)
 		if err != nil {
-			panic(err)
+			plugin.Log.Failure("Invalid item in database", "database", peerDbName, "error", err)
+
+			invalidKeys = append(invalidKeys, append([]byte{}, key...))
+
+			return
 		}
 		// the peers are stored by identifier in the db
 		if !bytes.Equal(key, peer.Identity.Identifier) {
-			panic("Invalid item in '" + peerDbName + "' database")
+			plugin.Log.Failure("Invalid item in database: identifier does not match the key", "database", peerDbName)
+
+			invalidKeys = append(invalidKeys, append([]byte{}, key...))
+
+			return
 		}
 
 		knownpeers.INSTANCE.AddOrUpdate(peer)
 		count++
-		plugin.LogDebug("Added stored peer: " + peer.Address.String() + " / " + peer.Identity.StringIdentifier)
+		plugin.Log.Debug("Added stored peer", "address", peer.Address, "identifier", peer.Identity.StringIdentifier)
 	})
 	if err != nil {
 		panic(err)
 	}
 
-	plugin.LogSuccess("Restored " + strconv.Itoa(count) + " peers from database")
+	// the entries are removed after the iteration, since the database can not be modified while iterating over it
+	for _, key := range invalidKeys {
+		if err := getDb().Delete(key); err != nil {
+			panic(err)
+		}
+	}
+
+	plugin.Log.Success("Restored peers from database", "count", count, "removedInvalidEntries", len(invalidKeys))
 }
 
 func Configure(plugin *node.Plugin) {
 	// do not store the entry nodes by ignoring all peers currently contained in konwnpeers
 	// add peers from db
 	loadPeers(plugin)
+	loadReputations(plugin)
 
 	// subscribe to all known peers' events
 	knownpeers.INSTANCE.Events.Add.Attach(events.NewClosure(func(p *peer.Peer) {
@@ -84,5 +105,16 @@ func Configure(plugin *node.Plugin) {
 	}))
 	knownpeers.INSTANCE.Events.Remove.Attach(events.NewClosure(func(p *peer.Peer) {
 		removePeer(p)
+		removeReputation(p.Identity.Identifier)
 	}))
 }
+
+func Run(plugin *node.Plugin) {
+	daemon.BackgroundWorker("Autopeering Peer Reputation Maintainer", func(shutdownSignal <-chan struct{}) {
+		timeutil.Ticker(func() {
+			maintainReputations(plugin)
+		}, REPUTATION_MAINTENANCE_INTERVAL, shutdownSignal)
+
+		storeReputations()
+	}, shutdown.PRIORITY_AUTOPEERING)
+}
@@ -0,0 +1,127 @@
+package peerstorage
+
+import (
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/acceptedneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/entrynodes"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/parameters"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/reputation"
+)
+
+const reputationDbName string = "peer_reputations"
+
+var reputationDb database.Database
+var reputationDbOnce sync.Once
+
+func initReputationDb() {
+	db, err := database.Get(reputationDbName)
+	if err != nil {
+		panic(err)
+	}
+
+	reputationDb = db
+}
+
+func getReputationDb() database.Database {
+	reputationDbOnce.Do(initReputationDb)
+
+	return reputationDb
+}
+
+func loadReputations(plugin *node.Plugin) {
+	var count int
+
+	err := getReputationDb().ForEach(func(key []byte, value []byte) {
+		peerReputation, err := reputation.Unmarshal(value)
+		if err != nil {
+			plugin.Log.Failure("Invalid item in database", "database", reputationDbName, "error", err)
+
+			return
+		}
+
+		knownpeers.SetReputation(hex.EncodeToString(key), peerReputation)
+		count++
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	plugin.Log.Success("Restored peer reputations from database", "count", count)
+}
+
+func storeReputations() {
+	for identifier, peerReputation := range knownpeers.GetReputations() {
+		if decodedIdentifier, err := hex.DecodeString(identifier); err == nil {
+			if err := getReputationDb().Set(decodedIdentifier, peerReputation.Marshal()); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+func removeReputation(identifier []byte) {
+	if err := getReputationDb().Delete(identifier); err != nil {
+		panic(err)
+	}
+}
+
+// counts unanswered requests as failed, removes chronically dead peers and persists the reputations
+func maintainReputations(plugin *node.Plugin) {
+	now := time.Now()
+	deadPeerTimeout := *parameters.DEAD_PEER_TIMEOUT.Value
+
+	prunedPeers := 0
+	for identifier, peerReputation := range knownpeers.GetReputations() {
+		peerReputation.CheckRequestTimeout(now, REQUEST_TIMEOUT)
+		peerReputation.CheckPingTimeout(now, PING_TIMEOUT)
+
+		if peerReputation.IsDead(now, deadPeerTimeout, DEAD_PEER_MIN_FAILURES) && isPrunable(identifier) {
+			knownpeers.INSTANCE.Remove(identifier)
+
+			// the reputation might belong to a peer that is not part of the known peers anymore
+			knownpeers.RemoveReputation(identifier)
+			if decodedIdentifier, err := hex.DecodeString(identifier); err == nil {
+				removeReputation(decodedIdentifier)
+			}
+
+			prunedPeers++
+		}
+	}
+
+	if prunedPeers != 0 {
+		plugin.Log.Info("Removed dead peers", "count", prunedPeers)
+	}
+
+	storeReputations()
+}
+
+// entry nodes and current neighbors are never pruned
+func isPrunable(identifier string) bool {
+	for _, entryNode := range entrynodes.INSTANCE {
+		if entryNode.Identity.StringIdentifier == identifier {
+			return false
+		}
+	}
+
+	return !chosenneighbors.INSTANCE.Contains(identifier) && !acceptedneighbors.INSTANCE.Contains(identifier)
+}
+
+const (
+	REPUTATION_MAINTENANCE_INTERVAL = 1 * time.Minute
+
+	// requests that are not answered within this time count as failed
+	REQUEST_TIMEOUT = 30 * time.Second
+
+	// pings that are not answered within this time (by a reply or any other message of the peer) count as failed
+	PING_TIMEOUT = 30 * time.Second
+
+	// peers need to fail at least this often in a row before they are pruned
+	DEAD_PEER_MIN_FAILURES = 5
+)
@@ -1,7 +1,6 @@
 package autopeering
 
 import (
-	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances"
@@ -9,6 +8,7 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/peerstorage"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/portmapping"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/server"
@@ -16,7 +16,7 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/gossip"
 )
 
-var PLUGIN = node.NewPlugin("Auto Peering", node.Enabled, configure, run)
+var PLUGIN = node.NewPlugin("Auto Peering", node.Enabled, configure, run).DependsOn(gossip.PLUGIN)
 
 func configure(plugin *node.Plugin) {
 	saltmanager.Configure(plugin)
@@ -24,10 +24,7 @@ func configure(plugin *node.Plugin) {
 	server.Configure(plugin)
 	protocol.Configure(plugin)
 	peerstorage.Configure(plugin)
-
-	daemon.Events.Shutdown.Attach(events.NewClosure(func() {
-		server.Shutdown(plugin)
-	}))
+	portmapping.Configure(plugin)
 
 	configureLogging(plugin)
 }
@@ -36,6 +33,8 @@ func run(plugin *node.Plugin) {
 	instances.Run(plugin)
 	server.Run(plugin)
 	protocol.Run(plugin)
+	peerstorage.Run(plugin)
+	portmapping.Run(plugin)
 }
 
 func configureLogging(plugin *node.Plugin) {
@@ -45,39 +44,39 @@ func configureLogging(plugin *node.Plugin) {
 	}))
 
 	acceptedneighbors.INSTANCE.Events.Add.Attach(events.NewClosure(func(p *peer.Peer) {
-		plugin.LogDebug("accepted neighbor added: " + p.Address.String() + " / " + p.Identity.StringIdentifier)
+		plugin.Log.Debug("accepted neighbor added", "address", p.Address, "identifier", p.Identity.StringIdentifier)
 
-		gossip.AddNeighbor(gossip.NewNeighbor(p.Identity, p.Address, p.GossipPort))
+		gossip.AddNeighbor(gossip.NewNeighbor(p.Identity, p.Address, p.GossipPort, p.AlternativeAddresses...))
 	}))
 	acceptedneighbors.INSTANCE.Events.Remove.Attach(events.NewClosure(func(p *peer.Peer) {
-		plugin.LogDebug("accepted neighbor removed: " + p.Address.String() + " / " + p.Identity.StringIdentifier)
+		plugin.Log.Debug("accepted neighbor removed", "address", p.Address, "identifier", p.Identity.StringIdentifier)
 
 		gossip.RemoveNeighbor(p.Identity.StringIdentifier)
 	}))
 
 	chosenneighbors.INSTANCE.Events.Add.Attach(events.NewClosure(func(p *peer.Peer) {
-		plugin.LogDebug("chosen neighbor added: " + p.Address.String() + " / " + p.Identity.StringIdentifier)
+		plugin.Log.Debug("chosen neighbor added", "address", p.Address, "identifier", p.Identity.StringIdentifier)
 
-		gossip.AddNeighbor(gossip.NewNeighbor(p.Identity, p.Address, p.GossipPort))
+		gossip.AddNeighbor(gossip.NewNeighbor(p.Identity, p.Address, p.GossipPort, p.AlternativeAddresses...))
 	}))
 	chosenneighbors.INSTANCE.Events.Remove.Attach(events.NewClosure(func(p *peer.Peer) {
-		plugin.LogDebug("chosen neighbor removed: " + p.Address.String() + " / " + p.Identity.StringIdentifier)
+		plugin.Log.Debug("chosen neighbor removed", "address", p.Address, "identifier", p.Identity.StringIdentifier)
 
 		gossip.RemoveNeighbor(p.Identity.StringIdentifier)
 	}))
 
 	knownpeers.INSTANCE.Events.Add.Attach(events.NewClosure(func(p *peer.Peer) {
-		plugin.LogInfo("new peer discovered: " + p.Address.String() + " / " + p.Identity.StringIdentifier)
+		plugin.Log.Info("new peer discovered", "address", p.Address, "identifier", p.Identity.StringIdentifier)
 
 		if _, exists := gossip.GetNeighbor(p.Identity.StringIdentifier); exists {
-			gossip.AddNeighbor(gossip.NewNeighbor(p.Identity, p.Address, p.GossipPort))
+			gossip.AddNeighbor(gossip.NewNeighbor(p.Identity, p.Address, p.GossipPort, p.AlternativeAddresses...))
 		}
 	}))
 	knownpeers.INSTANCE.Events.Update.Attach(events.NewClosure(func(p *peer.Peer) {
-		plugin.LogDebug("peer updated: " + p.Address.String() + " / " + p.Identity.StringIdentifier)
+		plugin.Log.Debug("peer updated", "address", p.Address, "identifier", p.Identity.StringIdentifier)
 
 		if _, exists := gossip.GetNeighbor(p.Identity.StringIdentifier); exists {
-			gossip.AddNeighbor(gossip.NewNeighbor(p.Identity, p.Address, p.GossipPort))
+			gossip.AddNeighbor(gossip.NewNeighbor(p.Identity, p.Address, p.GossipPort, p.AlternativeAddresses...))
 		}
 	}))
 }
@@ -0,0 +1,11 @@
+package portmapping
+
+import "time"
+
+const (
+	// the time after which a failed mapping attempt is repeated
+	MAPPING_RETRY_INTERVAL = 5 * time.Minute
+
+	// mappings are renewed at half of their lifetime but not more often than this
+	MIN_RENEW_INTERVAL = 1 * time.Minute
+)
@@ -0,0 +1,118 @@
+package portmapping
+
+import (
+	"strings"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/daemon"
+	"github.com/iotaledger/goshimmer/packages/natpmp"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/timeutil"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/parameters"
+	"github.com/iotaledger/goshimmer/plugins/gossip"
+)
+
+func Configure(plugin *node.Plugin) {
+	gatewayAddress := strings.TrimSpace(*parameters.NAT_PMP_GATEWAY.Value)
+	if gatewayAddress == "" {
+		return
+	}
+
+	natPmpClient, err := natpmp.NewClient(gatewayAddress)
+	if err != nil {
+		plugin.LogFailure(err.Error())
+
+		return
+	}
+
+	client = natPmpClient
+}
+
+// Requests the port mappings for the peering and the gossip port and renews them until the node shuts down.
+func Run(plugin *node.Plugin) {
+	if client == nil {
+		return
+	}
+
+	daemon.BackgroundWorker("Autopeering NAT-PMP Port Mapper", func(shutdownSignal <-chan struct{}) {
+		for {
+			renewInterval := MAPPING_RETRY_INTERVAL
+			if lifetime, err := mapPorts(plugin); err != nil {
+				plugin.Log.Failure("could not map ports via NAT-PMP", "error", err)
+			} else if lifetime/2 > MIN_RENEW_INTERVAL {
+				renewInterval = lifetime / 2
+			} else {
+				renewInterval = MIN_RENEW_INTERVAL
+			}
+
+			if !timeutil.Sleep(renewInterval, shutdownSignal) {
+				break
+			}
+		}
+
+		unmapPorts(plugin)
+	}, shutdown.PRIORITY_AUTOPEERING)
+}
+
+// creates (or renews) the mappings and returns the shortest lifetime that the gateway granted
+func mapPorts(plugin *node.Plugin) (time.Duration, error) {
+	if !ownpeer.IsExternalAddressConfigured() {
+		externalAddress, err := client.GetExternalAddress()
+		if err != nil {
+			return 0, err
+		}
+
+		ownpeer.SetAddress(externalAddress)
+	}
+
+	peeringPort := uint16(*parameters.PORT.Value)
+	gossipPort := uint16(*gossip.PORT.Value)
+
+	// the peering server listens for tcp and udp on the same port, so both mappings need the same external port
+	peeringTCPMapping, err := client.AddPortMapping(natpmp.PROTOCOL_TCP, peeringPort, peeringPort, natpmp.DEFAULT_MAPPING_LIFETIME)
+	if err != nil {
+		return 0, err
+	}
+	peeringUDPMapping, err := client.AddPortMapping(natpmp.PROTOCOL_UDP, peeringPort, peeringTCPMapping.ExternalPort, natpmp.DEFAULT_MAPPING_LIFETIME)
+	if err != nil {
+		return 0, err
+	}
+	if peeringUDPMapping.ExternalPort != peeringTCPMapping.ExternalPort {
+		plugin.Log.Warning("NAT-PMP gateway mapped the peering port to different tcp and udp ports - pings will not reach us", "tcpPort", peeringTCPMapping.ExternalPort, "udpPort", peeringUDPMapping.ExternalPort)
+	}
+
+	gossipMapping, err := client.AddPortMapping(natpmp.PROTOCOL_TCP, gossipPort, gossipPort, natpmp.DEFAULT_MAPPING_LIFETIME)
+	if err != nil {
+		return 0, err
+	}
+
+	ownpeer.SetPorts(peeringTCPMapping.ExternalPort, gossipMapping.ExternalPort)
+
+	lifetime := peeringTCPMapping.Lifetime
+	for _, mapping := range []*natpmp.Mapping{peeringUDPMapping, gossipMapping} {
+		if mapping.Lifetime < lifetime {
+			lifetime = mapping.Lifetime
+		}
+	}
+
+	return lifetime, nil
+}
+
+func unmapPorts(plugin *node.Plugin) {
+	peeringPort := uint16(*parameters.PORT.Value)
+	gossipPort := uint16(*gossip.PORT.Value)
+
+	for _, err := range []error{
+		client.DeletePortMapping(natpmp.PROTOCOL_TCP, peeringPort),
+		client.DeletePortMapping(natpmp.PROTOCOL_UDP, peeringPort),
+		client.DeletePortMapping(natpmp.PROTOCOL_TCP, gossipPort),
+	} {
+		if err != nil {
+			plugin.Log.Failure("could not remove NAT-PMP port mapping", "error", err)
+		}
+	}
+}
+
+var client *natpmp.Client
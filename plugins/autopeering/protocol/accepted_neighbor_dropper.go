@@ -3,38 +3,31 @@ package protocol
 import (
 	"time"
 
+	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/packages/timeutil"
-	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/acceptedneighbors"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
-	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/constants"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/types"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/drop"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
 )
 
-func createAcceptedNeighborDropper(plugin *node.Plugin) func() {
-	return func() {
+func createAcceptedNeighborDropper(plugin *node.Plugin) daemon.WorkerFunc {
+	return func(shutdownSignal <-chan struct{}) {
 		timeutil.Ticker(func() {
-			if len(acceptedneighbors.INSTANCE.Peers) > constants.NEIGHBOR_COUNT/2 {
-				defer acceptedneighbors.INSTANCE.Lock()()
-				for len(acceptedneighbors.INSTANCE.Peers) > constants.NEIGHBOR_COUNT/2 {
-					acceptedneighbors.FurthestNeighborLock.RLock()
-					furthestNeighbor := acceptedneighbors.FURTHEST_NEIGHBOR
-					acceptedneighbors.FurthestNeighborLock.RUnlock()
+			ownState.DropSurplusNeighbors(ownState.AcceptedNeighbors, func(furthestNeighbor *peer.Peer) {
+				go sendDrop(plugin, furthestNeighbor)
+			})
+		}, 1*time.Second, shutdownSignal)
+	}
+}
 
-					if furthestNeighbor != nil {
-						dropMessage := &drop.Drop{Issuer: ownpeer.INSTANCE}
-						dropMessage.Sign()
+// informs the neighbor that we dropped it (the drop message is sent by both droppers)
+func sendDrop(plugin *node.Plugin, neighbor *peer.Peer) {
+	dropMessage := &drop.Drop{Issuer: ownpeer.Snapshot()}
+	dropMessage.Sign(neighbor.Identity)
 
-						acceptedneighbors.INSTANCE.Remove(furthestNeighbor.Identity.StringIdentifier, false)
-						go func() {
-							if _, err := furthestNeighbor.Send(dropMessage.Marshal(), types.PROTOCOL_TYPE_UDP, false); err != nil {
-								plugin.LogDebug("error when sending drop message to" + acceptedneighbors.FURTHEST_NEIGHBOR.String())
-							}
-						}()
-					}
-				}
-			}
-		}, 1*time.Second)
+	if _, err := neighbor.Send(dropMessage.Marshal(), types.PROTOCOL_TYPE_UDP, false); err != nil {
+		plugin.Log.Debug("error when sending drop message", "peer", neighbor)
 	}
 }
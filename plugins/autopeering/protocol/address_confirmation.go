@@ -0,0 +1,58 @@
+package protocol
+
+import (
+	"github.com/iotaledger/goshimmer/packages/filter"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/constants"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/types"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/ping"
+)
+
+// The source address of UDP packets is not authenticated, so we only send peer exchange responses and ping replies to
+// addresses that proved that they receive our messages - otherwise our (larger) responses could be reflected to a
+// third party. An address is confirmed once a message arrives over TCP or once the peer answers a message that we
+// sent to that address.
+var confirmedAddresses = filter.NewSeenSet(constants.CONFIRMED_ADDRESS_COUNT, constants.CONFIRMED_ADDRESS_TTL)
+
+// the addresses that we sent a ping or a peer exchange request to and that did not answer, yet
+var pendingAddresses = filter.NewSeenSet(constants.CONFIRMED_ADDRESS_COUNT, constants.PEER_EXCHANGE_RESPONSE_TIMEOUT)
+
+// remembers that we expect an answer from the peer under its current address
+func expectAnswer(p *peer.Peer) {
+	pendingAddresses.Add(getAddressKey(p))
+}
+
+// confirms the address of the peer if the message was received over TCP or answers one of our messages
+func recordAnswer(p *peer.Peer) {
+	addressKey := getAddressKey(p)
+
+	if p.GetConn() != nil || pendingAddresses.Contains(addressKey) {
+		confirmedAddresses.Add(addressKey)
+	}
+}
+
+// asks the peer to reply to a ping of ours instead of replying to its ping (both pings have the same size, so this can
+// not be used to amplify traffic) - the reply confirms the address and proves that we are reachable as well
+func requestAddressConfirmation(plugin *node.Plugin, p *peer.Peer) {
+	confirmationPing := &ping.Ping{
+		Issuer:         ownpeer.Snapshot(),
+		ReplyRequested: true,
+	}
+	confirmationPing.Sign(p.Identity)
+
+	expectAnswer(p)
+
+	if _, err := p.Send(confirmationPing.Marshal(), types.PROTOCOL_TYPE_UDP, false); err != nil {
+		plugin.Log.Debug("error when requesting address confirmation", "peer", p, "error", err)
+	}
+}
+
+func isAddressConfirmed(p *peer.Peer) bool {
+	return confirmedAddresses.Contains(getAddressKey(p))
+}
+
+func getAddressKey(p *peer.Peer) string {
+	return p.Identity.StringIdentifier + "@" + p.Address.String()
+}
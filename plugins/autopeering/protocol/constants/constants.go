@@ -15,4 +15,34 @@ const (
 
 	// The length of a ping cycle (after this time we have sent randomized pings to all of our neighbors).
 	PING_CYCLE_LENGTH = 900 * time.Second
+
+	// How often do we ask a neighbor for a sample of its known peers.
+	PEER_EXCHANGE_INTERVAL = 60 * time.Second
+
+	// How often do we ask for peers while we know less than PEER_EXCHANGE_MIN_KNOWN_PEERS peers.
+	PEER_EXCHANGE_BOOTSTRAP_INTERVAL = 10 * time.Second
+
+	// The amount of known peers below which we ask for peers more often.
+	PEER_EXCHANGE_MIN_KNOWN_PEERS = 2 * NEIGHBOR_COUNT
+
+	// Peers that were not seen for a longer time are neither sent nor accepted in peer exchange responses.
+	PEER_EXCHANGE_MAX_PEER_AGE = 1 * time.Hour
+
+	// Responses to peer exchange requests that arrive later are ignored.
+	PEER_EXCHANGE_RESPONSE_TIMEOUT = 30 * time.Second
+
+	// The amount of peer exchange requests (and ping replies) per second that we answer for every source address.
+	PEER_EXCHANGE_RATE_LIMIT = 0.1
+
+	// The amount of peer exchange requests (and ping replies) that a source address can send in a burst.
+	PEER_EXCHANGE_RATE_LIMIT_BURST = 3
+
+	// The maximum amount of source addresses that the rate limits are tracked for.
+	PEER_EXCHANGE_RATE_LIMIT_PEERS = 1000
+
+	// How long a peer address stays confirmed after the peer proved that it receives our messages under it.
+	CONFIRMED_ADDRESS_TTL = 1 * time.Hour
+
+	// The maximum amount of confirmed (and pending) peer addresses that we remember.
+	CONFIRMED_ADDRESS_COUNT = 1000
 )
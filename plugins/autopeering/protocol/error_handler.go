@@ -9,6 +9,6 @@ import (
 
 func createErrorHandler(plugin *node.Plugin) *events.Closure {
 	return events.NewClosure(func(ip net.IP, err error) {
-		plugin.LogDebug("error when communicating with " + ip.String() + ": " + err.Error())
+		plugin.Log.Debug("error when communicating with peer", "address", ip, "error", err)
 	})
 }
@@ -3,16 +3,20 @@ package protocol
 import (
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/node"
-	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/acceptedneighbors"
-	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/drop"
 )
 
 func createIncomingDropProcessor(plugin *node.Plugin) *events.Closure {
 	return events.NewClosure(func(drop *drop.Drop) {
-		plugin.LogDebug("received drop message from " + drop.Issuer.String())
+		if !verifyStamp(plugin, drop.Issuer, drop.Stamp) {
+			return
+		}
 
-		chosenneighbors.INSTANCE.Remove(drop.Issuer.Identity.StringIdentifier)
-		acceptedneighbors.INSTANCE.Remove(drop.Issuer.Identity.StringIdentifier)
+		plugin.Log.Debug("received drop message", "peer", drop.Issuer)
+
+		knownpeers.RecordSeen(drop.Issuer)
+
+		ownState.ProcessDrop(drop.Issuer)
 	})
 }
@@ -0,0 +1,90 @@
+package protocol
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/ratelimiter"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/constants"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/types"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/exchangerequest"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/exchangeresponse"
+)
+
+func createIncomingExchangeRequestProcessor(plugin *node.Plugin) *events.Closure {
+	return events.NewClosure(func(exchangeRequest *exchangerequest.ExchangeRequest) {
+		if verifyStamp(plugin, exchangeRequest.Issuer, exchangeRequest.Stamp) {
+			recordAnswer(exchangeRequest.Issuer)
+
+			go processIncomingExchangeRequest(plugin, exchangeRequest)
+		}
+	})
+}
+
+func processIncomingExchangeRequest(plugin *node.Plugin, exchangeRequest *exchangerequest.ExchangeRequest) {
+	plugin.Log.Debug("received peer exchange request", "peer", exchangeRequest.Issuer)
+
+	if !exchangeRateLimiter.Allow(exchangeRequest.Issuer.Address.String()) {
+		plugin.Log.Debug("ignoring peer exchange request (rate limit exceeded)", "peer", exchangeRequest.Issuer)
+
+		return
+	}
+
+	if !isAddressConfirmed(exchangeRequest.Issuer) {
+		plugin.Log.Debug("ignoring peer exchange request (address not confirmed)", "peer", exchangeRequest.Issuer)
+
+		return
+	}
+
+	knownpeers.INSTANCE.AddOrUpdate(exchangeRequest.Issuer)
+	knownpeers.RecordSeen(exchangeRequest.Issuer)
+
+	exchangeResponse := &exchangeresponse.ExchangeResponse{
+		Issuer:  ownpeer.Snapshot(),
+		Entries: getExchangeSample(exchangeRequest.Issuer.Identity.StringIdentifier),
+	}
+	exchangeResponse.Sign(exchangeRequest.Issuer.Identity)
+
+	if _, err := exchangeRequest.Issuer.Send(exchangeResponse.Marshal(), types.PROTOCOL_TYPE_UDP, false); err != nil {
+		plugin.Log.Debug("error when sending peer exchange response", "peer", exchangeRequest.Issuer, "error", err)
+	}
+}
+
+// returns a random sample of the known peers that we heard from recently (excluding the requester)
+func getExchangeSample(requesterIdentifier string) []*exchangeresponse.Entry {
+	knownPeersUnlock := knownpeers.INSTANCE.Lock()
+	knownPeers := knownpeers.INSTANCE.List()
+	knownPeersUnlock()
+
+	now := time.Now()
+	result := make([]*exchangeresponse.Entry, 0, exchangeresponse.MAX_ENTRIES)
+	for _, i := range rand.Perm(len(knownPeers)) {
+		knownPeer := knownPeers[i]
+		if knownPeer.Identity.StringIdentifier == requesterIdentifier {
+			continue
+		}
+
+		lastSeen := knownpeers.GetLastSeen(knownPeer)
+		if lastSeen.IsZero() || now.Sub(lastSeen) > constants.PEER_EXCHANGE_MAX_PEER_AGE {
+			continue
+		}
+
+		result = append(result, &exchangeresponse.Entry{
+			Peer: knownPeer,
+			Age:  now.Sub(lastSeen),
+		})
+
+		if len(result) == exchangeresponse.MAX_ENTRIES {
+			break
+		}
+	}
+
+	return result
+}
+
+// limits how often every source address can make us send a peer exchange response or a ping reply
+var exchangeRateLimiter = ratelimiter.NewKeyedTokenBucket(constants.PEER_EXCHANGE_RATE_LIMIT, constants.PEER_EXCHANGE_RATE_LIMIT_BURST, constants.PEER_EXCHANGE_RATE_LIMIT_PEERS)
@@ -0,0 +1,67 @@
+package protocol
+
+import (
+	"github.com/iotaledger/goshimmer/packages/accountability"
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/constants"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/types"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/exchangeresponse"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/ping"
+)
+
+func createIncomingExchangeResponseProcessor(plugin *node.Plugin) *events.Closure {
+	return events.NewClosure(func(exchangeResponse *exchangeresponse.ExchangeResponse) {
+		if verifyStamp(plugin, exchangeResponse.Issuer, exchangeResponse.Stamp) {
+			recordAnswer(exchangeResponse.Issuer)
+
+			go processIncomingExchangeResponse(plugin, exchangeResponse)
+		}
+	})
+}
+
+func processIncomingExchangeResponse(plugin *node.Plugin, exchangeResponse *exchangeresponse.ExchangeResponse) {
+	if !removeExchangePending(exchangeResponse.Issuer) {
+		plugin.Log.Debug("ignoring unrequested peer exchange response", "peer", exchangeResponse.Issuer)
+
+		return
+	}
+
+	plugin.Log.Debug("received peer exchange response", "peer", exchangeResponse.Issuer)
+
+	knownpeers.INSTANCE.AddOrUpdate(exchangeResponse.Issuer)
+	knownpeers.RecordSeen(exchangeResponse.Issuer)
+
+	for _, entry := range exchangeResponse.Entries {
+		if entry.Age > constants.PEER_EXCHANGE_MAX_PEER_AGE || entry.Peer.Identity.StringIdentifier == accountability.OwnId().StringIdentifier {
+			continue
+		}
+
+		knownPeersUnlock := knownpeers.INSTANCE.Lock()
+		alreadyKnown := knownpeers.INSTANCE.Contains(entry.Peer.Identity.StringIdentifier)
+		knownPeersUnlock()
+
+		if !alreadyKnown {
+			go verifyExchangedPeer(plugin, entry.Peer)
+		}
+	}
+}
+
+// the exchanged peers are not trusted - we ask them to reply to a ping and the incoming ping processor adds them to the
+// known peers once their (signed) reply arrives
+func verifyExchangedPeer(plugin *node.Plugin, exchangedPeer *peer.Peer) {
+	verificationPing := &ping.Ping{
+		Issuer:         ownpeer.Snapshot(),
+		ReplyRequested: true,
+	}
+	verificationPing.Sign(exchangedPeer.Identity)
+
+	expectAnswer(exchangedPeer)
+
+	if _, err := exchangedPeer.Send(verificationPing.Marshal(), types.PROTOCOL_TYPE_UDP, false); err != nil {
+		plugin.Log.Debug("error when verifying exchanged peer", "peer", exchangedPeer, "error", err)
+	}
+}
@@ -4,16 +4,50 @@ import (
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/types"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/ping"
 )
 
 func createIncomingPingProcessor(plugin *node.Plugin) *events.Closure {
 	return events.NewClosure(func(ping *ping.Ping) {
-		plugin.LogDebug("received ping from " + ping.Issuer.String())
+		if !verifyStamp(plugin, ping.Issuer, ping.Stamp) {
+			return
+		}
+
+		plugin.Log.Debug("received ping", "peer", ping.Issuer)
+
+		recordAnswer(ping.Issuer)
 
-		knownpeers.INSTANCE.AddOrUpdate(ping.Issuer)
-		for _, neighbor := range ping.Neighbors {
-			knownpeers.INSTANCE.AddOrUpdate(neighbor)
+		ownState.ProcessPing(ping.Issuer, ping.Neighbors)
+		knownpeers.RecordSeen(ping.Issuer)
+
+		if ping.ReplyRequested {
+			go replyToPing(plugin, ping)
 		}
 	})
 }
+
+// answers a verification ping (the reply proves that we are reachable under the address that the issuer knows)
+func replyToPing(plugin *node.Plugin, incomingPing *ping.Ping) {
+	if !exchangeRateLimiter.Allow(incomingPing.Issuer.Address.String()) {
+		plugin.Log.Debug("ignoring ping reply request (rate limit exceeded)", "peer", incomingPing.Issuer)
+
+		return
+	}
+
+	if !isAddressConfirmed(incomingPing.Issuer) {
+		requestAddressConfirmation(plugin, incomingPing.Issuer)
+
+		return
+	}
+
+	replyPing := &ping.Ping{
+		Issuer: ownpeer.Snapshot(),
+	}
+	replyPing.Sign(incomingPing.Issuer.Identity)
+
+	if _, err := incomingPing.Issuer.Send(replyPing.Marshal(), types.PROTOCOL_TYPE_UDP, false); err != nil {
+		plugin.Log.Debug("error when replying to ping", "peer", incomingPing.Issuer, "error", err)
+	}
+}
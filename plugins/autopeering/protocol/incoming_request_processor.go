@@ -7,10 +7,8 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/node"
-	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/acceptedneighbors"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/neighborhood"
-	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/constants"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerlist"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/request"
@@ -18,52 +16,40 @@ import (
 
 func createIncomingRequestProcessor(plugin *node.Plugin) *events.Closure {
 	return events.NewClosure(func(req *request.Request) {
-		go processIncomingRequest(plugin, req)
+		if verifyStamp(plugin, req.Issuer, req.Stamp) {
+			recordAnswer(req.Issuer)
+
+			go processIncomingRequest(plugin, req)
+		}
 	})
 }
 
 func processIncomingRequest(plugin *node.Plugin, req *request.Request) {
-	plugin.LogDebug("received peering request from " + req.Issuer.String())
-
-	knownpeers.INSTANCE.AddOrUpdate(req.Issuer)
-
-	if *parameters.ACCEPT_REQUESTS.Value && requestShouldBeAccepted(req) {
-		defer acceptedneighbors.INSTANCE.Lock()()
-
-		if requestShouldBeAccepted(req) {
-			acceptedneighbors.INSTANCE.AddOrUpdate(req.Issuer, false)
+	plugin.Log.Debug("received peering request", "peer", req.Issuer)
 
-			acceptRequest(plugin, req)
+	knownpeers.RecordSeen(req.Issuer)
 
-			return
-		}
+	if ownState.ProcessRequest(req.Issuer, *parameters.ACCEPT_REQUESTS.Value) {
+		acceptRequest(plugin, req)
+	} else {
+		rejectRequest(plugin, req)
 	}
-
-	rejectRequest(plugin, req)
-}
-
-func requestShouldBeAccepted(req *request.Request) bool {
-	return len(acceptedneighbors.INSTANCE.Peers) < constants.NEIGHBOR_COUNT/2 ||
-		acceptedneighbors.INSTANCE.Contains(req.Issuer.Identity.StringIdentifier) ||
-		acceptedneighbors.OWN_DISTANCE(req.Issuer) < acceptedneighbors.FURTHEST_NEIGHBOR_DISTANCE
 }
 
 func acceptRequest(plugin *node.Plugin, req *request.Request) {
 	if err := req.Accept(generateProposedPeeringCandidates(req)); err != nil {
-		plugin.LogDebug("error when sending response to" + req.Issuer.String())
+		plugin.Log.Debug("error when sending peering response", "peer", req.Issuer, "error", err)
 	}
 
-	plugin.LogDebug("sent positive peering response to " + req.Issuer.String())
-
-	acceptedneighbors.INSTANCE.AddOrUpdate(req.Issuer, false)
+	plugin.Log.Debug("sent positive peering response", "peer", req.Issuer)
 }
 
 func rejectRequest(plugin *node.Plugin, req *request.Request) {
 	if err := req.Reject(generateProposedPeeringCandidates(req)); err != nil {
-		plugin.LogDebug("error when sending response to" + req.Issuer.String())
+		plugin.Log.Debug("error when sending peering response", "peer", req.Issuer, "error", err)
 	}
 
-	plugin.LogDebug("sent negative peering response to " + req.Issuer.String())
+	plugin.Log.Debug("sent negative peering response", "peer", req.Issuer)
 }
 
 func generateProposedPeeringCandidates(req *request.Request) peerlist.PeerList {
@@ -3,32 +3,30 @@ package protocol
 import (
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/node"
-	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/response"
 )
 
 func createIncomingResponseProcessor(plugin *node.Plugin) *events.Closure {
 	return events.NewClosure(func(peeringResponse *response.Response) {
-		go processIncomingResponse(plugin, peeringResponse)
+		if verifyStamp(plugin, peeringResponse.Issuer, peeringResponse.Stamp) {
+			recordAnswer(peeringResponse.Issuer)
+
+			go processIncomingResponse(plugin, peeringResponse)
+		}
 	})
 }
 
 func processIncomingResponse(plugin *node.Plugin, peeringResponse *response.Response) {
-	plugin.LogDebug("received peering response from " + peeringResponse.Issuer.String())
+	plugin.Log.Debug("received peering response", "peer", peeringResponse.Issuer)
 
 	if conn := peeringResponse.Issuer.GetConn(); conn != nil {
 		_ = conn.Close()
 	}
 
-	knownpeers.INSTANCE.AddOrUpdate(peeringResponse.Issuer)
-	for _, peer := range peeringResponse.Peers {
-		knownpeers.INSTANCE.AddOrUpdate(peer)
-	}
-
-	if peeringResponse.Type == response.TYPE_ACCEPT {
-		defer chosenneighbors.INSTANCE.Lock()()
+	knownpeers.RecordResponse(peeringResponse.Issuer)
+	ownpeer.RecordObservedAddress(peeringResponse.Issuer, peeringResponse.ObservedAddress)
 
-		chosenneighbors.INSTANCE.AddOrUpdate(peeringResponse.Issuer, false)
-	}
+	ownState.ProcessResponse(peeringResponse)
 }
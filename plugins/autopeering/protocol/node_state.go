@@ -0,0 +1,215 @@
+package protocol
+
+import (
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/acceptedneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/neighborhood"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/parameters"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/constants"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerlist"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerregister"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/response"
+)
+
+// region NodeState ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// NodeState contains the peering state that the rules of the processors operate on. The processors of the plugin use
+// the global instances of this node, while the simulation creates a NodeState for each of its virtual nodes (and
+// delivers the messages itself), so both follow the same rules.
+type NodeState struct {
+	OwnPeer           *peer.Peer
+	KnownPeers        *peerregister.PeerRegister
+	ChosenNeighbors   *NeighborSet
+	AcceptedNeighbors *NeighborSet
+	// the percentage by which a peer has to be closer than the furthest neighbor to replace it
+	NeighborHysteresis int
+	// the percentage of the neighbors that are chosen from other clusters
+	CrossClusterLinks int
+}
+
+// Creates a NodeState with empty peer registers (the furthest neighbors are computed on demand).
+func NewNodeState(ownPeer *peer.Peer, chosenDistance func(p *peer.Peer) uint64, acceptedDistance func(p *peer.Peer) uint64) *NodeState {
+	return &NodeState{
+		OwnPeer:            ownPeer,
+		KnownPeers:         peerregister.New(),
+		ChosenNeighbors:    NewNeighborSet(peerregister.New(), chosenDistance),
+		AcceptedNeighbors:  NewNeighborSet(peerregister.New(), acceptedDistance),
+		NeighborHysteresis: int(*parameters.NEIGHBOR_HYSTERESIS.Value),
+		CrossClusterLinks:  int(*parameters.CROSS_CLUSTER_LINKS.Value),
+	}
+}
+
+// Adds the peer to the known peers (our own peer is ignored).
+func (state *NodeState) AddKnownPeer(p *peer.Peer) {
+	if p.Identity.StringIdentifier != state.OwnPeer.Identity.StringIdentifier {
+		state.KnownPeers.AddOrUpdate(p)
+	}
+}
+
+// Returns true if a peering request of the issuer should be accepted - either because we have free slots or because it
+// is closer than our furthest accepted neighbor (and does not exceed the share of cross cluster links).
+func (state *NodeState) RequestShouldBeAccepted(issuer *peer.Peer) bool {
+	return state.AcceptedNeighbors.Peers.Contains(issuer.Identity.StringIdentifier) || state.AcceptedNeighbors.accepts(state, issuer)
+}
+
+// Returns true if we should send a peering request to the candidate.
+func (state *NodeState) CandidateShouldBeContacted(candidate *peer.Peer) bool {
+	identifier := candidate.Identity.StringIdentifier
+
+	return !state.AcceptedNeighbors.Peers.Contains(identifier) && !state.ChosenNeighbors.Peers.Contains(identifier) &&
+		state.OwnPeer.Identity.StringIdentifier != identifier && state.ChosenNeighbors.accepts(state, candidate)
+}
+
+// Processes a peering request: the issuer becomes a known peer and an accepted neighbor if the request should be
+// accepted (returns true if it was accepted).
+func (state *NodeState) ProcessRequest(issuer *peer.Peer, acceptRequests bool) bool {
+	state.AddKnownPeer(issuer)
+
+	if !acceptRequests || !state.RequestShouldBeAccepted(issuer) {
+		return false
+	}
+
+	defer state.AcceptedNeighbors.Peers.Lock()()
+
+	// another request might have taken the slot in the meantime
+	if !state.RequestShouldBeAccepted(issuer) {
+		return false
+	}
+
+	state.AcceptedNeighbors.Peers.AddOrUpdate(issuer, false)
+
+	return true
+}
+
+// Processes a peering response: the issuer and the proposed peers become known peers and the issuer becomes a chosen
+// neighbor if it accepted our request.
+func (state *NodeState) ProcessResponse(peeringResponse *response.Response) {
+	state.AddKnownPeer(peeringResponse.Issuer)
+	for _, proposedPeer := range peeringResponse.Peers {
+		state.AddKnownPeer(proposedPeer)
+	}
+
+	if peeringResponse.Type == response.TYPE_ACCEPT {
+		defer state.ChosenNeighbors.Peers.Lock()()
+
+		state.ChosenNeighbors.Peers.AddOrUpdate(peeringResponse.Issuer, false)
+	}
+}
+
+// Processes a ping: the issuer and its neighbors become known peers.
+func (state *NodeState) ProcessPing(issuer *peer.Peer, neighbors peerlist.PeerList) {
+	state.AddKnownPeer(issuer)
+	for _, neighbor := range neighbors {
+		state.AddKnownPeer(neighbor)
+	}
+}
+
+// Processes a drop message: the issuer is neither a chosen nor an accepted neighbor anymore.
+func (state *NodeState) ProcessDrop(issuer *peer.Peer) {
+	state.ChosenNeighbors.Peers.Remove(issuer.Identity.StringIdentifier)
+	state.AcceptedNeighbors.Peers.Remove(issuer.Identity.StringIdentifier)
+}
+
+// Removes the furthest neighbors of the set while it has more neighbors than allowed - the removed neighbors are passed
+// to the given function (that informs them with a drop message).
+func (state *NodeState) DropSurplusNeighbors(neighbors *NeighborSet, dropNeighbor func(p *peer.Peer)) {
+	if len(neighbors.Peers.Peers) <= constants.NEIGHBOR_COUNT/2 {
+		return
+	}
+
+	defer neighbors.Peers.Lock()()
+
+	for len(neighbors.Peers.Peers) > constants.NEIGHBOR_COUNT/2 {
+		furthestNeighbor, _ := neighbors.FurthestNeighbor()
+		if furthestNeighbor == nil {
+			return
+		}
+
+		neighbors.Peers.Remove(furthestNeighbor.Identity.StringIdentifier, false)
+
+		dropNeighbor(furthestNeighbor)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region NeighborSet //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// NeighborSet is one of the neighbor lists of a node together with the metric that its members are selected by.
+type NeighborSet struct {
+	Peers    *peerregister.PeerRegister
+	Distance func(p *peer.Peer) uint64
+	// returns the neighbor with the largest distance and its distance (nil if there are no neighbors)
+	FurthestNeighbor func() (*peer.Peer, uint64)
+}
+
+// Creates a NeighborSet that searches the furthest neighbor whenever it is needed.
+func NewNeighborSet(peers *peerregister.PeerRegister, distance func(p *peer.Peer) uint64) *NeighborSet {
+	neighborSet := &NeighborSet{
+		Peers:    peers,
+		Distance: distance,
+	}
+	neighborSet.FurthestNeighbor = neighborSet.findFurthestNeighbor
+
+	return neighborSet
+}
+
+// returns true if the peer fits into the set (free slots or closer than the furthest neighbor) without exceeding the
+// share of cross cluster links
+func (neighborSet *NeighborSet) accepts(state *NodeState, p *peer.Peer) bool {
+	if !neighborhood.AcceptsCrossClusterLinkOf(state.OwnPeer, neighborSet.Peers, p, state.CrossClusterLinks) {
+		return false
+	}
+
+	if len(neighborSet.Peers.Peers) < constants.NEIGHBOR_COUNT/2 {
+		return true
+	}
+
+	_, furthestDistance := neighborSet.FurthestNeighbor()
+
+	return neighborSet.Distance(p) < neighborhood.ApplyHysteresis(furthestDistance, state.NeighborHysteresis)
+}
+
+func (neighborSet *NeighborSet) findFurthestNeighbor() (furthestNeighbor *peer.Peer, furthestDistance uint64) {
+	for _, neighbor := range neighborSet.Peers.Peers {
+		if distance := neighborSet.Distance(neighbor); furthestNeighbor == nil || distance > furthestDistance ||
+			distance == furthestDistance && neighbor.Identity.StringIdentifier < furthestNeighbor.Identity.StringIdentifier {
+
+			furthestNeighbor = neighbor
+			furthestDistance = distance
+		}
+	}
+
+	return
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region state of this node ///////////////////////////////////////////////////////////////////////////////////////////
+
+// the state of this node (the furthest neighbors are cached by the instances)
+var ownState *NodeState
+
+func configureOwnState() {
+	ownState = &NodeState{
+		OwnPeer:    ownpeer.INSTANCE,
+		KnownPeers: knownpeers.INSTANCE,
+		ChosenNeighbors: &NeighborSet{
+			Peers:            chosenneighbors.INSTANCE,
+			Distance:         func(p *peer.Peer) uint64 { return chosenneighbors.OWN_DISTANCE(p) },
+			FurthestNeighbor: chosenneighbors.GetFurthestNeighbor,
+		},
+		AcceptedNeighbors: &NeighborSet{
+			Peers:            acceptedneighbors.INSTANCE,
+			Distance:         func(p *peer.Peer) uint64 { return acceptedneighbors.OWN_DISTANCE(p) },
+			FurthestNeighbor: acceptedneighbors.GetFurthestNeighbor,
+		},
+		NeighborHysteresis: int(*parameters.NEIGHBOR_HYSTERESIS.Value),
+		CrossClusterLinks:  int(*parameters.CROSS_CLUSTER_LINKS.Value),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
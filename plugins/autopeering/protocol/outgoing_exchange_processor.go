@@ -0,0 +1,139 @@
+package protocol
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/daemon"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/acceptedneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/constants"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/types"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/exchangerequest"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerlist"
+)
+
+func createOutgoingExchangeProcessor(plugin *node.Plugin) daemon.WorkerFunc {
+	return func(shutdownSignal <-chan struct{}) {
+		plugin.LogInfo("Starting Peer Exchange Processor ...")
+		plugin.LogSuccess("Starting Peer Exchange Processor ... done")
+
+		lastExchange := time.Time{}
+
+		ticker := time.NewTicker(constants.PING_PROCESS_INTERVAL)
+	ticker:
+		for {
+			select {
+			case <-shutdownSignal:
+				plugin.LogInfo("Stopping Peer Exchange Processor ...")
+
+				break ticker
+			case <-ticker.C:
+				if time.Since(lastExchange) >= getExchangeInterval() {
+					requestPeers(plugin)
+
+					lastExchange = time.Now()
+				}
+			}
+		}
+
+		plugin.LogSuccess("Stopping Peer Exchange Processor ... done")
+	}
+}
+
+// we ask more often while we know only a few peers (i.e. after all entry nodes went offline)
+func getExchangeInterval() time.Duration {
+	knownPeersUnlock := knownpeers.INSTANCE.Lock()
+	knownPeerCount := len(knownpeers.INSTANCE.Peers)
+	knownPeersUnlock()
+
+	if knownPeerCount < constants.PEER_EXCHANGE_MIN_KNOWN_PEERS {
+		return constants.PEER_EXCHANGE_BOOTSTRAP_INTERVAL
+	}
+
+	return constants.PEER_EXCHANGE_INTERVAL
+}
+
+// asks a random neighbor (or a random known peer if we have no neighbors) for a sample of its known peers
+func requestPeers(plugin *node.Plugin) {
+	candidates := getExchangeCandidates()
+	if len(candidates) == 0 {
+		return
+	}
+
+	exchangePartner := candidates[rand.Intn(len(candidates))]
+
+	exchangeRequest := &exchangerequest.ExchangeRequest{
+		Issuer: ownpeer.Snapshot(),
+	}
+	exchangeRequest.Sign(exchangePartner.Identity)
+
+	setExchangePending(exchangePartner)
+	expectAnswer(exchangePartner)
+
+	go func() {
+		if _, err := exchangePartner.Send(exchangeRequest.Marshal(), types.PROTOCOL_TYPE_UDP, false); err != nil {
+			plugin.Log.Debug("error when sending peer exchange request", "peer", exchangePartner, "error", err)
+		} else {
+			plugin.Log.Debug("sent peer exchange request", "peer", exchangePartner)
+		}
+	}()
+}
+
+func getExchangeCandidates() peerlist.PeerList {
+	candidates := make(peerlist.PeerList, 0)
+
+	chosenNeighborsUnlock := chosenneighbors.INSTANCE.Lock()
+	candidates = append(candidates, chosenneighbors.INSTANCE.List()...)
+	chosenNeighborsUnlock()
+
+	acceptedNeighborsUnlock := acceptedneighbors.INSTANCE.Lock()
+	candidates = append(candidates, acceptedneighbors.INSTANCE.List()...)
+	acceptedNeighborsUnlock()
+
+	if len(candidates) == 0 {
+		knownPeersUnlock := knownpeers.INSTANCE.Lock()
+		candidates = knownpeers.INSTANCE.List()
+		knownPeersUnlock()
+	}
+
+	return candidates
+}
+
+// remembers that we asked the peer, so that we accept its response
+func setExchangePending(p *peer.Peer) {
+	pendingExchangesMutex.Lock()
+	defer pendingExchangesMutex.Unlock()
+
+	now := time.Now()
+	for identifier, requestTime := range pendingExchanges {
+		if now.Sub(requestTime) > constants.PEER_EXCHANGE_RESPONSE_TIMEOUT {
+			delete(pendingExchanges, identifier)
+		}
+	}
+
+	pendingExchanges[p.Identity.StringIdentifier] = now
+}
+
+// returns true (and forgets the request) if we asked the peer recently
+func removeExchangePending(p *peer.Peer) bool {
+	pendingExchangesMutex.Lock()
+	defer pendingExchangesMutex.Unlock()
+
+	requestTime, exists := pendingExchanges[p.Identity.StringIdentifier]
+	if !exists {
+		return false
+	}
+	delete(pendingExchanges, p.Identity.StringIdentifier)
+
+	return time.Since(requestTime) <= constants.PEER_EXCHANGE_RESPONSE_TIMEOUT
+}
+
+var pendingExchanges = make(map[string]time.Time)
+
+var pendingExchangesMutex sync.Mutex
@@ -6,48 +6,38 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/accountability"
 	"github.com/iotaledger/goshimmer/packages/daemon"
-	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/neighborhood"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/constants"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/types"
-	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerlist"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/ping"
-	"github.com/iotaledger/goshimmer/plugins/autopeering/types/salt"
 )
 
 var lastPing time.Time
 
-func createOutgoingPingProcessor(plugin *node.Plugin) func() {
-	return func() {
+func createOutgoingPingProcessor(plugin *node.Plugin) daemon.WorkerFunc {
+	return func(shutdownSignal <-chan struct{}) {
 		plugin.LogInfo("Starting Ping Processor ...")
 		plugin.LogSuccess("Starting Ping Processor ... done")
 
 		lastPing = time.Now().Add(-constants.PING_CYCLE_LENGTH)
 
-		outgoingPing := &ping.Ping{
-			Issuer: ownpeer.INSTANCE,
-		}
-		outgoingPing.Sign()
-
-		saltmanager.Events.UpdatePublicSalt.Attach(events.NewClosure(func(salt *salt.Salt) {
-			outgoingPing.Sign()
-		}))
-
-		pingPeers(plugin, outgoingPing)
+		pingPeers(plugin)
 
 		ticker := time.NewTicker(constants.PING_PROCESS_INTERVAL)
 	ticker:
 		for {
 			select {
-			case <-daemon.ShutdownSignal:
+			case <-shutdownSignal:
 				plugin.LogInfo("Stopping Ping Processor ...")
 
 				break ticker
 			case <-ticker.C:
-				pingPeers(plugin, outgoingPing)
+				pingPeers(plugin)
 			}
 		}
 
@@ -55,32 +45,60 @@ func createOutgoingPingProcessor(plugin *node.Plugin) func() {
 	}
 }
 
-func pingPeers(plugin *node.Plugin, outgoingPing *ping.Ping) {
-	if len(neighborhood.LIST_INSTANCE) >= 1 {
-		pingDelay := constants.PING_CYCLE_LENGTH / time.Duration(len(neighborhood.LIST_INSTANCE))
+func pingPeers(plugin *node.Plugin) {
+	if PingIsDue(lastPing, time.Now(), len(neighborhood.LIST_INSTANCE)) {
+		for _, chosenPeer := range ChoosePingTargets(neighborhood.LIST_INSTANCE, accountability.OwnId().StringIdentifier, rand.Intn) {
+			go func(chosenPeer *peer.Peer) {
+				// every ping gets its own stamp (the receivers reject replayed pings) - the peer is asked to reply, since
+				// the ping only counts as successful once we hear from the peer
+				outgoingPing := &ping.Ping{
+					Issuer:         ownpeer.Snapshot(),
+					ReplyRequested: true,
+				}
+				outgoingPing.Sign(chosenPeer.Identity)
+
+				expectAnswer(chosenPeer)
 
-		if lastPing.Add(pingDelay).Before(time.Now()) {
-			chosenPeers := make(map[string]*peer.Peer)
+				if _, err := chosenPeer.Send(outgoingPing.Marshal(), types.PROTOCOL_TYPE_UDP, false); err != nil {
+					plugin.Log.Debug("error when sending ping", "peer", chosenPeer, "error", err)
 
-			for i := 0; i < constants.PING_CONTACT_COUNT_PER_CYCLE; i++ {
-				randomNeighborHoodPeer := neighborhood.LIST_INSTANCE[rand.Intn(len(neighborhood.LIST_INSTANCE))]
+					knownpeers.RecordPing(chosenPeer, false)
+				} else {
+					plugin.Log.Debug("sent ping", "peer", chosenPeer)
 
-				if randomNeighborHoodPeer.Identity.StringIdentifier != accountability.OwnId().StringIdentifier {
-					chosenPeers[randomNeighborHoodPeer.Identity.StringIdentifier] = randomNeighborHoodPeer
+					knownpeers.RecordPingSent(chosenPeer)
 				}
-			}
+			}(chosenPeer)
+		}
 
-			for _, chosenPeer := range chosenPeers {
-				go func(chosenPeer *peer.Peer) {
-					if _, err := chosenPeer.Send(outgoingPing.Marshal(), types.PROTOCOL_TYPE_UDP, false); err != nil {
-						plugin.LogDebug("error when sending ping to " + chosenPeer.String() + ": " + err.Error())
-					} else {
-						plugin.LogDebug("sent ping to " + chosenPeer.String())
-					}
-				}(chosenPeer)
-			}
+		lastPing = time.Now()
+	}
+}
+
+// Returns true if the next pings should be sent - the pings of a cycle are spread over the cycle according to the
+// amount of peers in the neighborhood.
+func PingIsDue(lastPing time.Time, now time.Time, neighborhoodSize int) bool {
+	if neighborhoodSize < 1 {
+		return false
+	}
+
+	return lastPing.Add(constants.PING_CYCLE_LENGTH / time.Duration(neighborhoodSize)).Before(now)
+}
+
+// Picks the random peers of the neighborhood that get pinged next (in the order they were picked and without
+// duplicates or our own peer) - randomIndex returns a random number in [0, n).
+func ChoosePingTargets(neighborhoodPeers peerlist.PeerList, ownIdentifier string, randomIndex func(n int) int) peerlist.PeerList {
+	chosenPeers := make(peerlist.PeerList, 0, constants.PING_CONTACT_COUNT_PER_CYCLE)
+	chosenIdentifiers := make(map[string]bool, constants.PING_CONTACT_COUNT_PER_CYCLE)
 
-			lastPing = time.Now()
+	for i := 0; i < constants.PING_CONTACT_COUNT_PER_CYCLE; i++ {
+		randomNeighborHoodPeer := neighborhoodPeers[randomIndex(len(neighborhoodPeers))]
+
+		if identifier := randomNeighborHoodPeer.Identity.StringIdentifier; identifier != ownIdentifier && !chosenIdentifiers[identifier] {
+			chosenIdentifiers[identifier] = true
+			chosenPeers = append(chosenPeers, randomNeighborHoodPeer)
 		}
 	}
+
+	return chosenPeers
 }
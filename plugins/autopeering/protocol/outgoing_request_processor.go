@@ -9,32 +9,30 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/timeutil"
 
-	"github.com/iotaledger/goshimmer/packages/accountability"
 	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/node"
-	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/acceptedneighbors"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/constants"
-	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
 )
 
-func createOutgoingRequestProcessor(plugin *node.Plugin) func() {
-	return func() {
+func createOutgoingRequestProcessor(plugin *node.Plugin) daemon.WorkerFunc {
+	return func(shutdownSignal <-chan struct{}) {
 		plugin.LogInfo("Starting Chosen Neighbor Processor ...")
 		plugin.LogSuccess("Starting Chosen Neighbor Processor ... done")
 
-		sendOutgoingRequests(plugin)
+		sendOutgoingRequests(plugin, shutdownSignal)
 
 		ticker := time.NewTicker(constants.FIND_NEIGHBOR_INTERVAL)
 	ticker:
 		for {
 			select {
-			case <-daemon.ShutdownSignal:
+			case <-shutdownSignal:
 				plugin.LogInfo("Stopping Chosen Neighbor Processor ...")
 
 				break ticker
 			case <-ticker.C:
-				sendOutgoingRequests(plugin)
+				sendOutgoingRequests(plugin, shutdownSignal)
 			}
 		}
 
@@ -42,18 +40,22 @@ func createOutgoingRequestProcessor(plugin *node.Plugin) func() {
 	}
 }
 
-func sendOutgoingRequests(plugin *node.Plugin) {
+func sendOutgoingRequests(plugin *node.Plugin, shutdownSignal <-chan struct{}) {
 	for _, chosenNeighborCandidate := range chosenneighbors.CANDIDATES.Clone() {
-		timeutil.Sleep(5 * time.Second)
+		timeutil.Sleep(5*time.Second, shutdownSignal)
 
-		if candidateShouldBeContacted(chosenNeighborCandidate) {
+		if ownState.CandidateShouldBeContacted(chosenNeighborCandidate) {
 			doneChan := make(chan int, 1)
 
 			go func(doneChan chan int) {
-				if dialed, err := chosenNeighborCandidate.Send(outgoingrequest.INSTANCE.Marshal(), types.PROTOCOL_TYPE_TCP, true); err != nil {
+				knownpeers.RecordRequestSent(chosenNeighborCandidate)
+
+				if dialed, err := chosenNeighborCandidate.Send(outgoingrequest.MarshalSigned(chosenNeighborCandidate), types.PROTOCOL_TYPE_TCP, true); err != nil {
 					plugin.LogDebug(err.Error())
+
+					knownpeers.RecordRequestFailure(chosenNeighborCandidate)
 				} else {
-					plugin.LogDebug("sent peering request to " + chosenNeighborCandidate.String())
+					plugin.Log.Debug("sent peering request", "peer", chosenNeighborCandidate)
 
 					if dialed {
 						tcp.HandleConnection(chosenNeighborCandidate.GetConn())
@@ -64,7 +66,7 @@ func sendOutgoingRequests(plugin *node.Plugin) {
 			}(doneChan)
 
 			select {
-			case <-daemon.ShutdownSignal:
+			case <-shutdownSignal:
 				return
 			case <-doneChan:
 				continue
@@ -72,11 +74,3 @@ func sendOutgoingRequests(plugin *node.Plugin) {
 		}
 	}
 }
-
-func candidateShouldBeContacted(candidate *peer.Peer) bool {
-	nodeId := candidate.Identity.StringIdentifier
-
-	return (!acceptedneighbors.INSTANCE.Contains(nodeId) && !chosenneighbors.INSTANCE.Contains(nodeId) &&
-		accountability.OwnId().StringIdentifier != nodeId) && (len(chosenneighbors.INSTANCE.Peers) < constants.NEIGHBOR_COUNT/2 ||
-		chosenneighbors.OWN_DISTANCE(candidate) < chosenneighbors.FURTHEST_NEIGHBOR_DISTANCE)
-}
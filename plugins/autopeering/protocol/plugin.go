@@ -3,16 +3,21 @@ package protocol
 import (
 	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/parameters"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/server/tcp"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/server/udp"
 )
 
 func Configure(plugin *node.Plugin) {
+	configureOwnState()
+
 	errorHandler := createErrorHandler(plugin)
 
 	udp.Events.ReceiveDrop.Attach(createIncomingDropProcessor(plugin))
 	udp.Events.ReceivePing.Attach(createIncomingPingProcessor(plugin))
+	udp.Events.ReceiveExchangeRequest.Attach(createIncomingExchangeRequestProcessor(plugin))
+	udp.Events.ReceiveExchangeResponse.Attach(createIncomingExchangeResponseProcessor(plugin))
 	udp.Events.Error.Attach(errorHandler)
 
 	tcp.Events.ReceiveRequest.Attach(createIncomingRequestProcessor(plugin))
@@ -21,12 +26,13 @@ func Configure(plugin *node.Plugin) {
 }
 
 func Run(plugin *node.Plugin) {
-	daemon.BackgroundWorker("Autopeering Chosen Neighbor Dropper", createChosenNeighborDropper(plugin))
-	daemon.BackgroundWorker("Autopeering Accepted Neighbor Dropper", createAcceptedNeighborDropper(plugin))
+	daemon.BackgroundWorker("Autopeering Chosen Neighbor Dropper", createChosenNeighborDropper(plugin), shutdown.PRIORITY_AUTOPEERING)
+	daemon.BackgroundWorker("Autopeering Accepted Neighbor Dropper", createAcceptedNeighborDropper(plugin), shutdown.PRIORITY_AUTOPEERING)
 
 	if *parameters.SEND_REQUESTS.Value {
-		daemon.BackgroundWorker("Autopeering Outgoing Request Processor", createOutgoingRequestProcessor(plugin))
+		daemon.BackgroundWorker("Autopeering Outgoing Request Processor", createOutgoingRequestProcessor(plugin), shutdown.PRIORITY_AUTOPEERING)
 	}
 
-	daemon.BackgroundWorker("Autopeering Outgoing Ping Processor", createOutgoingPingProcessor(plugin))
+	daemon.BackgroundWorker("Autopeering Outgoing Ping Processor", createOutgoingPingProcessor(plugin), shutdown.PRIORITY_AUTOPEERING)
+	daemon.BackgroundWorker("Autopeering Peer Exchange Processor", createOutgoingExchangeProcessor(plugin), shutdown.PRIORITY_AUTOPEERING)
 }
@@ -0,0 +1,21 @@
+package protocol
+
+import (
+	"github.com/iotaledger/goshimmer/packages/accountability"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/stamp"
+)
+
+// Checks the stamp of a received message whose signature was verified when it was unmarshaled: the message has to be
+// addressed to us, fresh and not replayed. The nonce only enters the replay cache here (after the signature and the
+// recipient were checked), so that forged or misdirected messages cannot fill it.
+func verifyStamp(plugin *node.Plugin, issuer *peer.Peer, messageStamp *stamp.Stamp) bool {
+	if err := stamp.Verify(accountability.OwnId(), issuer.Identity.StringIdentifier, messageStamp); err != nil {
+		plugin.Log.Debug("ignoring message", "peer", issuer, "error", err)
+
+		return false
+	}
+
+	return true
+}
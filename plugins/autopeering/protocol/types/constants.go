@@ -6,4 +6,5 @@ const (
 
 	ADDRESS_TYPE_IPV4 = AddressType(0)
 	ADDRESS_TYPE_IPV6 = AddressType(1)
+	ADDRESS_TYPE_NONE = AddressType(2)
 )
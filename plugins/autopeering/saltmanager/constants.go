@@ -3,8 +3,17 @@ package saltmanager
 import "time"
 
 const (
-	PUBLIC_SALT_LIFETIME  = 1800 * time.Second
-	PRIVATE_SALT_LIFETIME = 1800 * time.Second
+	DEFAULT_PUBLIC_SALT_LIFETIME  = 1800 * time.Second
+	DEFAULT_PRIVATE_SALT_LIFETIME = 1800 * time.Second
+
+	// the configured lifetimes have to lie within these limits (other peers reject public salts that live longer)
+	MIN_SALT_LIFETIME         = 60 * time.Second
+	MAX_PUBLIC_SALT_LIFETIME  = 7200 * time.Second
+	MAX_PRIVATE_SALT_LIFETIME = 365 * 24 * time.Hour
+
+	// salts that expire within this time are not accepted (and salts that expire later than allowed by up to this time
+	// are tolerated) to account for differing clocks
+	SALT_CLOCK_TOLERANCE = 1 * time.Minute
 )
 
 var (
@@ -7,17 +7,43 @@ import (
 	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/packages/settings"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/parameters"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/salt"
 )
 
 var (
 	PRIVATE_SALT *salt.Salt
 	PUBLIC_SALT  *salt.Salt
+
+	PUBLIC_SALT_LIFETIME  = DEFAULT_PUBLIC_SALT_LIFETIME
+	PRIVATE_SALT_LIFETIME = DEFAULT_PRIVATE_SALT_LIFETIME
 )
 
 func Configure(plugin *node.Plugin) {
-	PRIVATE_SALT = createSalt(PRIVATE_SALT_SETTINGS_KEY, PRIVATE_SALT_LIFETIME, Events.UpdatePrivateSalt.Trigger)
-	PUBLIC_SALT = createSalt(PUBLIC_SALT_SETTINGS_KEY, PUBLIC_SALT_LIFETIME, Events.UpdatePublicSalt.Trigger)
+	PUBLIC_SALT_LIFETIME = *parameters.PUBLIC_SALT_LIFETIME.SetRange(MIN_SALT_LIFETIME, MAX_PUBLIC_SALT_LIFETIME).Value
+	PRIVATE_SALT_LIFETIME = *parameters.PRIVATE_SALT_LIFETIME.SetRange(MIN_SALT_LIFETIME, MAX_PRIVATE_SALT_LIFETIME).Value
+
+	PRIVATE_SALT = createSalt(PRIVATE_SALT_SETTINGS_KEY, PRIVATE_SALT_LIFETIME, Events.UpdatePrivateSalt.Trigger, privateSaltRotationSignal)
+	PUBLIC_SALT = createSalt(PUBLIC_SALT_SETTINGS_KEY, PUBLIC_SALT_LIFETIME, Events.UpdatePublicSalt.Trigger, publicSaltRotationSignal)
+}
+
+// Replaces the public salt before it expires (i.e. to test how the network copes with the resulting neighbor churn).
+func RotatePublicSalt() {
+	requestRotation(publicSaltRotationSignal)
+}
+
+// Replaces the private salt before it expires.
+func RotatePrivateSalt() {
+	requestRotation(privateSaltRotationSignal)
+}
+
+// a pending rotation request is enough - further requests before the salt updater picks it up are dropped
+func requestRotation(rotationSignal chan bool) {
+	select {
+	case rotationSignal <- true:
+	default:
+	}
 }
 
 func generateNewSalt(key []byte, lifetime time.Duration) *salt.Salt {
@@ -43,11 +69,16 @@ func getSalt(key []byte, lifetime time.Duration) *salt.Salt {
 	if resultingSalt, err := salt.Unmarshal(saltBytes); err != nil {
 		panic(err)
 	} else {
+		// a salt that was stored with a longer lifetime is shortened to the configured one
+		if maxExpirationTime := time.Now().Add(lifetime); resultingSalt.ExpirationTime.After(maxExpirationTime) {
+			resultingSalt.ExpirationTime = maxExpirationTime
+		}
+
 		return resultingSalt
 	}
 }
 
-func updatePublicSalt(saltToUpdate *salt.Salt, settingsKey []byte, lifeSpan time.Duration, updateCallback func(params ...interface{})) {
+func updateSalt(saltToUpdate *salt.Salt, settingsKey []byte, lifeSpan time.Duration, updateCallback func(params ...interface{})) {
 	newSalt := salt.New(lifeSpan)
 
 	saltToUpdate.Bytes = newSalt.Bytes
@@ -58,31 +89,35 @@ func updatePublicSalt(saltToUpdate *salt.Salt, settingsKey []byte, lifeSpan time
 	}
 
 	updateCallback(saltToUpdate)
-
-	scheduleUpdateForSalt(saltToUpdate, settingsKey, lifeSpan, updateCallback)
 }
 
-func scheduleUpdateForSalt(saltToUpdate *salt.Salt, settingsKey []byte, lifeSpan time.Duration, callback func(params ...interface{})) {
-	now := time.Now()
-
-	if saltToUpdate.ExpirationTime.Before(now) {
-		updatePublicSalt(saltToUpdate, settingsKey, lifeSpan, callback)
-	} else {
-		daemon.BackgroundWorker("Salt Updater", func() {
+// starts the worker that replaces the salt when it expires or when a rotation is requested
+func scheduleUpdatesForSalt(saltToUpdate *salt.Salt, settingsKey []byte, lifeSpan time.Duration, callback func(params ...interface{}), rotationSignal chan bool) {
+	daemon.BackgroundWorker("Salt Updater", func(shutdownSignal <-chan struct{}) {
+		for {
 			select {
-			case <-time.After(saltToUpdate.ExpirationTime.Sub(now)):
-				updatePublicSalt(saltToUpdate, settingsKey, lifeSpan, callback)
-			case <-daemon.ShutdownSignal:
+			case <-time.After(time.Until(saltToUpdate.ExpirationTime)):
+				updateSalt(saltToUpdate, settingsKey, lifeSpan, callback)
+			case <-rotationSignal:
+				updateSalt(saltToUpdate, settingsKey, lifeSpan, callback)
+			case <-shutdownSignal:
 				return
 			}
-		})
-	}
+		}
+	}, shutdown.PRIORITY_AUTOPEERING)
 }
 
-func createSalt(settingsKey []byte, lifeSpan time.Duration, updateCallback func(params ...interface{})) *salt.Salt {
+func createSalt(settingsKey []byte, lifeSpan time.Duration, updateCallback func(params ...interface{}), rotationSignal chan bool) *salt.Salt {
 	newSalt := getSalt(settingsKey, lifeSpan)
+	if newSalt.ExpirationTime.Before(time.Now()) {
+		updateSalt(newSalt, settingsKey, lifeSpan, updateCallback)
+	}
 
-	scheduleUpdateForSalt(newSalt, settingsKey, lifeSpan, updateCallback)
+	scheduleUpdatesForSalt(newSalt, settingsKey, lifeSpan, updateCallback, rotationSignal)
 
 	return newSalt
 }
+
+var publicSaltRotationSignal = make(chan bool, 1)
+
+var privateSaltRotationSignal = make(chan bool, 1)
@@ -6,12 +6,18 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/salt"
 )
 
+// Checks if the public salt of another peer is neither expired nor lives longer than MAX_PUBLIC_SALT_LIFETIME (the
+// peers can configure different lifetimes, so we can not compare it to our own lifetime).
 func CheckSalt(saltToCheck *salt.Salt) error {
-	now := time.Now()
-	if saltToCheck.ExpirationTime.Before(now.Add(-1 * time.Minute)) {
+	return CheckSaltAt(saltToCheck, time.Now())
+}
+
+// Checks the salt like CheckSalt does but against the given time (i.e. the virtual clock of the simulation).
+func CheckSaltAt(saltToCheck *salt.Salt, now time.Time) error {
+	if saltToCheck.ExpirationTime.Before(now.Add(-SALT_CLOCK_TOLERANCE)) {
 		return ErrPublicSaltExpired
 	}
-	if saltToCheck.ExpirationTime.After(now.Add(PUBLIC_SALT_LIFETIME + 1*time.Minute)) {
+	if saltToCheck.ExpirationTime.After(now.Add(MAX_PUBLIC_SALT_LIFETIME + SALT_CLOCK_TOLERANCE)) {
 		return ErrPublicSaltInvalidLifetime
 	}
 
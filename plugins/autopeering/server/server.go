@@ -15,8 +15,3 @@ func Run(plugin *node.Plugin) {
 	udp.RunServer(plugin)
 	tcp.RunServer(plugin)
 }
-
-func Shutdown(plugin *node.Plugin) {
-	udp.ShutdownUDPServer(plugin)
-	tcp.ShutdownServer(plugin)
-}
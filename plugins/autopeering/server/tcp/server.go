@@ -3,13 +3,13 @@ package tcp
 import (
 	"math"
 	"net"
-	"strconv"
 
 	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/network"
 	"github.com/iotaledger/goshimmer/packages/network/tcp"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/parameters"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/ping"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/request"
@@ -22,14 +22,10 @@ var server = tcp.NewServer()
 func ConfigureServer(plugin *node.Plugin) {
 	server.Events.Connect.Attach(events.NewClosure(HandleConnection))
 	server.Events.Error.Attach(events.NewClosure(func(err error) {
-		plugin.LogFailure("error in tcp server: " + err.Error())
+		plugin.Log.Failure("error in tcp server", "error", err)
 	}))
 	server.Events.Start.Attach(events.NewClosure(func() {
-		if *parameters.ADDRESS.Value == "0.0.0.0" {
-			plugin.LogSuccess("Starting TCP Server (port " + strconv.Itoa(*parameters.PORT.Value) + ") ... done")
-		} else {
-			plugin.LogSuccess("Starting TCP Server (" + *parameters.ADDRESS.Value + ":" + strconv.Itoa(*parameters.PORT.Value) + ") ... done")
-		}
+		plugin.Log.Success("Starting TCP Server ... done", "addresses", network.DescribeBindAddresses(*parameters.PORT.Value, *parameters.ADDRESS.Value...))
 	}))
 	server.Events.Shutdown.Attach(events.NewClosure(func() {
 		plugin.LogSuccess("Stopping TCP Server ... done")
@@ -37,15 +33,17 @@ func ConfigureServer(plugin *node.Plugin) {
 }
 
 func RunServer(plugin *node.Plugin) {
-	daemon.BackgroundWorker("Autopeering TCP Server", func() {
-		if *parameters.ADDRESS.Value == "0.0.0.0" {
-			plugin.LogInfo("Starting TCP Server (port " + strconv.Itoa(*parameters.PORT.Value) + ") ...")
-		} else {
-			plugin.LogInfo("Starting TCP Server (" + *parameters.ADDRESS.Value + ":" + strconv.Itoa(*parameters.PORT.Value) + ") ...")
-		}
+	daemon.BackgroundWorker("Autopeering TCP Server", func(shutdownSignal <-chan struct{}) {
+		plugin.Log.Info("Starting TCP Server ...", "addresses", network.DescribeBindAddresses(*parameters.PORT.Value, *parameters.ADDRESS.Value...))
+
+		go func() {
+			<-shutdownSignal
+
+			ShutdownServer(plugin)
+		}()
 
-		server.Listen(*parameters.PORT.Value)
-	})
+		server.Listen(*parameters.PORT.Value, *parameters.ADDRESS.Value...)
+	}, shutdown.PRIORITY_AUTOPEERING)
 }
 
 func ShutdownServer(plugin *node.Plugin) {
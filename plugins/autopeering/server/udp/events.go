@@ -5,22 +5,28 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/drop"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/exchangerequest"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/exchangeresponse"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/ping"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/request"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/response"
 )
 
 var Events = struct {
-	ReceiveDrop     *events.Event
-	ReceivePing     *events.Event
-	ReceiveRequest  *events.Event
-	ReceiveResponse *events.Event
-	Error           *events.Event
+	ReceiveDrop             *events.Event
+	ReceivePing             *events.Event
+	ReceiveRequest          *events.Event
+	ReceiveResponse         *events.Event
+	ReceiveExchangeRequest  *events.Event
+	ReceiveExchangeResponse *events.Event
+	Error                   *events.Event
 }{
 	events.NewEvent(dropCaller),
 	events.NewEvent(pingCaller),
 	events.NewEvent(requestCaller),
 	events.NewEvent(responseCaller),
+	events.NewEvent(exchangeRequestCaller),
+	events.NewEvent(exchangeResponseCaller),
 	events.NewEvent(errorCaller),
 }
 
@@ -36,6 +42,12 @@ func requestCaller(handler interface{}, params ...interface{}) {
 func responseCaller(handler interface{}, params ...interface{}) {
 	handler.(func(*response.Response))(params[0].(*response.Response))
 }
+func exchangeRequestCaller(handler interface{}, params ...interface{}) {
+	handler.(func(*exchangerequest.ExchangeRequest))(params[0].(*exchangerequest.ExchangeRequest))
+}
+func exchangeResponseCaller(handler interface{}, params ...interface{}) {
+	handler.(func(*exchangeresponse.ExchangeResponse))(params[0].(*exchangeresponse.ExchangeResponse))
+}
 func errorCaller(handler interface{}, params ...interface{}) {
 	handler.(func(net.IP, error))(params[0].(net.IP), params[1].(error))
 }
@@ -3,21 +3,24 @@ package udp
 import (
 	"math"
 	"net"
-	"strconv"
 
 	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/packages/network"
 	"github.com/iotaledger/goshimmer/packages/network/udp"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/parameters"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/drop"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/exchangerequest"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/exchangeresponse"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/ping"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/request"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/response"
 	"github.com/pkg/errors"
 )
 
-var udpServer = udp.NewServer(int(math.Max(float64(request.MARSHALED_TOTAL_SIZE), float64(response.MARSHALED_TOTAL_SIZE))))
+var udpServer = udp.NewServer(int(math.Max(math.Max(float64(request.MARSHALED_TOTAL_SIZE), float64(response.MARSHALED_TOTAL_SIZE)), float64(exchangeresponse.MARSHALED_TOTAL_SIZE))))
 
 func ConfigureServer(plugin *node.Plugin) {
 	Events.Error.Attach(events.NewClosure(func(ip net.IP, err error) {
@@ -26,14 +29,10 @@ func ConfigureServer(plugin *node.Plugin) {
 
 	udpServer.Events.ReceiveData.Attach(events.NewClosure(processReceivedData))
 	udpServer.Events.Error.Attach(events.NewClosure(func(err error) {
-		plugin.LogFailure("error in udp server: " + err.Error())
+		plugin.Log.Failure("error in udp server", "error", err)
 	}))
 	udpServer.Events.Start.Attach(events.NewClosure(func() {
-		if *parameters.ADDRESS.Value == "0.0.0.0" {
-			plugin.LogSuccess("Starting UDP Server (port " + strconv.Itoa(*parameters.PORT.Value) + ") ... done")
-		} else {
-			plugin.LogSuccess("Starting UDP Server (" + *parameters.ADDRESS.Value + ":" + strconv.Itoa(*parameters.PORT.Value) + ") ... done")
-		}
+		plugin.Log.Success("Starting UDP Server ... done", "addresses", network.DescribeBindAddresses(*parameters.PORT.Value, *parameters.ADDRESS.Value...))
 	}))
 	udpServer.Events.Shutdown.Attach(events.NewClosure(func() {
 		plugin.LogSuccess("Stopping UDP Server ... done")
@@ -41,15 +40,17 @@ func ConfigureServer(plugin *node.Plugin) {
 }
 
 func RunServer(plugin *node.Plugin) {
-	daemon.BackgroundWorker("Autopeering UDP Server", func() {
-		if *parameters.ADDRESS.Value == "0.0.0.0" {
-			plugin.LogInfo("Starting UDP Server (port " + strconv.Itoa(*parameters.PORT.Value) + ") ...")
-		} else {
-			plugin.LogInfo("Starting UDP Server (" + *parameters.ADDRESS.Value + ":" + strconv.Itoa(*parameters.PORT.Value) + ") ...")
-		}
+	daemon.BackgroundWorker("Autopeering UDP Server", func(shutdownSignal <-chan struct{}) {
+		plugin.Log.Info("Starting UDP Server ...", "addresses", network.DescribeBindAddresses(*parameters.PORT.Value, *parameters.ADDRESS.Value...))
 
-		udpServer.Listen(*parameters.ADDRESS.Value, *parameters.PORT.Value)
-	})
+		go func() {
+			<-shutdownSignal
+
+			ShutdownUDPServer(plugin)
+		}()
+
+		udpServer.Listen(*parameters.PORT.Value, *parameters.ADDRESS.Value...)
+	}, shutdown.PRIORITY_AUTOPEERING)
 }
 
 func ShutdownUDPServer(plugin *node.Plugin) {
@@ -92,6 +93,22 @@ func processReceivedData(addr *net.UDPAddr, data []byte) {
 
 			Events.ReceiveDrop.Trigger(drop)
 		}
+	case exchangerequest.MARSHALED_PACKET_HEADER:
+		if exchangeRequest, err := exchangerequest.Unmarshal(data); err != nil {
+			Events.Error.Trigger(addr.IP, err)
+		} else {
+			exchangeRequest.Issuer.Address = addr.IP
+
+			Events.ReceiveExchangeRequest.Trigger(exchangeRequest)
+		}
+	case exchangeresponse.MARSHALED_PACKET_HEADER:
+		if exchangeResponse, err := exchangeresponse.Unmarshal(data); err != nil {
+			Events.Error.Trigger(addr.IP, err)
+		} else {
+			exchangeResponse.Issuer.Address = addr.IP
+
+			Events.ReceiveExchangeResponse.Trigger(exchangeResponse)
+		}
 	default:
 		Events.Error.Trigger(addr.IP, errors.New("invalid UDP peering packet from "+addr.IP.String()))
 	}
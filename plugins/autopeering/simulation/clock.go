@@ -0,0 +1,129 @@
+package simulation
+
+import (
+	"container/heap"
+	"time"
+)
+
+// Clock is a virtual clock that executes scheduled callbacks in the order of their due time (callbacks with the same
+// due time are executed in the order they were scheduled). Time only advances when the clock is advanced explicitly,
+// which makes the simulation deterministic and independent of the wall clock. It is not safe for concurrent use - the
+// whole simulation runs in the goroutine that advances the clock.
+type Clock struct {
+	now             time.Time
+	scheduledEvents scheduledEvents
+	sequence        uint64
+}
+
+func NewClock(startTime time.Time) *Clock {
+	return &Clock{
+		now:             startTime,
+		scheduledEvents: make(scheduledEvents, 0),
+	}
+}
+
+func (clock *Clock) Now() time.Time {
+	return clock.now
+}
+
+// Schedules the callback to be executed after the given delay.
+func (clock *Clock) Schedule(delay time.Duration, callback func()) {
+	clock.ScheduleAt(clock.now.Add(delay), callback)
+}
+
+// Schedules the callback to be executed at the given time (callbacks in the past are executed with the next advance).
+func (clock *Clock) ScheduleAt(dueTime time.Time, callback func()) {
+	if dueTime.Before(clock.now) {
+		dueTime = clock.now
+	}
+
+	clock.sequence++
+
+	heap.Push(&clock.scheduledEvents, &scheduledEvent{
+		dueTime:  dueTime,
+		sequence: clock.sequence,
+		callback: callback,
+	})
+}
+
+// Executes the callback every interval (starting after the given initial delay) as long as it returns true.
+func (clock *Clock) Every(initialDelay time.Duration, interval time.Duration, callback func() bool) {
+	var scheduleNext func(delay time.Duration)
+	scheduleNext = func(delay time.Duration) {
+		clock.Schedule(delay, func() {
+			if callback() {
+				scheduleNext(interval)
+			}
+		})
+	}
+
+	scheduleNext(initialDelay)
+}
+
+// Advances the clock by the given duration and executes all callbacks that become due.
+func (clock *Clock) Advance(duration time.Duration) {
+	clock.RunUntil(clock.now.Add(duration))
+}
+
+// Executes all callbacks that are due until the given time (including the ones that get scheduled while doing so).
+func (clock *Clock) RunUntil(endTime time.Time) {
+	for len(clock.scheduledEvents) != 0 && !clock.scheduledEvents[0].dueTime.After(endTime) {
+		nextEvent := heap.Pop(&clock.scheduledEvents).(*scheduledEvent)
+
+		clock.now = nextEvent.dueTime
+
+		nextEvent.callback()
+	}
+
+	if endTime.After(clock.now) {
+		clock.now = endTime
+	}
+}
+
+// Returns the number of callbacks that are waiting to be executed.
+func (clock *Clock) PendingEvents() int {
+	return len(clock.scheduledEvents)
+}
+
+// region scheduledEvents //////////////////////////////////////////////////////////////////////////////////////////////
+
+type scheduledEvent struct {
+	dueTime  time.Time
+	sequence uint64
+	callback func()
+}
+
+// scheduledEvents implements heap.Interface and orders the events by their due time and their sequence number.
+type scheduledEvents []*scheduledEvent
+
+func (events scheduledEvents) Len() int {
+	return len(events)
+}
+
+func (events scheduledEvents) Less(i, j int) bool {
+	if events[i].dueTime.Equal(events[j].dueTime) {
+		return events[i].sequence < events[j].sequence
+	}
+
+	return events[i].dueTime.Before(events[j].dueTime)
+}
+
+func (events scheduledEvents) Swap(i, j int) {
+	events[i], events[j] = events[j], events[i]
+}
+
+func (events *scheduledEvents) Push(x interface{}) {
+	*events = append(*events, x.(*scheduledEvent))
+}
+
+func (events *scheduledEvents) Pop() interface{} {
+	old := *events
+	n := len(old)
+	result := old[n-1]
+	old[n-1] = nil
+	*events = old[:n-1]
+
+	return result
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
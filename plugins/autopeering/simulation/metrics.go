@@ -0,0 +1,179 @@
+package simulation
+
+import (
+	"sort"
+	"strconv"
+)
+
+// GraphMetrics describes the topology that the autopeering formed (the links are undirected).
+type GraphMetrics struct {
+	NodeCount int
+	LinkCount int
+	// maps a degree to the number of nodes with this degree
+	DegreeDistribution map[int]int
+	MinDegree          int
+	MaxDegree          int
+	AverageDegree      float64
+	// the longest shortest path inside any of the partitions
+	Diameter int
+	// the connected components of the graph (largest first) - a healthy network consists of a single partition
+	Partitions [][]string
+}
+
+// Computes the metrics of the undirected graph that is described by the given adjacency lists (links only need to be
+// listed by one of both nodes).
+func ComputeGraphMetrics(adjacency map[string][]string) GraphMetrics {
+	links := make(map[string]map[string]bool)
+	for nodeId := range adjacency {
+		links[nodeId] = make(map[string]bool)
+	}
+
+	for nodeId, neighborIds := range adjacency {
+		for _, neighborId := range neighborIds {
+			if _, exists := links[neighborId]; !exists || neighborId == nodeId {
+				continue
+			}
+
+			links[nodeId][neighborId] = true
+			links[neighborId][nodeId] = true
+		}
+	}
+
+	nodeIds := make([]string, 0, len(links))
+	for nodeId := range links {
+		nodeIds = append(nodeIds, nodeId)
+	}
+	sort.Strings(nodeIds)
+
+	result := GraphMetrics{
+		NodeCount:          len(nodeIds),
+		DegreeDistribution: make(map[int]int),
+		Partitions:         make([][]string, 0),
+	}
+
+	degreeSum := 0
+	for i, nodeId := range nodeIds {
+		degree := len(links[nodeId])
+
+		result.DegreeDistribution[degree]++
+		if i == 0 || degree < result.MinDegree {
+			result.MinDegree = degree
+		}
+		if degree > result.MaxDegree {
+			result.MaxDegree = degree
+		}
+
+		degreeSum += degree
+	}
+	result.LinkCount = degreeSum / 2
+	if result.NodeCount != 0 {
+		result.AverageDegree = float64(degreeSum) / float64(result.NodeCount)
+	}
+
+	partitionOf := make(map[string]int)
+	for _, nodeId := range nodeIds {
+		if _, visited := partitionOf[nodeId]; visited {
+			continue
+		}
+
+		partition := make([]string, 0)
+		for reachedNodeId := range breadthFirstSearch(links, nodeId) {
+			partitionOf[reachedNodeId] = len(result.Partitions)
+			partition = append(partition, reachedNodeId)
+		}
+		sort.Strings(partition)
+
+		result.Partitions = append(result.Partitions, partition)
+	}
+	sort.SliceStable(result.Partitions, func(i, j int) bool {
+		return len(result.Partitions[i]) > len(result.Partitions[j])
+	})
+
+	for _, nodeId := range nodeIds {
+		for _, distance := range breadthFirstSearch(links, nodeId) {
+			if distance > result.Diameter {
+				result.Diameter = distance
+			}
+		}
+	}
+
+	return result
+}
+
+func (metrics GraphMetrics) IsPartitioned() bool {
+	return len(metrics.Partitions) > 1
+}
+
+func (metrics GraphMetrics) String() string {
+	degrees := make([]int, 0, len(metrics.DegreeDistribution))
+	for degree := range metrics.DegreeDistribution {
+		degrees = append(degrees, degree)
+	}
+	sort.Ints(degrees)
+
+	degreeDistribution := ""
+	for i, degree := range degrees {
+		if i != 0 {
+			degreeDistribution += " "
+		}
+		degreeDistribution += strconv.Itoa(degree) + ":" + strconv.Itoa(metrics.DegreeDistribution[degree])
+	}
+
+	return "nodes=" + strconv.Itoa(metrics.NodeCount) +
+		" links=" + strconv.Itoa(metrics.LinkCount) +
+		" degree(min/avg/max)=" + strconv.Itoa(metrics.MinDegree) + "/" + strconv.FormatFloat(metrics.AverageDegree, 'f', 2, 64) + "/" + strconv.Itoa(metrics.MaxDegree) +
+		" degrees=[" + degreeDistribution + "]" +
+		" diameter=" + strconv.Itoa(metrics.Diameter) +
+		" partitions=" + strconv.Itoa(len(metrics.Partitions))
+}
+
+// Returns the amount of (undirected) links of the first graph that do not exist in the second graph anymore.
+func CountRemovedLinks(before map[string][]string, after map[string][]string) int {
+	linksAfter := make(map[string]bool)
+	for nodeId, neighborIds := range after {
+		for _, neighborId := range neighborIds {
+			linksAfter[getLinkId(nodeId, neighborId)] = true
+		}
+	}
+
+	removedLinks := make(map[string]bool)
+	for nodeId, neighborIds := range before {
+		for _, neighborId := range neighborIds {
+			if linkId := getLinkId(nodeId, neighborId); !linksAfter[linkId] {
+				removedLinks[linkId] = true
+			}
+		}
+	}
+
+	return len(removedLinks)
+}
+
+// returns the same identifier for both directions of a link
+func getLinkId(nodeId string, neighborId string) string {
+	if nodeId < neighborId {
+		return nodeId + "-" + neighborId
+	}
+
+	return neighborId + "-" + nodeId
+}
+
+// returns the hop distance of all nodes that are reachable from the start node
+func breadthFirstSearch(links map[string]map[string]bool, startNodeId string) map[string]int {
+	distances := map[string]int{startNodeId: 0}
+
+	queue := []string{startNodeId}
+	for len(queue) != 0 {
+		currentNodeId := queue[0]
+		queue = queue[1:]
+
+		for neighborId := range links[currentNodeId] {
+			if _, visited := distances[neighborId]; !visited {
+				distances[neighborId] = distances[currentNodeId] + 1
+
+				queue = append(queue, neighborId)
+			}
+		}
+	}
+
+	return distances
+}
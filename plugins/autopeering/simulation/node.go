@@ -0,0 +1,315 @@
+package simulation
+
+import (
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/acceptedneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/constants"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/types"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/drop"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerlist"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerregister"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/ping"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/request"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/response"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/salt"
+)
+
+// Node is a virtual autopeering node that runs the rules of the protocol package (neighbor selection, droppers, pings
+// and salt updates) on its own protocol.NodeState instead of the global instances of a real node - the messages are
+// delivered by the in-memory transport and the timing is driven by the virtual clock.
+type Node struct {
+	Peer           *peer.Peer
+	PrivateSalt    *salt.Salt
+	State          *protocol.NodeState
+	online         bool
+	generation     int
+	lastPing       time.Time
+	candidates     peerlist.PeerList
+	candidateIndex int
+	clock          *Clock
+	transport      *Transport
+	random         *rand.Rand
+}
+
+func newNode(ownPeer *peer.Peer, clock *Clock, transport *Transport, random *rand.Rand, hysteresis int) *Node {
+	node := &Node{
+		Peer:        ownPeer,
+		PrivateSalt: newSalt(clock, random, saltmanager.PRIVATE_SALT_LIFETIME),
+		clock:       clock,
+		transport:   transport,
+		random:      random,
+	}
+
+	node.State = protocol.NewNodeState(ownPeer, chosenneighbors.DISTANCE(ownPeer), func(p *peer.Peer) uint64 {
+		return acceptedneighbors.GetSaltedDistance(node.Peer, node.PrivateSalt, p)
+	})
+	node.State.NeighborHysteresis = hysteresis
+
+	return node
+}
+
+func (node *Node) GetIdentifier() string {
+	return node.Peer.Identity.StringIdentifier
+}
+
+func (node *Node) IsOnline() bool {
+	return node.online
+}
+
+// Takes the node offline (its neighbors notice the broken links) or brings it back online.
+func (node *Node) SetOnline(online bool) {
+	if node.online == online {
+		return
+	}
+
+	node.online = online
+	if online {
+		node.lastPing = node.clock.Now().Add(-constants.PING_CYCLE_LENGTH)
+
+		node.start()
+	} else {
+		node.transport.dropBrokenLinks()
+	}
+}
+
+// starts the periodic tasks of the node (they are spread randomly, so the nodes do not act in lockstep)
+func (node *Node) start() {
+	node.generation++
+
+	node.clock.Every(node.randomDelay(OUTGOING_REQUEST_INTERVAL), OUTGOING_REQUEST_INTERVAL, node.whileOnline(node.sendOutgoingRequest))
+	node.clock.Every(node.randomDelay(constants.PING_PROCESS_INTERVAL), constants.PING_PROCESS_INTERVAL, node.whileOnline(node.pingPeers))
+	node.clock.Every(node.randomDelay(DROPPER_INTERVAL), DROPPER_INTERVAL, node.whileOnline(node.dropNeighbors))
+
+	node.scheduleSaltUpdates()
+}
+
+// wraps a periodic task, so that it stops when the node goes offline (or was restarted in the meantime)
+func (node *Node) whileOnline(task func()) func() bool {
+	generation := node.generation
+
+	return func() bool {
+		if !node.online || node.generation != generation {
+			return false
+		}
+
+		task()
+
+		return true
+	}
+}
+
+// Replaces both salts before they expire (like the forced rotation of a real node).
+func (node *Node) RotateSalts() {
+	node.Peer.Salt = newSalt(node.clock, node.random, saltmanager.PUBLIC_SALT_LIFETIME)
+	node.PrivateSalt = newSalt(node.clock, node.random, saltmanager.PRIVATE_SALT_LIFETIME)
+
+	// the distances changed, so the candidates need to be sorted again (like chosenneighbors.Reevaluate does)
+	node.updateCandidates()
+
+	node.scheduleSaltUpdates()
+}
+
+func (node *Node) scheduleSaltUpdates() {
+	scheduledSalt := node.Peer.Salt
+	updateSalts := node.whileOnline(func() {
+		// the salts were rotated before they expired
+		if node.Peer.Salt != scheduledSalt {
+			return
+		}
+
+		node.RotateSalts()
+	})
+
+	node.clock.ScheduleAt(scheduledSalt.ExpirationTime, func() {
+		updateSalts()
+	})
+}
+
+// region protocol /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// contacts the next candidate that should be contacted - like the outgoing request processor, the node walks through
+// the sorted candidates (one per interval) and starts over with fresh candidates at the end
+func (node *Node) sendOutgoingRequest() {
+	for ; node.candidateIndex < len(node.candidates); node.candidateIndex++ {
+		if candidate := node.candidates[node.candidateIndex]; node.State.CandidateShouldBeContacted(candidate) {
+			node.candidateIndex++
+
+			node.transport.Send(node, candidate, &request.Request{Issuer: node.copyOwnPeer()}, types.PROTOCOL_TYPE_TCP)
+
+			return
+		}
+	}
+
+	node.updateCandidates()
+}
+
+// sorts the known peers like chosenneighbors.CANDIDATES (the virtual nodes do not track the reliability of their peers)
+func (node *Node) updateCandidates() {
+	node.candidates = chosenneighbors.SortCandidates(sortedPeers(node.State.KnownPeers), node.Peer, func(p *peer.Peer) bool {
+		return false
+	})
+	node.candidateIndex = 0
+}
+
+func (node *Node) pingPeers() {
+	knownPeers := sortedPeers(node.State.KnownPeers)
+	if !protocol.PingIsDue(node.lastPing, node.clock.Now(), len(knownPeers)) {
+		return
+	}
+
+	for _, chosenPeer := range protocol.ChoosePingTargets(knownPeers, node.GetIdentifier(), node.random.Intn) {
+		node.transport.Send(node, chosenPeer, &ping.Ping{Issuer: node.copyOwnPeer()}, types.PROTOCOL_TYPE_UDP)
+	}
+
+	node.lastPing = node.clock.Now()
+}
+
+// drops the furthest neighbors while there are more neighbors than allowed (like the neighbor droppers)
+func (node *Node) dropNeighbors() {
+	sendDrop := func(furthestNeighbor *peer.Peer) {
+		node.transport.Send(node, furthestNeighbor, &drop.Drop{Issuer: node.copyOwnPeer()}, types.PROTOCOL_TYPE_UDP)
+	}
+
+	node.State.DropSurplusNeighbors(node.State.ChosenNeighbors, sendDrop)
+	node.State.DropSurplusNeighbors(node.State.AcceptedNeighbors, sendDrop)
+}
+
+// processes the received message like the corresponding incoming processor (the salts are checked against the
+// virtual clock, like the servers of a real node check them when unmarshaling the messages)
+func (node *Node) receive(message interface{}) {
+	switch typedMessage := message.(type) {
+	case *request.Request:
+		if node.checkSalt(typedMessage.Issuer.Salt) {
+			node.processRequest(typedMessage)
+		}
+	case *response.Response:
+		node.State.ProcessResponse(typedMessage)
+	case *ping.Ping:
+		if node.checkSalt(typedMessage.Issuer.Salt) {
+			node.State.ProcessPing(typedMessage.Issuer, typedMessage.Neighbors)
+		}
+	case *drop.Drop:
+		if node.checkSalt(typedMessage.Issuer.Salt) {
+			node.State.ProcessDrop(typedMessage.Issuer)
+		}
+	}
+}
+
+func (node *Node) processRequest(req *request.Request) {
+	peeringResponse := &response.Response{
+		Type:   response.TYPE_REJECT,
+		Issuer: node.copyOwnPeer(),
+	}
+
+	if node.State.ProcessRequest(req.Issuer, true) {
+		peeringResponse.Type = response.TYPE_ACCEPT
+	}
+	peeringResponse.Peers = node.generateProposedPeeringCandidates()
+
+	node.transport.Send(node, req.Issuer, peeringResponse, types.PROTOCOL_TYPE_TCP)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region utility methods //////////////////////////////////////////////////////////////////////////////////////////////
+
+func (node *Node) removeNeighbor(identifier string) {
+	node.State.ChosenNeighbors.Peers.Remove(identifier)
+	node.State.AcceptedNeighbors.Peers.Remove(identifier)
+}
+
+func (node *Node) getNeighbors() peerlist.PeerList {
+	result := sortedPeers(node.State.ChosenNeighbors.Peers)
+	for _, acceptedNeighbor := range sortedPeers(node.State.AcceptedNeighbors.Peers) {
+		if !node.State.ChosenNeighbors.Peers.Contains(acceptedNeighbor.Identity.StringIdentifier) {
+			result = append(result, acceptedNeighbor)
+		}
+	}
+
+	return result
+}
+
+func (node *Node) generateProposedPeeringCandidates() peerlist.PeerList {
+	proposedPeers := sortedPeers(node.State.KnownPeers)
+	node.random.Shuffle(len(proposedPeers), func(i, j int) {
+		proposedPeers[i], proposedPeers[j] = proposedPeers[j], proposedPeers[i]
+	})
+
+	if len(proposedPeers) > response.MARSHALED_PEERS_AMOUNT {
+		proposedPeers = proposedPeers[:response.MARSHALED_PEERS_AMOUNT]
+	}
+
+	// the receiver gets copies (like it would after unmarshaling the response)
+	for i, proposedPeer := range proposedPeers {
+		proposedPeers[i] = copyPeer(proposedPeer)
+	}
+
+	return proposedPeers
+}
+
+// checks the salt of a received message against the virtual clock
+func (node *Node) checkSalt(saltToCheck *salt.Salt) bool {
+	return saltmanager.CheckSaltAt(saltToCheck, node.clock.Now()) == nil
+}
+
+func (node *Node) copyOwnPeer() *peer.Peer {
+	return copyPeer(node.Peer)
+}
+
+func (node *Node) randomDelay(maxDelay time.Duration) time.Duration {
+	return time.Duration(node.random.Int63n(int64(maxDelay)))
+}
+
+func copyPeer(p *peer.Peer) *peer.Peer {
+	return &peer.Peer{
+		Identity:             p.Identity,
+		Address:              p.Address,
+		AlternativeAddresses: p.AlternativeAddresses,
+		ClusterIdentifier:    p.ClusterIdentifier,
+		PeeringPort:          p.PeeringPort,
+		GossipPort:           p.GossipPort,
+		Salt:                 p.Salt,
+	}
+}
+
+func newSalt(clock *Clock, random *rand.Rand, lifetime time.Duration) *salt.Salt {
+	result := &salt.Salt{
+		Bytes:          make([]byte, salt.SALT_BYTES_SIZE),
+		ExpirationTime: clock.Now().Add(lifetime),
+	}
+	random.Read(result.Bytes)
+
+	return result
+}
+
+// returns the peers ordered by their identifier (iterating the maps directly would break the determinism)
+func sortedPeers(peers *peerregister.PeerRegister) peerlist.PeerList {
+	result := peers.List()
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Identity.StringIdentifier < result[j].Identity.StringIdentifier
+	})
+
+	return result
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region constants and variables //////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// the outgoing request processor waits this long between contacting two candidates
+	OUTGOING_REQUEST_INTERVAL = 5 * time.Second
+
+	// the neighbor droppers check the number of neighbors in this interval
+	DROPPER_INTERVAL = 1 * time.Second
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
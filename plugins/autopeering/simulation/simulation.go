@@ -0,0 +1,161 @@
+package simulation
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"net"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/identity"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+)
+
+// Config defines the simulated network - zero values are replaced by the defaults.
+type Config struct {
+	NodeCount      int
+	EntryNodeCount int
+	// the seed of all random decisions (two simulations with the same config produce the same topology)
+	Seed          int64
+	StartTime     time.Time
+	Latency       time.Duration
+	LatencyJitter time.Duration
+	// the probability that a udp message (ping or drop) gets lost
+	PacketLoss float64
+	// the percentage by which a peer has to be closer than the furthest neighbor to replace it (0 = no hysteresis)
+	NeighborHysteresis int
+}
+
+// Simulation runs a network of virtual autopeering nodes in a single process on top of a virtual clock and an
+// in-memory transport (replacing the real processes that runSimulation.bat starts).
+type Simulation struct {
+	Clock      *Clock
+	Transport  *Transport
+	Nodes      []*Node
+	EntryNodes []*Node
+	config     Config
+	random     *rand.Rand
+}
+
+func New(config Config) *Simulation {
+	if config.EntryNodeCount <= 0 {
+		config.EntryNodeCount = DEFAULT_ENTRY_NODE_COUNT
+	}
+	if config.StartTime.IsZero() {
+		config.StartTime = DEFAULT_START_TIME
+	}
+	if config.Latency <= 0 {
+		config.Latency = DEFAULT_LATENCY
+	}
+
+	random := rand.New(rand.NewSource(config.Seed))
+	clock := NewClock(config.StartTime)
+
+	simulation := &Simulation{
+		Clock:     clock,
+		Transport: NewTransport(clock, random, config.Latency, config.LatencyJitter, config.PacketLoss),
+		Nodes:     make([]*Node, 0, config.NodeCount),
+		config:    config,
+		random:    random,
+	}
+
+	for i := 0; i < config.NodeCount; i++ {
+		node := simulation.createNode()
+
+		if i < config.EntryNodeCount {
+			simulation.EntryNodes = append(simulation.EntryNodes, node)
+		}
+	}
+
+	for _, node := range simulation.Nodes {
+		node.SetOnline(true)
+	}
+
+	return simulation
+}
+
+// Adds a new node that joins the network through the entry nodes.
+func (simulation *Simulation) AddNode() *Node {
+	node := simulation.createNode()
+	node.SetOnline(true)
+
+	return node
+}
+
+// Advances the virtual time of the simulation.
+func (simulation *Simulation) Run(duration time.Duration) {
+	simulation.Clock.Advance(duration)
+}
+
+// Rotates the salts of all online nodes at the same time (the worst case for the neighbor churn).
+func (simulation *Simulation) RotateSalts() {
+	for _, node := range simulation.Nodes {
+		if node.IsOnline() {
+			node.RotateSalts()
+		}
+	}
+}
+
+// Returns the metrics of the graph that is formed by the chosen and accepted neighbors of the online nodes.
+func (simulation *Simulation) GetGraphMetrics() GraphMetrics {
+	return ComputeGraphMetrics(simulation.GetAdjacency())
+}
+
+// Returns the identifiers of the neighbors of every online node.
+func (simulation *Simulation) GetAdjacency() map[string][]string {
+	adjacency := make(map[string][]string)
+	for _, node := range simulation.Nodes {
+		if !node.IsOnline() {
+			continue
+		}
+
+		neighborIds := make([]string, 0)
+		for _, neighbor := range node.getNeighbors() {
+			neighborIds = append(neighborIds, neighbor.Identity.StringIdentifier)
+		}
+
+		adjacency[node.GetIdentifier()] = neighborIds
+	}
+
+	return adjacency
+}
+
+func (simulation *Simulation) createNode() *Node {
+	identifier := make([]byte, IDENTIFIER_SIZE)
+	simulation.random.Read(identifier)
+
+	nodeIndex := len(simulation.Nodes)
+
+	// the identities are derived from the seed (and not generated) to keep the distances deterministic - the virtual
+	// nodes do not sign their messages, so they do not need a key pair
+	node := newNode(&peer.Peer{
+		Identity: &identity.Identity{
+			Identifier:       identifier,
+			StringIdentifier: hex.EncodeToString(identifier),
+		},
+		Address:     net.IPv4(10, byte(nodeIndex>>16), byte(nodeIndex>>8), byte(nodeIndex)),
+		PeeringPort: DEFAULT_PEERING_PORT,
+		GossipPort:  DEFAULT_GOSSIP_PORT,
+		Salt:        newSalt(simulation.Clock, simulation.random, saltmanager.PUBLIC_SALT_LIFETIME),
+	}, simulation.Clock, simulation.Transport, simulation.random, simulation.config.NeighborHysteresis)
+
+	for _, entryNode := range simulation.EntryNodes {
+		node.State.AddKnownPeer(copyPeer(entryNode.Peer))
+	}
+
+	simulation.Nodes = append(simulation.Nodes, node)
+	simulation.Transport.Register(node)
+
+	return node
+}
+
+const (
+	DEFAULT_ENTRY_NODE_COUNT = 1
+	DEFAULT_LATENCY          = 50 * time.Millisecond
+	DEFAULT_PEERING_PORT     = 14626
+	DEFAULT_GOSSIP_PORT      = 14666
+
+	IDENTIFIER_SIZE = 20
+)
+
+var DEFAULT_START_TIME = time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
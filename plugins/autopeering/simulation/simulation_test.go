@@ -0,0 +1,178 @@
+package simulation
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
+)
+
+// the peer registers compare the simulated peers with the identity of the node (which is kept in the settings
+// database), so all tests of the package share an empty database in a temporary directory
+func TestMain(m *testing.M) {
+	directory, err := ioutil.TempDir("", "simulation")
+	if err != nil {
+		fmt.Println(err)
+
+		os.Exit(1)
+	}
+	database.DIRECTORY.SetValue(directory)
+
+	exitCode := m.Run()
+
+	_ = os.RemoveAll(directory)
+
+	os.Exit(exitCode)
+}
+
+func TestClock(t *testing.T) {
+	clock := NewClock(DEFAULT_START_TIME)
+
+	executionOrder := make([]int, 0)
+	clock.Schedule(2*time.Second, func() { executionOrder = append(executionOrder, 3) })
+	clock.Schedule(1*time.Second, func() { executionOrder = append(executionOrder, 1) })
+	clock.Schedule(1*time.Second, func() {
+		executionOrder = append(executionOrder, 2)
+
+		// callbacks that get scheduled while advancing are executed if they are due
+		clock.Schedule(500*time.Millisecond, func() { executionOrder = append(executionOrder, 4) })
+	})
+	clock.Schedule(5*time.Second, func() { executionOrder = append(executionOrder, 5) })
+
+	clock.Advance(2 * time.Second)
+
+	if !reflect.DeepEqual(executionOrder, []int{1, 2, 4, 3}) {
+		t.Error("unexpected execution order", executionOrder)
+	}
+	if !clock.Now().Equal(DEFAULT_START_TIME.Add(2*time.Second)) || clock.PendingEvents() != 1 {
+		t.Error("unexpected clock state", clock.Now(), clock.PendingEvents())
+	}
+
+	executions := 0
+	clock.Every(0, time.Second, func() bool {
+		executions++
+
+		return executions < 3
+	})
+	clock.Advance(10 * time.Second)
+
+	if executions != 3 {
+		t.Error("periodic callback was not stopped", executions)
+	}
+}
+
+func TestComputeGraphMetrics(t *testing.T) {
+	// a line a-b-c-d and a separate pair e-f
+	metrics := ComputeGraphMetrics(map[string][]string{
+		"a": {"b"},
+		"b": {"c"},
+		"c": {"b", "d"},
+		"d": {},
+		"e": {"f"},
+		"f": {},
+	})
+
+	if metrics.NodeCount != 6 || metrics.LinkCount != 4 {
+		t.Error("unexpected node or link count", metrics.NodeCount, metrics.LinkCount)
+	}
+	if !reflect.DeepEqual(metrics.DegreeDistribution, map[int]int{1: 4, 2: 2}) || metrics.MinDegree != 1 || metrics.MaxDegree != 2 {
+		t.Error("unexpected degree distribution", metrics.DegreeDistribution)
+	}
+	if metrics.Diameter != 3 {
+		t.Error("unexpected diameter", metrics.Diameter)
+	}
+	if !metrics.IsPartitioned() || !reflect.DeepEqual(metrics.Partitions, [][]string{{"a", "b", "c", "d"}, {"e", "f"}}) {
+		t.Error("unexpected partitions", metrics.Partitions)
+	}
+}
+
+func TestSimulation(t *testing.T) {
+	config := Config{
+		NodeCount:     50,
+		Seed:          42,
+		LatencyJitter: 50 * time.Millisecond,
+		PacketLoss:    0.01,
+	}
+
+	simulation := New(config)
+	simulation.Run(30 * time.Minute)
+
+	metrics := simulation.GetGraphMetrics()
+	t.Log(metrics)
+
+	if metrics.NodeCount != config.NodeCount {
+		t.Error("unexpected node count", metrics.NodeCount)
+	}
+	if metrics.IsPartitioned() {
+		t.Error("the network is partitioned", metrics.Partitions)
+	}
+	if metrics.MinDegree == 0 {
+		t.Error("some nodes did not find any neighbors")
+	}
+
+	// the same config produces the same topology
+	otherSimulation := New(config)
+	otherSimulation.Run(30 * time.Minute)
+
+	if otherMetrics := otherSimulation.GetGraphMetrics(); !reflect.DeepEqual(metrics, otherMetrics) {
+		t.Error("the simulation is not deterministic", otherMetrics)
+	}
+
+	// the remaining nodes replace the neighbors that went offline (while the salts expire)
+	for _, node := range simulation.Nodes[40:] {
+		node.SetOnline(false)
+	}
+	simulation.Run(saltmanager.PUBLIC_SALT_LIFETIME)
+
+	if metrics := simulation.GetGraphMetrics(); metrics.NodeCount != 40 || metrics.MinDegree == 0 {
+		t.Error("the nodes did not replace their neighbors", metrics)
+	}
+	for _, node := range simulation.Nodes[:40] {
+		for _, neighbor := range node.getNeighbors() {
+			if neighborNode := simulation.Transport.nodes[neighbor.Identity.StringIdentifier]; !neighborNode.IsOnline() {
+				t.Error("node kept an offline neighbor", node.GetIdentifier(), neighborNode.GetIdentifier())
+			}
+		}
+	}
+
+	// a partition is detected
+	simulation.Transport.Partition(simulation.Nodes[:20], simulation.Nodes[20:40])
+	simulation.Run(time.Minute)
+
+	if metrics := simulation.GetGraphMetrics(); !metrics.IsPartitioned() {
+		t.Error("the partition was not detected", metrics)
+	}
+}
+
+func TestSimulation_SaltRotationHysteresis(t *testing.T) {
+	removedLinks := make(map[int]int)
+	for _, hysteresis := range []int{0, 30} {
+		simulation := New(Config{
+			NodeCount:          40,
+			Seed:               7,
+			NeighborHysteresis: hysteresis,
+		})
+		simulation.Run(20 * time.Minute)
+
+		adjacencyBeforeRotation := simulation.GetAdjacency()
+
+		simulation.RotateSalts()
+		simulation.Run(10 * time.Minute)
+
+		removedLinks[hysteresis] = CountRemovedLinks(adjacencyBeforeRotation, simulation.GetAdjacency())
+		t.Log("hysteresis", hysteresis, "removed links", removedLinks[hysteresis], simulation.GetGraphMetrics())
+
+		if metrics := simulation.GetGraphMetrics(); metrics.MinDegree == 0 {
+			t.Error("some nodes lost all neighbors after the salt rotation", metrics)
+		}
+	}
+
+	if removedLinks[30] >= removedLinks[0] {
+		t.Error("the hysteresis did not reduce the neighbor churn", removedLinks)
+	}
+}
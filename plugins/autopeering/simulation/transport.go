@@ -0,0 +1,112 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/types"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+)
+
+// Transport is an in-memory stand-in for the tcp and udp servers of the autopeering. Messages are delivered through the
+// virtual clock after a (jittered) latency. Messages to nodes that are offline or in another partition are lost and
+// udp messages are additionally subject to the configured packet loss.
+type Transport struct {
+	clock           *Clock
+	random          *rand.Rand
+	nodes           map[string]*Node
+	partitions      map[string]int
+	latency         time.Duration
+	latencyJitter   time.Duration
+	packetLoss      float64
+	sentMessages    uint64
+	droppedMessages uint64
+}
+
+func NewTransport(clock *Clock, random *rand.Rand, latency time.Duration, latencyJitter time.Duration, packetLoss float64) *Transport {
+	return &Transport{
+		clock:         clock,
+		random:        random,
+		nodes:         make(map[string]*Node),
+		partitions:    make(map[string]int),
+		latency:       latency,
+		latencyJitter: latencyJitter,
+		packetLoss:    packetLoss,
+	}
+}
+
+func (transport *Transport) Register(node *Node) {
+	transport.nodes[node.GetIdentifier()] = node
+}
+
+// Sends the message (a request, response, ping or drop) from the sender to the receiver.
+func (transport *Transport) Send(sender *Node, receiver *peer.Peer, message interface{}, protocol types.ProtocolType) {
+	transport.sentMessages++
+
+	receivingNode, exists := transport.nodes[receiver.Identity.StringIdentifier]
+	if !exists || !transport.CanCommunicate(sender, receivingNode) ||
+		(protocol == types.PROTOCOL_TYPE_UDP && transport.packetLoss > 0 && transport.random.Float64() < transport.packetLoss) {
+
+		transport.droppedMessages++
+
+		return
+	}
+
+	delay := transport.latency
+	if transport.latencyJitter > 0 {
+		delay += time.Duration(transport.random.Int63n(int64(transport.latencyJitter)))
+	}
+
+	transport.clock.Schedule(delay, func() {
+		// the receiver might have gone offline or might have been partitioned while the message was in transit
+		if transport.CanCommunicate(sender, receivingNode) {
+			receivingNode.receive(message)
+		} else {
+			transport.droppedMessages++
+		}
+	})
+}
+
+// Returns true if both nodes are online and in the same partition.
+func (transport *Transport) CanCommunicate(sender *Node, receiver *Node) bool {
+	return sender.IsOnline() && receiver.IsOnline() &&
+		transport.partitions[sender.GetIdentifier()] == transport.partitions[receiver.GetIdentifier()]
+}
+
+// Splits the network into the given groups (nodes that are not part of any group form partition 0). Links between
+// different partitions break just like the gossip connections of real nodes would.
+func (transport *Transport) Partition(groups ...[]*Node) {
+	transport.partitions = make(map[string]int)
+	for i, group := range groups {
+		for _, node := range group {
+			transport.partitions[node.GetIdentifier()] = i + 1
+		}
+	}
+
+	transport.dropBrokenLinks()
+}
+
+// Removes all partitions.
+func (transport *Transport) Heal() {
+	transport.partitions = make(map[string]int)
+}
+
+// Returns the number of sent messages and the number of messages that were lost.
+func (transport *Transport) Statistics() (sentMessages uint64, droppedMessages uint64) {
+	return transport.sentMessages, transport.droppedMessages
+}
+
+// notifies all nodes about neighbors that they cannot reach anymore (after the latency that it takes to notice)
+func (transport *Transport) dropBrokenLinks() {
+	for _, node := range transport.nodes {
+		for _, neighbor := range node.getNeighbors() {
+			if neighborNode, exists := transport.nodes[neighbor.Identity.StringIdentifier]; exists && !transport.CanCommunicate(node, neighborNode) {
+				transport.clock.Schedule(transport.latency, func(node *Node, identifier string) func() {
+					return func() {
+						node.removeNeighbor(identifier)
+					}
+				}(node, neighbor.Identity.StringIdentifier))
+			}
+		}
+	}
+}
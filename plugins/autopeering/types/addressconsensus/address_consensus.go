@@ -0,0 +1,111 @@
+package addressconsensus
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// AddressConsensus collects the source addresses that other peers observed when we contacted them and determines our
+// external address as the address that a majority of them agrees on. Every reporter has a single vote (a new
+// observation replaces its previous one), so a single peer can not make us adopt a wrong address.
+type AddressConsensus struct {
+	minVotes       int
+	observationTTL time.Duration
+	observations   map[string]*observation
+	mutex          sync.RWMutex
+}
+
+type observation struct {
+	address    net.IP
+	observedAt time.Time
+}
+
+func New(minVotes int, observationTTL time.Duration) *AddressConsensus {
+	if minVotes < 1 {
+		minVotes = 1
+	}
+
+	return &AddressConsensus{
+		minVotes:       minVotes,
+		observationTTL: observationTTL,
+		observations:   make(map[string]*observation),
+	}
+}
+
+// Records the address that the given reporter observed and returns the resulting consensus.
+func (consensus *AddressConsensus) AddObservation(reporter string, address net.IP, now time.Time) (net.IP, bool) {
+	consensus.mutex.Lock()
+	defer consensus.mutex.Unlock()
+
+	consensus.removeExpiredObservations(now)
+
+	if _, exists := consensus.observations[reporter]; !exists && len(consensus.observations) >= MAX_OBSERVATIONS {
+		consensus.removeOldestObservation()
+	}
+
+	consensus.observations[reporter] = &observation{
+		address:    address,
+		observedAt: now,
+	}
+
+	return consensus.getAddress()
+}
+
+// Returns the address that at least the minimum amount of reporters and more than half of all reporters agree on.
+func (consensus *AddressConsensus) GetAddress(now time.Time) (net.IP, bool) {
+	consensus.mutex.Lock()
+	defer consensus.mutex.Unlock()
+
+	consensus.removeExpiredObservations(now)
+
+	return consensus.getAddress()
+}
+
+// Returns the amount of (not expired) observations.
+func (consensus *AddressConsensus) GetObservationCount() int {
+	consensus.mutex.RLock()
+	defer consensus.mutex.RUnlock()
+
+	return len(consensus.observations)
+}
+
+func (consensus *AddressConsensus) getAddress() (net.IP, bool) {
+	votes := make(map[string]int)
+	for _, observation := range consensus.observations {
+		votes[observation.address.String()]++
+	}
+
+	for address, voteCount := range votes {
+		if voteCount >= consensus.minVotes && 2*voteCount > len(consensus.observations) {
+			return net.ParseIP(address), true
+		}
+	}
+
+	return nil, false
+}
+
+func (consensus *AddressConsensus) removeExpiredObservations(now time.Time) {
+	if consensus.observationTTL <= 0 {
+		return
+	}
+
+	for reporter, observation := range consensus.observations {
+		if now.Sub(observation.observedAt) > consensus.observationTTL {
+			delete(consensus.observations, reporter)
+		}
+	}
+}
+
+func (consensus *AddressConsensus) removeOldestObservation() {
+	oldestReporter := ""
+	var oldestObservation *observation
+	for reporter, observation := range consensus.observations {
+		if oldestObservation == nil || observation.observedAt.Before(oldestObservation.observedAt) {
+			oldestReporter = reporter
+			oldestObservation = observation
+		}
+	}
+
+	delete(consensus.observations, oldestReporter)
+}
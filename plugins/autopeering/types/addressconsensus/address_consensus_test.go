@@ -0,0 +1,58 @@
+package addressconsensus
+
+import (
+	"net"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestAddressConsensus_Majority(t *testing.T) {
+	now := time.Now()
+	consensus := New(3, time.Hour)
+
+	externalAddress := net.ParseIP("203.0.113.7")
+
+	if _, exists := consensus.AddObservation("peer1", externalAddress, now); exists {
+		t.Error("a single observation should not be enough for a consensus")
+	}
+	consensus.AddObservation("peer2", externalAddress, now)
+
+	// a reporter can not vote twice
+	if _, exists := consensus.AddObservation("peer2", externalAddress, now); exists {
+		t.Error("the repeated observation of the same reporter was counted twice")
+	}
+
+	if address, exists := consensus.AddObservation("peer3", externalAddress, now); !exists || !address.Equal(externalAddress) {
+		t.Error("three agreeing reporters should form a consensus", address)
+	}
+
+	// disagreeing reporters remove the majority
+	for i := 0; i < 3; i++ {
+		consensus.AddObservation("other"+strconv.Itoa(i), net.ParseIP("198.51.100.1"), now)
+	}
+	if _, exists := consensus.GetAddress(now); exists {
+		t.Error("there should be no consensus without a majority")
+	}
+}
+
+func TestAddressConsensus_Expiry(t *testing.T) {
+	now := time.Now()
+	consensus := New(2, time.Minute)
+
+	oldAddress := net.ParseIP("203.0.113.7")
+	newAddress := net.ParseIP("203.0.113.8")
+
+	consensus.AddObservation("peer1", oldAddress, now)
+	consensus.AddObservation("peer2", oldAddress, now)
+
+	later := now.Add(2 * time.Minute)
+	consensus.AddObservation("peer3", newAddress, later)
+	if address, exists := consensus.AddObservation("peer4", newAddress, later); !exists || !address.Equal(newAddress) {
+		t.Error("the expired observations should have been ignored", address)
+	}
+
+	if consensus.GetObservationCount() != 2 {
+		t.Error("the expired observations should have been removed", consensus.GetObservationCount())
+	}
+}
@@ -0,0 +1,14 @@
+package addressconsensus
+
+import "time"
+
+const (
+	// the minimum amount of distinct reporters that have to agree on an address before we adopt it
+	DEFAULT_MIN_VOTES = 3
+
+	// observations that were not renewed within this time are ignored (the address might have changed in between)
+	DEFAULT_OBSERVATION_TTL = 30 * time.Minute
+
+	// the maximum amount of observations that are remembered (the oldest one is replaced if a new reporter arrives)
+	MAX_OBSERVATIONS = 100
+)
@@ -2,6 +2,7 @@ package drop
 
 import (
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/stamp"
 )
 
 const (
@@ -9,14 +10,17 @@ const (
 
 	PACKET_HEADER_START       = 0
 	MARSHALED_ISSUER_START    = PACKET_HEADER_END
-	MARSHALED_SIGNATURE_START = MARSHALED_ISSUER_END
+	MARSHALED_STAMP_START     = MARSHALED_ISSUER_END
+	MARSHALED_SIGNATURE_START = MARSHALED_STAMP_END
 
 	PACKET_HEADER_END       = PACKET_HEADER_START + PACKET_HEADER_SIZE
 	MARSHALED_ISSUER_END    = MARSHALED_ISSUER_START + MARSHALED_ISSUER_SIZE
+	MARSHALED_STAMP_END     = MARSHALED_STAMP_START + MARSHALED_STAMP_SIZE
 	MARSHALED_SIGNATURE_END = MARSHALED_SIGNATURE_START + MARSHALED_SIGNATURE_SIZE
 
 	PACKET_HEADER_SIZE       = 1
 	MARSHALED_ISSUER_SIZE    = peer.MARSHALED_TOTAL_SIZE
+	MARSHALED_STAMP_SIZE     = stamp.MARSHALED_TOTAL_SIZE
 	MARSHALED_SIGNATURE_SIZE = 65
 
 	MARSHALED_TOTAL_SIZE = MARSHALED_SIGNATURE_END
@@ -6,10 +6,12 @@ import (
 	"github.com/iotaledger/goshimmer/packages/identity"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/stamp"
 )
 
 type Drop struct {
 	Issuer    *peer.Peer
+	Stamp     *stamp.Stamp
 	Signature [MARSHALED_SIGNATURE_SIZE]byte
 }
 
@@ -29,10 +31,16 @@ func Unmarshal(data []byte) (*Drop, error) {
 		return nil, err
 	}
 
+	if unmarshaledStamp, err := stamp.Unmarshal(data[MARSHALED_STAMP_START:MARSHALED_STAMP_END]); err != nil {
+		return nil, err
+	} else {
+		ping.Stamp = unmarshaledStamp
+	}
+
 	if issuer, err := identity.FromSignedData(data[:MARSHALED_SIGNATURE_START], data[MARSHALED_SIGNATURE_START:]); err != nil {
 		return nil, err
 	} else {
-		if !bytes.Equal(issuer.Identifier, ping.Issuer.Identity.Identifier) {
+		if !bytes.Equal(issuer.Identifier, ping.Issuer.Identity.Identifier) || !bytes.Equal(issuer.PublicKey, ping.Issuer.Identity.PublicKey) {
 			return nil, ErrInvalidSignature
 		}
 	}
@@ -46,12 +54,18 @@ func (ping *Drop) Marshal() []byte {
 
 	result[PACKET_HEADER_START] = MARSHALED_PACKET_HEADER
 	copy(result[MARSHALED_ISSUER_START:MARSHALED_ISSUER_END], ping.Issuer.Marshal())
+	if ping.Stamp != nil {
+		copy(result[MARSHALED_STAMP_START:MARSHALED_STAMP_END], ping.Stamp.Marshal())
+	}
 	copy(result[MARSHALED_SIGNATURE_START:MARSHALED_SIGNATURE_END], ping.Signature[:MARSHALED_SIGNATURE_SIZE])
 
 	return result
 }
 
-func (this *Drop) Sign() {
+// Signs the drop message with a fresh stamp for the given recipient.
+func (this *Drop) Sign(recipient *identity.Identity) {
+	this.Stamp = stamp.New(recipient)
+
 	if signature, err := this.Issuer.Identity.Sign(this.Marshal()[:MARSHALED_SIGNATURE_START]); err != nil {
 		panic(err)
 	} else {
@@ -0,0 +1,8 @@
+package exchangerequest
+
+import "github.com/pkg/errors"
+
+var (
+	ErrInvalidSignature         = errors.New("invalid signature in peer exchange request")
+	ErrMalformedExchangeRequest = errors.New("malformed peer exchange request")
+)
@@ -0,0 +1,75 @@
+package exchangerequest
+
+import (
+	"bytes"
+
+	"github.com/iotaledger/goshimmer/packages/identity"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/stamp"
+)
+
+// ExchangeRequest asks another peer for a random sample of the peers that it knows.
+type ExchangeRequest struct {
+	Issuer    *peer.Peer
+	Stamp     *stamp.Stamp
+	Signature [MARSHALED_SIGNATURE_SIZE]byte
+}
+
+func Unmarshal(data []byte) (*ExchangeRequest, error) {
+	if data[0] != MARSHALED_PACKET_HEADER || len(data) != MARSHALED_TOTAL_SIZE {
+		return nil, ErrMalformedExchangeRequest
+	}
+
+	exchangeRequest := &ExchangeRequest{}
+
+	if unmarshaledPeer, err := peer.Unmarshal(data[MARSHALED_ISSUER_START:MARSHALED_ISSUER_END]); err != nil {
+		return nil, err
+	} else {
+		exchangeRequest.Issuer = unmarshaledPeer
+	}
+	if err := saltmanager.CheckSalt(exchangeRequest.Issuer.Salt); err != nil {
+		return nil, err
+	}
+
+	if unmarshaledStamp, err := stamp.Unmarshal(data[MARSHALED_STAMP_START:MARSHALED_STAMP_END]); err != nil {
+		return nil, err
+	} else {
+		exchangeRequest.Stamp = unmarshaledStamp
+	}
+
+	if issuer, err := identity.FromSignedData(data[:MARSHALED_SIGNATURE_START], data[MARSHALED_SIGNATURE_START:]); err != nil {
+		return nil, err
+	} else {
+		if !bytes.Equal(issuer.Identifier, exchangeRequest.Issuer.Identity.Identifier) || !bytes.Equal(issuer.PublicKey, exchangeRequest.Issuer.Identity.PublicKey) {
+			return nil, ErrInvalidSignature
+		}
+	}
+	copy(exchangeRequest.Signature[:], data[MARSHALED_SIGNATURE_START:MARSHALED_SIGNATURE_END])
+
+	return exchangeRequest, nil
+}
+
+func (exchangeRequest *ExchangeRequest) Marshal() []byte {
+	result := make([]byte, MARSHALED_TOTAL_SIZE)
+
+	result[PACKET_HEADER_START] = MARSHALED_PACKET_HEADER
+	copy(result[MARSHALED_ISSUER_START:MARSHALED_ISSUER_END], exchangeRequest.Issuer.Marshal())
+	if exchangeRequest.Stamp != nil {
+		copy(result[MARSHALED_STAMP_START:MARSHALED_STAMP_END], exchangeRequest.Stamp.Marshal())
+	}
+	copy(result[MARSHALED_SIGNATURE_START:MARSHALED_SIGNATURE_END], exchangeRequest.Signature[:MARSHALED_SIGNATURE_SIZE])
+
+	return result
+}
+
+// Signs the request with a fresh stamp for the given recipient.
+func (exchangeRequest *ExchangeRequest) Sign(recipient *identity.Identity) {
+	exchangeRequest.Stamp = stamp.New(recipient)
+
+	if signature, err := exchangeRequest.Issuer.Identity.Sign(exchangeRequest.Marshal()[:MARSHALED_SIGNATURE_START]); err != nil {
+		panic(err)
+	} else {
+		copy(exchangeRequest.Signature[:], signature)
+	}
+}
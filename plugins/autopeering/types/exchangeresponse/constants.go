@@ -0,0 +1,49 @@
+package exchangeresponse
+
+import (
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/stamp"
+)
+
+const (
+	MARSHALED_PACKET_HEADER = 0x07
+
+	// the maximum amount of peers that are sent in a single response
+	MAX_ENTRIES = 16
+
+	PACKET_HEADER_START       = 0
+	MARSHALED_ISSUER_START    = PACKET_HEADER_END
+	MARSHALED_ENTRIES_START   = MARSHALED_ISSUER_END
+	MARSHALED_STAMP_START     = MARSHALED_ENTRIES_END
+	MARSHALED_SIGNATURE_START = MARSHALED_STAMP_END
+
+	PACKET_HEADER_END       = PACKET_HEADER_START + PACKET_HEADER_SIZE
+	MARSHALED_ISSUER_END    = MARSHALED_ISSUER_START + MARSHALED_ISSUER_SIZE
+	MARSHALED_ENTRIES_END   = MARSHALED_ENTRIES_START + MARSHALED_ENTRIES_SIZE
+	MARSHALED_STAMP_END     = MARSHALED_STAMP_START + MARSHALED_STAMP_SIZE
+	MARSHALED_SIGNATURE_END = MARSHALED_SIGNATURE_START + MARSHALED_SIGNATURE_SIZE
+
+	PACKET_HEADER_SIZE       = 1
+	MARSHALED_ISSUER_SIZE    = peer.MARSHALED_TOTAL_SIZE
+	MARSHALED_ENTRIES_SIZE   = MAX_ENTRIES * MARSHALED_ENTRY_SIZE
+	MARSHALED_STAMP_SIZE     = stamp.MARSHALED_TOTAL_SIZE
+	MARSHALED_SIGNATURE_SIZE = 65
+
+	MARSHALED_TOTAL_SIZE = MARSHALED_SIGNATURE_END
+)
+
+const (
+	MARSHALED_ENTRY_FLAG_START = 0
+	MARSHALED_ENTRY_AGE_START  = MARSHALED_ENTRY_FLAG_END
+	MARSHALED_ENTRY_PEER_START = MARSHALED_ENTRY_AGE_END
+
+	MARSHALED_ENTRY_FLAG_END = MARSHALED_ENTRY_FLAG_START + MARSHALED_ENTRY_FLAG_SIZE
+	MARSHALED_ENTRY_AGE_END  = MARSHALED_ENTRY_AGE_START + MARSHALED_ENTRY_AGE_SIZE
+	MARSHALED_ENTRY_PEER_END = MARSHALED_ENTRY_PEER_START + MARSHALED_ENTRY_PEER_SIZE
+
+	MARSHALED_ENTRY_FLAG_SIZE = 1
+	MARSHALED_ENTRY_AGE_SIZE  = 4
+	MARSHALED_ENTRY_PEER_SIZE = peer.MARSHALED_TOTAL_SIZE
+
+	MARSHALED_ENTRY_SIZE = MARSHALED_ENTRY_PEER_END
+)
@@ -0,0 +1,8 @@
+package exchangeresponse
+
+import "github.com/pkg/errors"
+
+var (
+	ErrInvalidSignature          = errors.New("invalid signature in peer exchange response")
+	ErrMalformedExchangeResponse = errors.New("malformed peer exchange response")
+)
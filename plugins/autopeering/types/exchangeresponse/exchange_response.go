@@ -0,0 +1,126 @@
+package exchangeresponse
+
+import (
+	"bytes"
+	"encoding/binary"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/identity"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/stamp"
+)
+
+// ExchangeResponse contains a random sample of the peers that the issuer knows.
+type ExchangeResponse struct {
+	Issuer    *peer.Peer
+	Entries   []*Entry
+	Stamp     *stamp.Stamp
+	Signature [MARSHALED_SIGNATURE_SIZE]byte
+}
+
+// Entry is a peer together with the time that passed since the issuer of the response last heard from it.
+type Entry struct {
+	Peer *peer.Peer
+	Age  time.Duration
+}
+
+func Unmarshal(data []byte) (*ExchangeResponse, error) {
+	if data[0] != MARSHALED_PACKET_HEADER || len(data) != MARSHALED_TOTAL_SIZE {
+		return nil, ErrMalformedExchangeResponse
+	}
+
+	exchangeResponse := &ExchangeResponse{
+		Entries: make([]*Entry, 0),
+	}
+
+	if unmarshaledPeer, err := peer.Unmarshal(data[MARSHALED_ISSUER_START:MARSHALED_ISSUER_END]); err != nil {
+		return nil, err
+	} else {
+		exchangeResponse.Issuer = unmarshaledPeer
+	}
+	if err := saltmanager.CheckSalt(exchangeResponse.Issuer.Salt); err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < MAX_ENTRIES; i++ {
+		entryData := data[MARSHALED_ENTRIES_START+i*MARSHALED_ENTRY_SIZE : MARSHALED_ENTRIES_START+(i+1)*MARSHALED_ENTRY_SIZE]
+		if entryData[MARSHALED_ENTRY_FLAG_START] != 1 {
+			continue
+		}
+
+		if unmarshaledPeer, err := peer.Unmarshal(entryData[MARSHALED_ENTRY_PEER_START:MARSHALED_ENTRY_PEER_END]); err != nil {
+			return nil, err
+		} else {
+			exchangeResponse.Entries = append(exchangeResponse.Entries, &Entry{
+				Peer: unmarshaledPeer,
+				Age:  time.Duration(binary.BigEndian.Uint32(entryData[MARSHALED_ENTRY_AGE_START:MARSHALED_ENTRY_AGE_END])) * time.Second,
+			})
+		}
+	}
+
+	if unmarshaledStamp, err := stamp.Unmarshal(data[MARSHALED_STAMP_START:MARSHALED_STAMP_END]); err != nil {
+		return nil, err
+	} else {
+		exchangeResponse.Stamp = unmarshaledStamp
+	}
+
+	if issuer, err := identity.FromSignedData(data[:MARSHALED_SIGNATURE_START], data[MARSHALED_SIGNATURE_START:]); err != nil {
+		return nil, err
+	} else {
+		if !bytes.Equal(issuer.Identifier, exchangeResponse.Issuer.Identity.Identifier) || !bytes.Equal(issuer.PublicKey, exchangeResponse.Issuer.Identity.PublicKey) {
+			return nil, ErrInvalidSignature
+		}
+	}
+	copy(exchangeResponse.Signature[:], data[MARSHALED_SIGNATURE_START:MARSHALED_SIGNATURE_END])
+
+	return exchangeResponse, nil
+}
+
+func (exchangeResponse *ExchangeResponse) Marshal() []byte {
+	result := make([]byte, MARSHALED_TOTAL_SIZE)
+
+	result[PACKET_HEADER_START] = MARSHALED_PACKET_HEADER
+	copy(result[MARSHALED_ISSUER_START:MARSHALED_ISSUER_END], exchangeResponse.Issuer.Marshal())
+
+	for i, entry := range exchangeResponse.Entries {
+		if i >= MAX_ENTRIES {
+			break
+		}
+
+		entryData := result[MARSHALED_ENTRIES_START+i*MARSHALED_ENTRY_SIZE : MARSHALED_ENTRIES_START+(i+1)*MARSHALED_ENTRY_SIZE]
+		entryData[MARSHALED_ENTRY_FLAG_START] = 1
+		binary.BigEndian.PutUint32(entryData[MARSHALED_ENTRY_AGE_START:MARSHALED_ENTRY_AGE_END], marshalAge(entry.Age))
+		copy(entryData[MARSHALED_ENTRY_PEER_START:MARSHALED_ENTRY_PEER_END], entry.Peer.Marshal())
+	}
+
+	if exchangeResponse.Stamp != nil {
+		copy(result[MARSHALED_STAMP_START:MARSHALED_STAMP_END], exchangeResponse.Stamp.Marshal())
+	}
+	copy(result[MARSHALED_SIGNATURE_START:MARSHALED_SIGNATURE_END], exchangeResponse.Signature[:MARSHALED_SIGNATURE_SIZE])
+
+	return result
+}
+
+// Signs the response with a fresh stamp for the given recipient.
+func (exchangeResponse *ExchangeResponse) Sign(recipient *identity.Identity) {
+	exchangeResponse.Stamp = stamp.New(recipient)
+
+	if signature, err := exchangeResponse.Issuer.Identity.Sign(exchangeResponse.Marshal()[:MARSHALED_SIGNATURE_START]); err != nil {
+		panic(err)
+	} else {
+		copy(exchangeResponse.Signature[:], signature)
+	}
+}
+
+// converts the age to seconds (negative ages are sent as 0 and very old ones are capped)
+func marshalAge(age time.Duration) uint32 {
+	switch {
+	case age < 0:
+		return 0
+	case age/time.Second > 0xFFFFFFFF:
+		return 0xFFFFFFFF
+	default:
+		return uint32(age / time.Second)
+	}
+}
@@ -0,0 +1,59 @@
+package exchangeresponse
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/identity"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/salt"
+)
+
+func TestExchangeResponse_MarshalUnmarshal(t *testing.T) {
+	exchangeResponse := &ExchangeResponse{
+		Issuer: &peer.Peer{
+			Address:     net.IPv4(127, 0, 0, 1),
+			Identity:    identity.GenerateRandomIdentity(),
+			GossipPort:  123,
+			PeeringPort: 456,
+			Salt:        salt.New(30 * time.Second),
+		},
+		Entries: []*Entry{
+			{
+				Peer: &peer.Peer{
+					Address:     net.IPv4(127, 0, 0, 2),
+					Identity:    identity.GenerateRandomIdentity(),
+					GossipPort:  124,
+					PeeringPort: 457,
+					Salt:        salt.New(30 * time.Second),
+				},
+				Age: 90 * time.Second,
+			},
+		},
+	}
+	exchangeResponse.Sign(identity.GenerateRandomIdentity())
+
+	unmarshaledResponse, err := Unmarshal(exchangeResponse.Marshal())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(unmarshaledResponse.Entries) != 1 {
+		t.Fatal("wrong amount of entries", len(unmarshaledResponse.Entries))
+	}
+
+	entry := unmarshaledResponse.Entries[0]
+	if entry.Age != 90*time.Second || entry.Peer.Identity.StringIdentifier != exchangeResponse.Entries[0].Peer.Identity.StringIdentifier || entry.Peer.PeeringPort != 457 {
+		t.Error("entry was not restored correctly", entry)
+	}
+
+	// the entries can not be changed without invalidating the signature
+	exchangeResponse.Sign(identity.GenerateRandomIdentity())
+	tamperedResponse := exchangeResponse.Marshal()
+	tamperedResponse[MARSHALED_ENTRIES_START+MARSHALED_ENTRY_AGE_END-1]++
+
+	if _, err := Unmarshal(tamperedResponse); err != ErrInvalidSignature {
+		t.Error("tampered response was accepted", err)
+	}
+}
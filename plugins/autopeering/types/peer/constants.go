@@ -5,6 +5,10 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/salt"
 )
 
+// The marshaled format is used both on the wire (requests, responses and peer exchanges) and in the peers database. It
+// changed incompatibly when the alternative addresses and the cluster identifier were appended: nodes that run an
+// older version can not decode the peers of newer nodes (and vice versa) and the stored peers of older versions are
+// discarded when the database is loaded.
 const (
 	MARSHALED_PUBLIC_KEY_START   = 0
 	MARSHALED_ADDRESS_TYPE_START = MARSHALED_PUBLIC_KEY_END
@@ -13,6 +17,9 @@ const (
 	MARSHALED_GOSSIP_PORT_START  = MARSHALED_PEERING_PORT_END
 	MARSHALED_SALT_START         = MARSHALED_GOSSIP_PORT_END
 
+	MARSHALED_ALTERNATIVE_ADDRESSES_START = MARSHALED_SALT_END
+	MARSHALED_CLUSTER_IDENTIFIER_START    = MARSHALED_ALTERNATIVE_ADDRESSES_END
+
 	MARSHALED_PUBLIC_KEY_END   = MARSHALED_PUBLIC_KEY_START + MARSHALED_PUBLIC_KEY_SIZE
 	MARSHALED_ADDRESS_TYPE_END = MARSHALED_ADDRESS_TYPE_START + MARSHALED_ADDRESS_TYPE_SIZE
 	MARSHALED_ADDRESS_END      = MARSHALED_ADDRESS_START + MARSHALED_ADDRESS_SIZE
@@ -20,6 +27,9 @@ const (
 	MARSHALED_GOSSIP_PORT_END  = MARSHALED_GOSSIP_PORT_START + MARSHALED_GOSSIP_PORT_SIZE
 	MARSHALED_SALT_END         = MARSHALED_SALT_START + MARSHALED_SALT_SIZE
 
+	MARSHALED_ALTERNATIVE_ADDRESSES_END = MARSHALED_ALTERNATIVE_ADDRESSES_START + MARSHALED_ALTERNATIVE_ADDRESSES_SIZE
+	MARSHALED_CLUSTER_IDENTIFIER_END    = MARSHALED_CLUSTER_IDENTIFIER_START + MARSHALED_CLUSTER_IDENTIFIER_SIZE
+
 	MARSHALED_PUBLIC_KEY_SIZE   = identity.PUBLIC_KEY_BYTE_LENGTH
 	MARSHALED_ADDRESS_TYPE_SIZE = 1
 	MARSHALED_ADDRESS_SIZE      = 16
@@ -27,5 +37,10 @@ const (
 	MARSHALED_GOSSIP_PORT_SIZE  = 2
 	MARSHALED_SALT_SIZE         = salt.SALT_MARSHALED_SIZE
 
-	MARSHALED_TOTAL_SIZE = MARSHALED_SALT_END
+	MARSHALED_ALTERNATIVE_ADDRESSES_COUNT = 2
+	MARSHALED_ALTERNATIVE_ADDRESS_SIZE    = MARSHALED_ADDRESS_TYPE_SIZE + MARSHALED_ADDRESS_SIZE
+	MARSHALED_ALTERNATIVE_ADDRESSES_SIZE  = MARSHALED_ALTERNATIVE_ADDRESSES_COUNT * MARSHALED_ALTERNATIVE_ADDRESS_SIZE
+	MARSHALED_CLUSTER_IDENTIFIER_SIZE     = 32
+
+	MARSHALED_TOTAL_SIZE = MARSHALED_CLUSTER_IDENTIFIER_END
 )
@@ -1,6 +1,7 @@
 package peer
 
 import (
+	"bytes"
 	"encoding/binary"
 	"net"
 	"strconv"
@@ -16,17 +17,22 @@ import (
 )
 
 type Peer struct {
-	Identity       *identity.Identity
-	Address        net.IP
-	PeeringPort    uint16
-	GossipPort     uint16
-	Salt           *salt.Salt
-	conn           *network.ManagedConnection
-	connectMutex   sync.RWMutex
-	firstSeen      time.Time
-	firstSeenMutex sync.RWMutex
-	lastSeen       time.Time
-	lastSeenMutex  sync.RWMutex
+	Identity *identity.Identity
+	// the address that we use to reach the peer (the source address of the last packet we received from it)
+	Address net.IP
+	// further addresses that the peer advertises (i.e. its IPv6 address if we reached it through IPv4)
+	AlternativeAddresses []net.IP
+	// the economic cluster (i.e. a fingerprint of the ledger view) that the peer declares to belong to (nil = none)
+	ClusterIdentifier []byte
+	PeeringPort       uint16
+	GossipPort        uint16
+	Salt              *salt.Salt
+	conn              *network.ManagedConnection
+	connectMutex      sync.RWMutex
+	firstSeen         time.Time
+	firstSeenMutex    sync.RWMutex
+	lastSeen          time.Time
+	lastSeenMutex     sync.RWMutex
 }
 
 func (peer *Peer) GetConn() (result *network.ManagedConnection) {
@@ -52,16 +58,24 @@ func Unmarshal(data []byte) (*Peer, error) {
 		Identity: identity.NewIdentity(data[MARSHALED_PUBLIC_KEY_START:MARSHALED_PUBLIC_KEY_END]),
 	}
 
-	switch data[MARSHALED_ADDRESS_TYPE_START] {
-	case types.ADDRESS_TYPE_IPV4:
-		peer.Address = net.IP(data[MARSHALED_ADDRESS_START:MARSHALED_ADDRESS_END]).To4()
-	case types.ADDRESS_TYPE_IPV6:
-		peer.Address = net.IP(data[MARSHALED_ADDRESS_START:MARSHALED_ADDRESS_END]).To16()
+	peer.Address = UnmarshalAddress(data[MARSHALED_ADDRESS_TYPE_START:MARSHALED_ADDRESS_END])
+
+	for i := 0; i < MARSHALED_ALTERNATIVE_ADDRESSES_COUNT; i++ {
+		addressStart := MARSHALED_ALTERNATIVE_ADDRESSES_START + i*MARSHALED_ALTERNATIVE_ADDRESS_SIZE
+
+		if address := UnmarshalAddress(data[addressStart : addressStart+MARSHALED_ALTERNATIVE_ADDRESS_SIZE]); address != nil {
+			peer.AlternativeAddresses = append(peer.AlternativeAddresses, address)
+		}
 	}
 
 	peer.PeeringPort = binary.BigEndian.Uint16(data[MARSHALED_PEERING_PORT_START:MARSHALED_PEERING_PORT_END])
 	peer.GossipPort = binary.BigEndian.Uint16(data[MARSHALED_GOSSIP_PORT_START:MARSHALED_GOSSIP_PORT_END])
 
+	if clusterIdentifier := data[MARSHALED_CLUSTER_IDENTIFIER_START:MARSHALED_CLUSTER_IDENTIFIER_END]; !isZero(clusterIdentifier) {
+		peer.ClusterIdentifier = make([]byte, MARSHALED_CLUSTER_IDENTIFIER_SIZE)
+		copy(peer.ClusterIdentifier, clusterIdentifier)
+	}
+
 	if unmarshaledSalt, err := salt.Unmarshal(data[MARSHALED_SALT_START:MARSHALED_SALT_END]); err != nil {
 		return nil, err
 	} else {
@@ -89,6 +103,35 @@ func (peer *Peer) Send(data []byte, protocol types.ProtocolType, responseExpecte
 	return dialed, nil
 }
 
+// Returns all known addresses of the peer, starting with the one that we used to reach it last.
+func (peer *Peer) GetAddresses() []net.IP {
+	result := make([]net.IP, 0, 1+len(peer.AlternativeAddresses))
+	if peer.Address != nil && !peer.Address.IsUnspecified() {
+		result = append(result, peer.Address)
+	}
+
+	for _, alternativeAddress := range peer.AlternativeAddresses {
+		if alternativeAddress.IsUnspecified() {
+			continue
+		}
+
+		known := false
+		for _, address := range result {
+			if address.Equal(alternativeAddress) {
+				known = true
+
+				break
+			}
+		}
+
+		if !known {
+			result = append(result, alternativeAddress)
+		}
+	}
+
+	return result
+}
+
 func (peer *Peer) ConnectTCP() (*network.ManagedConnection, bool, error) {
 	peer.connectMutex.RLock()
 
@@ -98,7 +141,7 @@ func (peer *Peer) ConnectTCP() (*network.ManagedConnection, bool, error) {
 		defer peer.connectMutex.Unlock()
 
 		if peer.conn == nil {
-			conn, err := net.Dial("tcp", peer.Address.String()+":"+strconv.Itoa(int(peer.PeeringPort)))
+			conn, err := peer.dialTCP()
 			if err != nil {
 				return nil, false, errors.New("error when connecting to " + peer.String() + ": " + err.Error())
 			} else {
@@ -117,8 +160,24 @@ func (peer *Peer) ConnectTCP() (*network.ManagedConnection, bool, error) {
 	return peer.conn, false, nil
 }
 
+// tries to connect to all known addresses of the peer and returns the first successful connection
+func (peer *Peer) dialTCP() (conn net.Conn, err error) {
+	addresses := peer.GetAddresses()
+	if len(addresses) == 0 {
+		return nil, errors.New("no known address")
+	}
+
+	for _, address := range addresses {
+		if conn, err = net.Dial("tcp", net.JoinHostPort(address.String(), strconv.Itoa(int(peer.PeeringPort)))); err == nil {
+			return
+		}
+	}
+
+	return
+}
+
 func (peer *Peer) ConnectUDP() (*network.ManagedConnection, bool, error) {
-	conn, err := net.Dial("udp", peer.Address.String()+":"+strconv.Itoa(int(peer.PeeringPort)))
+	conn, err := net.Dial("udp", net.JoinHostPort(peer.Address.String(), strconv.Itoa(int(peer.PeeringPort))))
 	if err != nil {
 		return nil, false, errors.New("error when connecting to " + peer.Address.String() + ": " + err.Error())
 	}
@@ -143,29 +202,78 @@ func (peer *Peer) Marshal() []byte {
 	copy(result[MARSHALED_PUBLIC_KEY_START:MARSHALED_PUBLIC_KEY_END],
 		peer.Identity.PublicKey[:MARSHALED_PUBLIC_KEY_SIZE])
 
-	switch len(peer.Address) {
-	case net.IPv4len:
-		result[MARSHALED_ADDRESS_TYPE_START] = types.ADDRESS_TYPE_IPV4
-	case net.IPv6len:
-		result[MARSHALED_ADDRESS_TYPE_START] = types.ADDRESS_TYPE_IPV6
-	default:
+	if peer.Address == nil {
 		panic("invalid address in peer")
 	}
+	MarshalAddress(result[MARSHALED_ADDRESS_TYPE_START:MARSHALED_ADDRESS_END], peer.Address)
+
+	for i := 0; i < MARSHALED_ALTERNATIVE_ADDRESSES_COUNT; i++ {
+		addressStart := MARSHALED_ALTERNATIVE_ADDRESSES_START + i*MARSHALED_ALTERNATIVE_ADDRESS_SIZE
 
-	copy(result[MARSHALED_ADDRESS_START:MARSHALED_ADDRESS_END], peer.Address.To16())
+		var alternativeAddress net.IP
+		if i < len(peer.AlternativeAddresses) {
+			alternativeAddress = peer.AlternativeAddresses[i]
+		}
+
+		MarshalAddress(result[addressStart:addressStart+MARSHALED_ALTERNATIVE_ADDRESS_SIZE], alternativeAddress)
+	}
 
 	binary.BigEndian.PutUint16(result[MARSHALED_PEERING_PORT_START:MARSHALED_PEERING_PORT_END], peer.PeeringPort)
 	binary.BigEndian.PutUint16(result[MARSHALED_GOSSIP_PORT_START:MARSHALED_GOSSIP_PORT_END], peer.GossipPort)
 
 	copy(result[MARSHALED_SALT_START:MARSHALED_SALT_END], peer.Salt.Marshal())
 
+	copy(result[MARSHALED_CLUSTER_IDENTIFIER_START:MARSHALED_CLUSTER_IDENTIFIER_END], peer.ClusterIdentifier)
+
 	return result
 }
 
+// Returns true if both peers declared to belong to the same economic cluster.
+func (peer *Peer) SharesClusterWith(other *Peer) bool {
+	return peer.ClusterIdentifier != nil && bytes.Equal(peer.ClusterIdentifier, other.ClusterIdentifier)
+}
+
 func (peer *Peer) String() string {
 	if peer.Identity != nil {
-		return peer.Address.String() + ":" + strconv.Itoa(int(peer.PeeringPort)) + " / " + peer.Identity.StringIdentifier
+		return net.JoinHostPort(peer.Address.String(), strconv.Itoa(int(peer.PeeringPort))) + " / " + peer.Identity.StringIdentifier
 	} else {
-		return peer.Address.String() + ":" + strconv.Itoa(int(peer.PeeringPort))
+		return net.JoinHostPort(peer.Address.String(), strconv.Itoa(int(peer.PeeringPort)))
+	}
+}
+
+// Writes the type and the 16 byte representation of the address (a nil address is marked as ADDRESS_TYPE_NONE).
+func MarshalAddress(result []byte, address net.IP) {
+	switch {
+	case address == nil:
+		result[0] = types.ADDRESS_TYPE_NONE
+	case address.To4() != nil:
+		result[0] = types.ADDRESS_TYPE_IPV4
+	default:
+		result[0] = types.ADDRESS_TYPE_IPV6
+	}
+
+	copy(result[MARSHALED_ADDRESS_TYPE_SIZE:], address.To16())
+}
+
+func isZero(data []byte) bool {
+	for _, b := range data {
+		if b != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Reads an address written by MarshalAddress (IPv4 addresses are returned in their 16 byte form like net.IPv4 does).
+func UnmarshalAddress(data []byte) net.IP {
+	switch data[0] {
+	case types.ADDRESS_TYPE_IPV4, types.ADDRESS_TYPE_IPV6:
+		address := make(net.IP, net.IPv6len)
+		copy(address, data[MARSHALED_ADDRESS_TYPE_SIZE:MARSHALED_ADDRESS_TYPE_SIZE+MARSHALED_ADDRESS_SIZE])
+
+		return address
+	default:
+		return nil
 	}
 }
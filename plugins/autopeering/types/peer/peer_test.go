@@ -36,3 +36,31 @@ func TestPeer_MarshalUnmarshal(t *testing.T) {
 		t.Errorf("got %v want %v", restoredPeer.Salt.ExpirationTime, peer.Salt.ExpirationTime)
 	}
 }
+
+func TestPeer_MarshalUnmarshalOptionalFields(t *testing.T) {
+	peer := &Peer{
+		Address:              net.ParseIP("2001:db8::1"),
+		AlternativeAddresses: []net.IP{net.IPv4(192, 168, 0, 1)},
+		ClusterIdentifier:    make([]byte, MARSHALED_CLUSTER_IDENTIFIER_SIZE),
+		Identity:             identity.GenerateRandomIdentity(),
+		GossipPort:           123,
+		PeeringPort:          456,
+		Salt:                 salt.New(30 * time.Second),
+	}
+	peer.ClusterIdentifier[0] = 1
+
+	restoredPeer, err := Unmarshal(peer.Marshal())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !peer.Address.Equal(restoredPeer.Address) {
+		t.Errorf("got %v want %v", restoredPeer.Address, peer.Address)
+	}
+	if len(restoredPeer.AlternativeAddresses) != 1 || !restoredPeer.AlternativeAddresses[0].Equal(peer.AlternativeAddresses[0]) {
+		t.Errorf("got %v want %v", restoredPeer.AlternativeAddresses, peer.AlternativeAddresses)
+	}
+	if !restoredPeer.SharesClusterWith(peer) {
+		t.Errorf("got %v want %v", restoredPeer.ClusterIdentifier, peer.ClusterIdentifier)
+	}
+}
@@ -40,6 +40,8 @@ func (this *PeerRegister) AddOrUpdate(peer *peer.Peer, lock ...bool) bool {
 
 	if existingPeer, exists := this.Peers[peer.Identity.StringIdentifier]; exists {
 		existingPeer.Address = peer.Address
+		existingPeer.AlternativeAddresses = peer.AlternativeAddresses
+		existingPeer.ClusterIdentifier = peer.ClusterIdentifier
 		existingPeer.GossipPort = peer.GossipPort
 		existingPeer.PeeringPort = peer.PeeringPort
 		existingPeer.Salt = peer.Salt
@@ -96,6 +98,14 @@ func (this *PeerRegister) Contains(key string) bool {
 	}
 }
 
+// Returns the number of peers in the register.
+func (this *PeerRegister) Len() int {
+	this.lock.RLock()
+	defer this.lock.RUnlock()
+
+	return len(this.Peers)
+}
+
 func (this *PeerRegister) Filter(filterFn func(this *PeerRegister, req *request.Request) *PeerRegister, req *request.Request) *PeerRegister {
 	return filterFn(this, req)
 }
@@ -3,6 +3,7 @@ package ping
 import (
 	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/constants"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/stamp"
 )
 
 const (
@@ -11,11 +12,15 @@ const (
 	PACKET_HEADER_START       = 0
 	MARSHALED_ISSUER_START    = PACKET_HEADER_END
 	MARSHALED_PEERS_START     = MARSHALED_ISSUER_END
-	MARSHALED_SIGNATURE_START = MARSHALED_PEERS_END
+	MARSHALED_FLAGS_START     = MARSHALED_PEERS_END
+	MARSHALED_STAMP_START     = MARSHALED_FLAGS_END
+	MARSHALED_SIGNATURE_START = MARSHALED_STAMP_END
 
 	PACKET_HEADER_END       = PACKET_HEADER_START + PACKET_HEADER_SIZE
 	MARSHALED_ISSUER_END    = MARSHALED_ISSUER_START + MARSHALED_ISSUER_SIZE
 	MARSHALED_PEERS_END     = MARSHALED_PEERS_START + MARSHALED_PEERS_SIZE
+	MARSHALED_FLAGS_END     = MARSHALED_FLAGS_START + MARSHALED_FLAGS_SIZE
+	MARSHALED_STAMP_END     = MARSHALED_STAMP_START + MARSHALED_STAMP_SIZE
 	MARSHALED_SIGNATURE_END = MARSHALED_SIGNATURE_START + MARSHALED_SIGNATURE_SIZE
 
 	PACKET_HEADER_SIZE             = 1
@@ -23,7 +28,14 @@ const (
 	MARSHALED_PEER_ENTRY_FLAG_SIZE = 1
 	MARSHALED_PEER_ENTRY_SIZE      = MARSHALED_PEER_ENTRY_FLAG_SIZE + peer.MARSHALED_TOTAL_SIZE
 	MARSHALED_PEERS_SIZE           = MARSHALED_PEER_ENTRY_SIZE * constants.NEIGHBOR_COUNT
+	MARSHALED_FLAGS_SIZE           = 1
+	MARSHALED_STAMP_SIZE           = stamp.MARSHALED_TOTAL_SIZE
 	MARSHALED_SIGNATURE_SIZE       = 65
 
 	MARSHALED_TOTAL_SIZE = MARSHALED_SIGNATURE_END
 )
+
+const (
+	// asks the receiver to answer with a ping of its own (it proves that the receiver is reachable)
+	FLAG_REPLY_REQUESTED = byte(1 << 0)
+)
@@ -8,12 +8,16 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerlist"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/stamp"
 )
 
 type Ping struct {
 	Issuer    *peer.Peer
 	Neighbors peerlist.PeerList
-	Signature [MARSHALED_SIGNATURE_SIZE]byte
+	// true if the issuer wants us to answer with a ping (i.e. to verify that we are reachable)
+	ReplyRequested bool
+	Stamp          *stamp.Stamp
+	Signature      [MARSHALED_SIGNATURE_SIZE]byte
 }
 
 func Unmarshal(data []byte) (*Ping, error) {
@@ -47,10 +51,18 @@ func Unmarshal(data []byte) (*Ping, error) {
 		offset += MARSHALED_PEER_ENTRY_SIZE
 	}
 
+	ping.ReplyRequested = data[MARSHALED_FLAGS_START]&FLAG_REPLY_REQUESTED != 0
+
+	if unmarshaledStamp, err := stamp.Unmarshal(data[MARSHALED_STAMP_START:MARSHALED_STAMP_END]); err != nil {
+		return nil, err
+	} else {
+		ping.Stamp = unmarshaledStamp
+	}
+
 	if issuer, err := identity.FromSignedData(data[:MARSHALED_SIGNATURE_START], data[MARSHALED_SIGNATURE_START:]); err != nil {
 		return nil, err
 	} else {
-		if !bytes.Equal(issuer.Identifier, ping.Issuer.Identity.Identifier) {
+		if !bytes.Equal(issuer.Identifier, ping.Issuer.Identity.Identifier) || !bytes.Equal(issuer.PublicKey, ping.Issuer.Identity.PublicKey) {
 			return nil, ErrInvalidSignature
 		}
 	}
@@ -71,12 +83,22 @@ func (ping *Ping) Marshal() []byte {
 
 		copy(result[entryStartOffset+1:entryStartOffset+MARSHALED_PEER_ENTRY_SIZE], neighbor.Marshal())
 	}
+	if ping.ReplyRequested {
+		result[MARSHALED_FLAGS_START] |= FLAG_REPLY_REQUESTED
+	}
+
+	if ping.Stamp != nil {
+		copy(result[MARSHALED_STAMP_START:MARSHALED_STAMP_END], ping.Stamp.Marshal())
+	}
 	copy(result[MARSHALED_SIGNATURE_START:MARSHALED_SIGNATURE_END], ping.Signature[:MARSHALED_SIGNATURE_SIZE])
 
 	return result
 }
 
-func (this *Ping) Sign() {
+// Signs the ping with a fresh stamp for the given recipient.
+func (this *Ping) Sign(recipient *identity.Identity) {
+	this.Stamp = stamp.New(recipient)
+
 	if signature, err := this.Issuer.Identity.Sign(this.Marshal()[:MARSHALED_SIGNATURE_START]); err != nil {
 		panic(err)
 	} else {
@@ -0,0 +1,42 @@
+package reputation
+
+import "time"
+
+const (
+	MARSHALED_SUCCESSFUL_PINGS_START     = 0
+	MARSHALED_FAILED_PINGS_START         = MARSHALED_SUCCESSFUL_PINGS_END
+	MARSHALED_SUCCESSFUL_REQUESTS_START  = MARSHALED_FAILED_PINGS_END
+	MARSHALED_FAILED_REQUESTS_START      = MARSHALED_SUCCESSFUL_REQUESTS_END
+	MARSHALED_CONSECUTIVE_FAILURES_START = MARSHALED_FAILED_REQUESTS_END
+	MARSHALED_FIRST_SEEN_START           = MARSHALED_CONSECUTIVE_FAILURES_END
+	MARSHALED_LAST_SEEN_START            = MARSHALED_FIRST_SEEN_END
+	MARSHALED_LAST_DECAY_START           = MARSHALED_LAST_SEEN_END
+	MARSHALED_UPTIME_START               = MARSHALED_LAST_DECAY_END
+
+	MARSHALED_SUCCESSFUL_PINGS_END     = MARSHALED_SUCCESSFUL_PINGS_START + MARSHALED_COUNTER_SIZE
+	MARSHALED_FAILED_PINGS_END         = MARSHALED_FAILED_PINGS_START + MARSHALED_COUNTER_SIZE
+	MARSHALED_SUCCESSFUL_REQUESTS_END  = MARSHALED_SUCCESSFUL_REQUESTS_START + MARSHALED_COUNTER_SIZE
+	MARSHALED_FAILED_REQUESTS_END      = MARSHALED_FAILED_REQUESTS_START + MARSHALED_COUNTER_SIZE
+	MARSHALED_CONSECUTIVE_FAILURES_END = MARSHALED_CONSECUTIVE_FAILURES_START + MARSHALED_CONSECUTIVE_FAILURES_SIZE
+	MARSHALED_FIRST_SEEN_END           = MARSHALED_FIRST_SEEN_START + MARSHALED_TIME_SIZE
+	MARSHALED_LAST_SEEN_END            = MARSHALED_LAST_SEEN_START + MARSHALED_TIME_SIZE
+	MARSHALED_LAST_DECAY_END           = MARSHALED_LAST_DECAY_START + MARSHALED_TIME_SIZE
+	MARSHALED_UPTIME_END               = MARSHALED_UPTIME_START + MARSHALED_TIME_SIZE
+
+	MARSHALED_COUNTER_SIZE              = 8
+	MARSHALED_CONSECUTIVE_FAILURES_SIZE = 4
+	MARSHALED_TIME_SIZE                 = 8
+
+	MARSHALED_TOTAL_SIZE = MARSHALED_UPTIME_END
+)
+
+const (
+	// the score of a peer without any recorded successes or failures
+	NEUTRAL_SCORE = 0.5
+
+	// peers with a lower score are only contacted after all other candidates
+	UNRELIABLE_SCORE = 0.3
+
+	// two sightings that are at most this far apart count as continuous uptime
+	MAX_UPTIME_GAP = 20 * time.Minute
+)
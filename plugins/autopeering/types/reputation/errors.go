@@ -0,0 +1,7 @@
+package reputation
+
+import "github.com/pkg/errors"
+
+var (
+	ErrMalformedReputation = errors.New("malformed peer reputation")
+)
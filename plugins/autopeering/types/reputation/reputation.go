@@ -0,0 +1,306 @@
+package reputation
+
+import (
+	"encoding/binary"
+	"math"
+	"sync"
+	"time"
+)
+
+// Reputation records how reliably a peer answered our pings and peering requests. The counters decay exponentially
+// (they lose half of their weight every half life), so that old failures are forgiven and old successes fade out.
+type Reputation struct {
+	successfulPings     float64
+	failedPings         float64
+	successfulRequests  float64
+	failedRequests      float64
+	consecutiveFailures uint32
+	firstSeen           time.Time
+	lastSeen            time.Time
+	lastDecay           time.Time
+	uptime              time.Duration
+	pendingRequestSince time.Time
+	pendingPingSince    time.Time
+	mutex               sync.RWMutex
+}
+
+func New(now time.Time) *Reputation {
+	return &Reputation{
+		firstSeen: now,
+		lastDecay: now,
+	}
+}
+
+func Unmarshal(data []byte) (*Reputation, error) {
+	if len(data) < MARSHALED_TOTAL_SIZE {
+		return nil, ErrMalformedReputation
+	}
+
+	return &Reputation{
+		successfulPings:     unmarshalCounter(data[MARSHALED_SUCCESSFUL_PINGS_START:MARSHALED_SUCCESSFUL_PINGS_END]),
+		failedPings:         unmarshalCounter(data[MARSHALED_FAILED_PINGS_START:MARSHALED_FAILED_PINGS_END]),
+		successfulRequests:  unmarshalCounter(data[MARSHALED_SUCCESSFUL_REQUESTS_START:MARSHALED_SUCCESSFUL_REQUESTS_END]),
+		failedRequests:      unmarshalCounter(data[MARSHALED_FAILED_REQUESTS_START:MARSHALED_FAILED_REQUESTS_END]),
+		consecutiveFailures: binary.BigEndian.Uint32(data[MARSHALED_CONSECUTIVE_FAILURES_START:MARSHALED_CONSECUTIVE_FAILURES_END]),
+		firstSeen:           unmarshalTime(data[MARSHALED_FIRST_SEEN_START:MARSHALED_FIRST_SEEN_END]),
+		lastSeen:            unmarshalTime(data[MARSHALED_LAST_SEEN_START:MARSHALED_LAST_SEEN_END]),
+		lastDecay:           unmarshalTime(data[MARSHALED_LAST_DECAY_START:MARSHALED_LAST_DECAY_END]),
+		uptime:              time.Duration(binary.BigEndian.Uint64(data[MARSHALED_UPTIME_START:MARSHALED_UPTIME_END])),
+	}, nil
+}
+
+func (reputation *Reputation) Marshal() []byte {
+	reputation.mutex.RLock()
+	defer reputation.mutex.RUnlock()
+
+	result := make([]byte, MARSHALED_TOTAL_SIZE)
+
+	marshalCounter(result[MARSHALED_SUCCESSFUL_PINGS_START:MARSHALED_SUCCESSFUL_PINGS_END], reputation.successfulPings)
+	marshalCounter(result[MARSHALED_FAILED_PINGS_START:MARSHALED_FAILED_PINGS_END], reputation.failedPings)
+	marshalCounter(result[MARSHALED_SUCCESSFUL_REQUESTS_START:MARSHALED_SUCCESSFUL_REQUESTS_END], reputation.successfulRequests)
+	marshalCounter(result[MARSHALED_FAILED_REQUESTS_START:MARSHALED_FAILED_REQUESTS_END], reputation.failedRequests)
+	binary.BigEndian.PutUint32(result[MARSHALED_CONSECUTIVE_FAILURES_START:MARSHALED_CONSECUTIVE_FAILURES_END], reputation.consecutiveFailures)
+	marshalTime(result[MARSHALED_FIRST_SEEN_START:MARSHALED_FIRST_SEEN_END], reputation.firstSeen)
+	marshalTime(result[MARSHALED_LAST_SEEN_START:MARSHALED_LAST_SEEN_END], reputation.lastSeen)
+	marshalTime(result[MARSHALED_LAST_DECAY_START:MARSHALED_LAST_DECAY_END], reputation.lastDecay)
+	binary.BigEndian.PutUint64(result[MARSHALED_UPTIME_START:MARSHALED_UPTIME_END], uint64(reputation.uptime))
+
+	return result
+}
+
+// Records that we received a message from the peer (it is alive).
+func (reputation *Reputation) RecordSeen(now time.Time) {
+	reputation.mutex.Lock()
+	defer reputation.mutex.Unlock()
+
+	reputation.recordSeen(now)
+}
+
+// Records the outcome of sending a ping to the peer.
+func (reputation *Reputation) RecordPing(now time.Time, success bool) {
+	reputation.mutex.Lock()
+	defer reputation.mutex.Unlock()
+
+	reputation.recordPing(now, success)
+}
+
+// Records that we sent a ping to the peer - it counts as successful as soon as we hear from the peer and as failed if
+// we do not hear from it within the timeout (see CheckPingTimeout).
+func (reputation *Reputation) RecordPingSent(now time.Time) {
+	reputation.mutex.Lock()
+	defer reputation.mutex.Unlock()
+
+	if reputation.pendingPingSince.IsZero() {
+		reputation.pendingPingSince = now
+	}
+}
+
+// Counts a pending ping that was not answered within the timeout as failed and returns true if it did so.
+func (reputation *Reputation) CheckPingTimeout(now time.Time, timeout time.Duration) bool {
+	reputation.mutex.Lock()
+	defer reputation.mutex.Unlock()
+
+	if reputation.pendingPingSince.IsZero() || now.Sub(reputation.pendingPingSince) < timeout {
+		return false
+	}
+
+	reputation.recordPing(now, false)
+
+	return true
+}
+
+// Records that we sent a peering request to the peer - it counts as failed if no response arrives in time.
+func (reputation *Reputation) RecordRequestSent(now time.Time) {
+	reputation.mutex.Lock()
+	defer reputation.mutex.Unlock()
+
+	if reputation.pendingRequestSince.IsZero() {
+		reputation.pendingRequestSince = now
+	}
+}
+
+// Records that the peer answered our peering request.
+func (reputation *Reputation) RecordResponse(now time.Time) {
+	reputation.mutex.Lock()
+	defer reputation.mutex.Unlock()
+
+	reputation.decay(now)
+
+	reputation.successfulRequests++
+	reputation.pendingRequestSince = time.Time{}
+
+	reputation.recordSeen(now)
+}
+
+// Records that a peering request could not be delivered (or was not answered).
+func (reputation *Reputation) RecordRequestFailure(now time.Time) {
+	reputation.mutex.Lock()
+	defer reputation.mutex.Unlock()
+
+	reputation.recordRequestFailure(now)
+}
+
+// Counts a pending request that was not answered within the timeout as failed and returns true if it did so.
+func (reputation *Reputation) CheckRequestTimeout(now time.Time, timeout time.Duration) bool {
+	reputation.mutex.Lock()
+	defer reputation.mutex.Unlock()
+
+	if reputation.pendingRequestSince.IsZero() || now.Sub(reputation.pendingRequestSince) < timeout {
+		return false
+	}
+
+	reputation.recordRequestFailure(now)
+
+	return true
+}
+
+// Returns the (smoothed) share of successful interactions - peers without history have a score of NEUTRAL_SCORE.
+func (reputation *Reputation) GetScore(now time.Time) float64 {
+	reputation.mutex.RLock()
+	defer reputation.mutex.RUnlock()
+
+	factor := reputation.getDecayFactor(now)
+
+	successes := (reputation.successfulPings + reputation.successfulRequests) * factor
+	failures := (reputation.failedPings + reputation.failedRequests) * factor
+
+	return (successes + 2*NEUTRAL_SCORE) / (successes + failures + 2)
+}
+
+// Returns true if the peer failed repeatedly and was not seen for longer than the given timeout (peers that were
+// never seen count from the time they were first recorded).
+func (reputation *Reputation) IsDead(now time.Time, timeout time.Duration, minFailures uint32) bool {
+	reputation.mutex.RLock()
+	defer reputation.mutex.RUnlock()
+
+	lastSign := reputation.lastSeen
+	if lastSign.IsZero() {
+		lastSign = reputation.firstSeen
+	}
+
+	return reputation.consecutiveFailures >= minFailures && now.Sub(lastSign) > timeout
+}
+
+func (reputation *Reputation) GetLastSeen() time.Time {
+	reputation.mutex.RLock()
+	defer reputation.mutex.RUnlock()
+
+	return reputation.lastSeen
+}
+
+func (reputation *Reputation) GetFirstSeen() time.Time {
+	reputation.mutex.RLock()
+	defer reputation.mutex.RUnlock()
+
+	return reputation.firstSeen
+}
+
+// Returns the accumulated time that the peer was observed to be online.
+func (reputation *Reputation) GetUptime() time.Duration {
+	reputation.mutex.RLock()
+	defer reputation.mutex.RUnlock()
+
+	return reputation.uptime
+}
+
+func (reputation *Reputation) GetConsecutiveFailures() uint32 {
+	reputation.mutex.RLock()
+	defer reputation.mutex.RUnlock()
+
+	return reputation.consecutiveFailures
+}
+
+// region utility methods //////////////////////////////////////////////////////////////////////////////////////////////
+
+func (reputation *Reputation) recordSeen(now time.Time) {
+	// hearing from the peer answers a pending ping
+	if !reputation.pendingPingSince.IsZero() {
+		reputation.recordPing(now, true)
+	}
+
+	if !reputation.lastSeen.IsZero() {
+		if gap := now.Sub(reputation.lastSeen); gap > 0 && gap <= MAX_UPTIME_GAP {
+			reputation.uptime += gap
+		}
+	}
+
+	if now.After(reputation.lastSeen) {
+		reputation.lastSeen = now
+	}
+	reputation.consecutiveFailures = 0
+}
+
+func (reputation *Reputation) recordPing(now time.Time, success bool) {
+	reputation.decay(now)
+
+	if success {
+		reputation.successfulPings++
+	} else {
+		reputation.failedPings++
+		reputation.consecutiveFailures++
+	}
+	reputation.pendingPingSince = time.Time{}
+}
+
+func (reputation *Reputation) recordRequestFailure(now time.Time) {
+	reputation.decay(now)
+
+	reputation.failedRequests++
+	reputation.consecutiveFailures++
+	reputation.pendingRequestSince = time.Time{}
+}
+
+// applies the decay since the last update to the counters
+func (reputation *Reputation) decay(now time.Time) {
+	factor := reputation.getDecayFactor(now)
+
+	reputation.successfulPings *= factor
+	reputation.failedPings *= factor
+	reputation.successfulRequests *= factor
+	reputation.failedRequests *= factor
+
+	if now.After(reputation.lastDecay) {
+		reputation.lastDecay = now
+	}
+}
+
+func (reputation *Reputation) getDecayFactor(now time.Time) float64 {
+	elapsed := now.Sub(reputation.lastDecay)
+	if elapsed <= 0 || HALF_LIFE <= 0 {
+		return 1
+	}
+
+	return math.Pow(0.5, float64(elapsed)/float64(HALF_LIFE))
+}
+
+func marshalCounter(result []byte, counter float64) {
+	binary.BigEndian.PutUint64(result, math.Float64bits(counter))
+}
+
+func unmarshalCounter(data []byte) float64 {
+	return math.Float64frombits(binary.BigEndian.Uint64(data))
+}
+
+// writes the time as unix nanoseconds (the zero time is written as 0)
+func marshalTime(result []byte, t time.Time) {
+	if !t.IsZero() {
+		binary.BigEndian.PutUint64(result, uint64(t.UnixNano()))
+	}
+}
+
+func unmarshalTime(data []byte) time.Time {
+	if unixNano := int64(binary.BigEndian.Uint64(data)); unixNano != 0 {
+		return time.Unix(0, unixNano)
+	}
+
+	return time.Time{}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region constants and variables //////////////////////////////////////////////////////////////////////////////////////
+
+// the time after which the recorded successes and failures lose half of their weight (0 disables the decay)
+var HALF_LIFE = 24 * time.Hour
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,142 @@
+package reputation
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestReputation_Score(t *testing.T) {
+	now := time.Now()
+
+	reputation := New(now)
+	if reputation.GetScore(now) != NEUTRAL_SCORE {
+		t.Error("peer without history does not have a neutral score", reputation.GetScore(now))
+	}
+
+	for i := 0; i < 10; i++ {
+		reputation.RecordRequestFailure(now)
+	}
+	if score := reputation.GetScore(now); score >= UNRELIABLE_SCORE {
+		t.Error("failing peer is not unreliable", score)
+	}
+
+	// the failures lose half of their weight after every half life
+	if score := reputation.GetScore(now.Add(HALF_LIFE)); math.Abs(score-(0+1)/float64(5+2)) > 1e-9 {
+		t.Error("unexpected score after one half life", score)
+	}
+	if score := reputation.GetScore(now.Add(20 * HALF_LIFE)); math.Abs(score-NEUTRAL_SCORE) > 1e-3 {
+		t.Error("old failures were not forgotten", score)
+	}
+
+	reputation.RecordResponse(now)
+	reputation.RecordPing(now, true)
+	if reputation.GetConsecutiveFailures() != 0 {
+		t.Error("response did not reset the consecutive failures")
+	}
+}
+
+func TestReputation_Uptime(t *testing.T) {
+	now := time.Now()
+
+	reputation := New(now)
+	reputation.RecordSeen(now)
+	reputation.RecordSeen(now.Add(10 * time.Minute))
+	reputation.RecordSeen(now.Add(15 * time.Minute))
+
+	// a gap that is too large does not count as uptime
+	reputation.RecordSeen(now.Add(5 * time.Hour))
+
+	if reputation.GetUptime() != 15*time.Minute {
+		t.Error("unexpected uptime", reputation.GetUptime())
+	}
+	if !reputation.GetLastSeen().Equal(now.Add(5 * time.Hour)) {
+		t.Error("unexpected last seen time", reputation.GetLastSeen())
+	}
+}
+
+func TestReputation_IsDead(t *testing.T) {
+	now := time.Now()
+
+	reputation := New(now)
+	reputation.RecordRequestSent(now)
+
+	if reputation.CheckRequestTimeout(now.Add(10*time.Second), 30*time.Second) {
+		t.Error("pending request timed out too early")
+	}
+	if !reputation.CheckRequestTimeout(now.Add(time.Minute), 30*time.Second) {
+		t.Error("pending request did not time out")
+	}
+
+	for i := 0; i < 4; i++ {
+		reputation.RecordPing(now, false)
+	}
+
+	if reputation.IsDead(now.Add(time.Hour), 2*time.Hour, 5) {
+		t.Error("peer is dead before the timeout")
+	}
+	if !reputation.IsDead(now.Add(3*time.Hour), 2*time.Hour, 5) {
+		t.Error("peer is not dead after the timeout")
+	}
+	if reputation.IsDead(now.Add(3*time.Hour), 2*time.Hour, 6) {
+		t.Error("peer is dead without enough failures")
+	}
+
+	reputation.RecordSeen(now.Add(3 * time.Hour))
+	if reputation.IsDead(now.Add(3*time.Hour), 2*time.Hour, 0) {
+		t.Error("seen peer is dead")
+	}
+}
+
+func TestReputation_MarshalUnmarshal(t *testing.T) {
+	now := time.Now()
+
+	reputation := New(now)
+	reputation.RecordSeen(now)
+	reputation.RecordSeen(now.Add(time.Minute))
+	reputation.RecordPing(now, true)
+	reputation.RecordRequestFailure(now)
+
+	restoredReputation, err := Unmarshal(reputation.Marshal())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if restoredReputation.GetScore(now) != reputation.GetScore(now) ||
+		restoredReputation.GetUptime() != reputation.GetUptime() ||
+		restoredReputation.GetConsecutiveFailures() != reputation.GetConsecutiveFailures() ||
+		!restoredReputation.GetLastSeen().Equal(reputation.GetLastSeen()) ||
+		!restoredReputation.GetFirstSeen().Equal(reputation.GetFirstSeen()) {
+
+		t.Error("restored reputation differs from the original")
+	}
+
+	if _, err := Unmarshal(make([]byte, MARSHALED_TOTAL_SIZE-1)); err != ErrMalformedReputation {
+		t.Error("malformed reputation was accepted")
+	}
+}
+
+func TestReputation_PingTimeout(t *testing.T) {
+	now := time.Now()
+
+	reputation := New(now)
+
+	// a ping is only successful once we hear from the peer
+	reputation.RecordPingSent(now)
+	if reputation.CheckPingTimeout(now.Add(10*time.Second), 30*time.Second) {
+		t.Error("pending ping failed before the timeout")
+	}
+	reputation.RecordSeen(now.Add(10 * time.Second))
+	if reputation.successfulPings != 1 || reputation.failedPings != 0 {
+		t.Error("answered ping was not counted as successful", reputation.successfulPings, reputation.failedPings)
+	}
+
+	// a ping that is not answered fails after the timeout (and only once)
+	reputation.RecordPingSent(now.Add(20 * time.Second))
+	if !reputation.CheckPingTimeout(now.Add(50*time.Second), 30*time.Second) {
+		t.Error("unanswered ping did not fail after the timeout")
+	}
+	if reputation.CheckPingTimeout(now.Add(60*time.Second), 30*time.Second) || reputation.GetConsecutiveFailures() != 1 {
+		t.Error("unanswered ping was counted more than once", reputation.GetConsecutiveFailures())
+	}
+}
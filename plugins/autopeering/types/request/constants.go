@@ -2,19 +2,23 @@ package request
 
 import (
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/stamp"
 )
 
 const (
 	PACKET_HEADER_SIZE = 1
 	ISSUER_SIZE        = peer.MARSHALED_TOTAL_SIZE
+	STAMP_SIZE         = stamp.MARSHALED_TOTAL_SIZE
 	SIGNATURE_SIZE     = 65
 
 	PACKET_HEADER_START = 0
 	ISSUER_START        = PACKET_HEADER_END
-	SIGNATURE_START     = ISSUER_END
+	STAMP_START         = ISSUER_END
+	SIGNATURE_START     = STAMP_END
 
 	PACKET_HEADER_END = PACKET_HEADER_START + PACKET_HEADER_SIZE
 	ISSUER_END        = ISSUER_START + ISSUER_SIZE
+	STAMP_END         = STAMP_START + STAMP_SIZE
 	SIGNATURE_END     = SIGNATURE_START + SIGNATURE_SIZE
 
 	MARSHALED_TOTAL_SIZE = SIGNATURE_END
@@ -2,7 +2,6 @@ package request
 
 import (
 	"bytes"
-	"time"
 
 	"github.com/iotaledger/goshimmer/packages/identity"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/ownpeer"
@@ -10,10 +9,12 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/response"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/stamp"
 )
 
 type Request struct {
 	Issuer    *peer.Peer
+	Stamp     *stamp.Stamp
 	Signature [SIGNATURE_SIZE]byte
 }
 
@@ -30,18 +31,25 @@ func Unmarshal(data []byte) (*Request, error) {
 		peeringRequest.Issuer = unmarshaledPeer
 	}
 
-	now := time.Now()
-	if peeringRequest.Issuer.Salt.ExpirationTime.Before(now.Add(-1 * time.Minute)) {
+	switch saltmanager.CheckSalt(peeringRequest.Issuer.Salt) {
+	case saltmanager.ErrPublicSaltExpired:
 		return nil, ErrPublicSaltExpired
-	}
-	if peeringRequest.Issuer.Salt.ExpirationTime.After(now.Add(saltmanager.PUBLIC_SALT_LIFETIME + 1*time.Minute)) {
+	case saltmanager.ErrPublicSaltInvalidLifetime:
 		return nil, ErrPublicSaltInvalidLifetime
 	}
 
+	if unmarshaledStamp, err := stamp.Unmarshal(data[STAMP_START:STAMP_END]); err != nil {
+		return nil, err
+	} else {
+		peeringRequest.Stamp = unmarshaledStamp
+	}
+
 	if issuer, err := identity.FromSignedData(data[:SIGNATURE_START], data[SIGNATURE_START:]); err != nil {
 		return nil, err
 	} else {
-		if !bytes.Equal(issuer.Identifier, peeringRequest.Issuer.Identity.Identifier) {
+		if !bytes.Equal(issuer.Identifier, peeringRequest.Issuer.Identity.Identifier) ||
+			!bytes.Equal(issuer.PublicKey, peeringRequest.Issuer.Identity.PublicKey) {
+
 			return nil, ErrInvalidSignature
 		}
 	}
@@ -53,10 +61,12 @@ func Unmarshal(data []byte) (*Request, error) {
 func (this *Request) Accept(peers []*peer.Peer) error {
 	peeringResponse := &response.Response{
 		Type:   response.TYPE_ACCEPT,
-		Issuer: ownpeer.INSTANCE,
+		Issuer: ownpeer.Snapshot(),
 		Peers:  peers,
+		// the issuer address was set to the source address of the connection by the server
+		ObservedAddress: this.Issuer.Address,
 	}
-	peeringResponse.Sign()
+	peeringResponse.Sign(this.Issuer.Identity)
 
 	if _, err := this.Issuer.Send(peeringResponse.Marshal(), types.PROTOCOL_TYPE_TCP, false); err != nil {
 		return err
@@ -68,10 +78,12 @@ func (this *Request) Accept(peers []*peer.Peer) error {
 func (this *Request) Reject(peers []*peer.Peer) error {
 	peeringResponse := &response.Response{
 		Type:   response.TYPE_REJECT,
-		Issuer: ownpeer.INSTANCE,
+		Issuer: ownpeer.Snapshot(),
 		Peers:  peers,
+		// the issuer address was set to the source address of the connection by the server
+		ObservedAddress: this.Issuer.Address,
 	}
-	peeringResponse.Sign()
+	peeringResponse.Sign(this.Issuer.Identity)
 
 	if _, err := this.Issuer.Send(peeringResponse.Marshal(), types.PROTOCOL_TYPE_TCP, false); err != nil {
 		return err
@@ -80,7 +92,11 @@ func (this *Request) Reject(peers []*peer.Peer) error {
 	return nil
 }
 
-func (this *Request) Sign() {
+// Signs the request with a fresh stamp for the given recipient - every sent request needs to be signed again, since the receivers reject
+// requests with a stamp that they have seen before.
+func (this *Request) Sign(recipient *identity.Identity) {
+	this.Stamp = stamp.New(recipient)
+
 	if signature, err := this.Issuer.Identity.Sign(this.Marshal()[:SIGNATURE_START]); err != nil {
 		panic(err)
 	} else {
@@ -93,6 +109,9 @@ func (this *Request) Marshal() []byte {
 
 	result[PACKET_HEADER_START] = MARSHALED_PACKET_HEADER
 	copy(result[ISSUER_START:ISSUER_END], this.Issuer.Marshal())
+	if this.Stamp != nil {
+		copy(result[STAMP_START:STAMP_END], this.Stamp.Marshal())
+	}
 	copy(result[SIGNATURE_START:SIGNATURE_END], this.Signature[:SIGNATURE_SIZE])
 
 	return result
@@ -3,6 +3,7 @@ package response
 import (
 	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/constants"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/stamp"
 )
 
 const (
@@ -12,24 +13,30 @@ const (
 	MARSHALED_PEERS_AMOUNT   = constants.NEIGHBOR_COUNT + constants.NEIGHBOR_COUNT*constants.NEIGHBOR_COUNT
 	MARHSALLED_PACKET_HEADER = 0xBC
 
-	MARSHALED_PACKET_HEADER_START = 0
-	MARSHALED_TYPE_START          = MARSHALED_PACKET_HEADER_END
-	MARSHALED_ISSUER_START        = MARSHALED_TYPE_END
-	MARSHALED_PEERS_START         = MARSHALED_ISSUER_END
-	MARSHALED_SIGNATURE_START     = MARSHALED_PEERS_END
+	MARSHALED_PACKET_HEADER_START    = 0
+	MARSHALED_TYPE_START             = MARSHALED_PACKET_HEADER_END
+	MARSHALED_ISSUER_START           = MARSHALED_TYPE_END
+	MARSHALED_PEERS_START            = MARSHALED_ISSUER_END
+	MARSHALED_OBSERVED_ADDRESS_START = MARSHALED_PEERS_END
+	MARSHALED_STAMP_START            = MARSHALED_OBSERVED_ADDRESS_END
+	MARSHALED_SIGNATURE_START        = MARSHALED_STAMP_END
 
-	MARSHALED_PACKET_HEADER_END = MARSHALED_PACKET_HEADER_START + MARSHALED_PACKET_HEADER_SIZE
-	MARSHALED_TYPE_END          = MARSHALED_TYPE_START + MARSHALED_TYPE_SIZE
-	MARSHALED_PEERS_END         = MARSHALED_PEERS_START + MARSHALED_PEERS_SIZE
-	MARSHALED_ISSUER_END        = MARSHALED_ISSUER_START + MARSHALED_ISSUER_SIZE
-	MARSHALED_SIGNATURE_END     = MARSHALED_SIGNATURE_START + MARSHALED_SIGNATURE_SIZE
+	MARSHALED_PACKET_HEADER_END    = MARSHALED_PACKET_HEADER_START + MARSHALED_PACKET_HEADER_SIZE
+	MARSHALED_TYPE_END             = MARSHALED_TYPE_START + MARSHALED_TYPE_SIZE
+	MARSHALED_PEERS_END            = MARSHALED_PEERS_START + MARSHALED_PEERS_SIZE
+	MARSHALED_ISSUER_END           = MARSHALED_ISSUER_START + MARSHALED_ISSUER_SIZE
+	MARSHALED_OBSERVED_ADDRESS_END = MARSHALED_OBSERVED_ADDRESS_START + MARSHALED_OBSERVED_ADDRESS_SIZE
+	MARSHALED_STAMP_END            = MARSHALED_STAMP_START + MARSHALED_STAMP_SIZE
+	MARSHALED_SIGNATURE_END        = MARSHALED_SIGNATURE_START + MARSHALED_SIGNATURE_SIZE
 
-	MARSHALED_PACKET_HEADER_SIZE = 1
-	MARSHALED_TYPE_SIZE          = 1
-	MARSHALED_ISSUER_SIZE        = peer.MARSHALED_TOTAL_SIZE
-	MARSHALED_PEER_FLAG_SIZE     = 1
-	MARSHALED_PEER_SIZE          = MARSHALED_PEER_FLAG_SIZE + peer.MARSHALED_TOTAL_SIZE
-	MARSHALED_PEERS_SIZE         = MARSHALED_PEERS_AMOUNT * MARSHALED_PEER_SIZE
-	MARSHALED_SIGNATURE_SIZE     = 65
-	MARSHALED_TOTAL_SIZE         = MARSHALED_SIGNATURE_END
+	MARSHALED_PACKET_HEADER_SIZE    = 1
+	MARSHALED_TYPE_SIZE             = 1
+	MARSHALED_ISSUER_SIZE           = peer.MARSHALED_TOTAL_SIZE
+	MARSHALED_PEER_FLAG_SIZE        = 1
+	MARSHALED_PEER_SIZE             = MARSHALED_PEER_FLAG_SIZE + peer.MARSHALED_TOTAL_SIZE
+	MARSHALED_PEERS_SIZE            = MARSHALED_PEERS_AMOUNT * MARSHALED_PEER_SIZE
+	MARSHALED_OBSERVED_ADDRESS_SIZE = peer.MARSHALED_ADDRESS_TYPE_SIZE + peer.MARSHALED_ADDRESS_SIZE
+	MARSHALED_STAMP_SIZE            = stamp.MARSHALED_TOTAL_SIZE
+	MARSHALED_SIGNATURE_SIZE        = 65
+	MARSHALED_TOTAL_SIZE            = MARSHALED_SIGNATURE_END
 )
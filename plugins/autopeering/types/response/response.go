@@ -2,18 +2,24 @@ package response
 
 import (
 	"bytes"
+	"net"
 
 	"github.com/iotaledger/goshimmer/packages/identity"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/protocol/constants"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peer"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/stamp"
 	"github.com/pkg/errors"
 )
 
 type Response struct {
-	Type      Type
-	Issuer    *peer.Peer
-	Peers     []*peer.Peer
-	Signature [MARSHALED_SIGNATURE_SIZE]byte
+	Type   Type
+	Issuer *peer.Peer
+	Peers  []*peer.Peer
+	// the source address that the issuer of the response saw our request coming from (our external address if we are
+	// behind a NAT)
+	ObservedAddress net.IP
+	Stamp           *stamp.Stamp
+	Signature       [MARSHALED_SIGNATURE_SIZE]byte
 }
 
 func Unmarshal(data []byte) (*Response, error) {
@@ -46,10 +52,20 @@ func Unmarshal(data []byte) (*Response, error) {
 		}
 	}
 
+	peeringResponse.ObservedAddress = peer.UnmarshalAddress(data[MARSHALED_OBSERVED_ADDRESS_START:MARSHALED_OBSERVED_ADDRESS_END])
+
+	if unmarshaledStamp, err := stamp.Unmarshal(data[MARSHALED_STAMP_START:MARSHALED_STAMP_END]); err != nil {
+		return nil, err
+	} else {
+		peeringResponse.Stamp = unmarshaledStamp
+	}
+
 	if issuer, err := identity.FromSignedData(data[:MARSHALED_SIGNATURE_START], data[MARSHALED_SIGNATURE_START:]); err != nil {
 		return nil, err
 	} else {
-		if !bytes.Equal(issuer.Identifier, peeringResponse.Issuer.Identity.Identifier) {
+		if !bytes.Equal(issuer.Identifier, peeringResponse.Issuer.Identity.Identifier) ||
+			!bytes.Equal(issuer.PublicKey, peeringResponse.Issuer.Identity.PublicKey) {
+
 			return nil, ErrInvalidSignature
 		}
 	}
@@ -58,7 +74,10 @@ func Unmarshal(data []byte) (*Response, error) {
 	return peeringResponse, nil
 }
 
-func (this *Response) Sign() *Response {
+// Signs the response with a fresh stamp for the given recipient.
+func (this *Response) Sign(recipient *identity.Identity) *Response {
+	this.Stamp = stamp.New(recipient)
+
 	dataToSign := this.Marshal()[:MARSHALED_SIGNATURE_START]
 	if signature, err := this.Issuer.Identity.Sign(dataToSign); err != nil {
 		panic(err)
@@ -87,6 +106,12 @@ func (this *Response) Marshal() []byte {
 		}
 	}
 
+	peer.MarshalAddress(result[MARSHALED_OBSERVED_ADDRESS_START:MARSHALED_OBSERVED_ADDRESS_END], this.ObservedAddress)
+
+	if this.Stamp != nil {
+		copy(result[MARSHALED_STAMP_START:MARSHALED_STAMP_END], this.Stamp.Marshal())
+	}
+
 	copy(result[MARSHALED_SIGNATURE_START:MARSHALED_SIGNATURE_END], this.Signature[:MARSHALED_SIGNATURE_SIZE])
 
 	return result
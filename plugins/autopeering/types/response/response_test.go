@@ -9,6 +9,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/identity"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/types/salt"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/stamp"
 )
 
 func TestPeer_MarshalUnmarshal(t *testing.T) {
@@ -42,14 +43,47 @@ func TestPeer_MarshalUnmarshal(t *testing.T) {
 	})
 
 	response := &Response{
-		Issuer: issuer,
-		Type:   TYPE_ACCEPT,
-		Peers:  peers,
+		Issuer:          issuer,
+		Type:            TYPE_ACCEPT,
+		Peers:           peers,
+		ObservedAddress: net.ParseIP("203.0.113.7"),
 	}
-	response.Sign()
+	recipient := identity.GenerateRandomIdentity()
+	response.Sign(recipient)
 
-	_, err := Unmarshal(response.Marshal())
+	marshaledResponse := response.Marshal()
+
+	unmarshaledResponse, err := Unmarshal(marshaledResponse)
 	if err != nil {
+		t.Fatal(err)
+	}
+	if !unmarshaledResponse.ObservedAddress.Equal(response.ObservedAddress) {
+		t.Error("observed address was not restored correctly", unmarshaledResponse.ObservedAddress)
+	}
+
+	// the stamp is checked by the processors (after the signature was verified)
+	if err := stamp.Verify(recipient, unmarshaledResponse.Issuer.Identity.StringIdentifier, unmarshaledResponse.Stamp); err != nil {
 		t.Error(err)
 	}
+	if err := stamp.Verify(recipient, unmarshaledResponse.Issuer.Identity.StringIdentifier, unmarshaledResponse.Stamp); err != stamp.ErrReplayedMessage {
+		t.Error("replayed response was accepted", err)
+	}
+
+	// the peer list cannot be changed without invalidating the signature
+	response.Sign(recipient)
+	tamperedResponse := response.Marshal()
+	tamperedResponse[MARSHALED_PEERS_START+1+peer.MARSHALED_GOSSIP_PORT_START]++
+
+	if _, err := Unmarshal(tamperedResponse); err == nil {
+		t.Error("tampered response was accepted")
+	}
+
+	// neither can the observed address
+	response.Sign(recipient)
+	tamperedResponse = response.Marshal()
+	tamperedResponse[MARSHALED_OBSERVED_ADDRESS_END-1]++
+
+	if _, err := Unmarshal(tamperedResponse); err == nil {
+		t.Error("response with tampered observed address was accepted")
+	}
 }
@@ -0,0 +1,30 @@
+package stamp
+
+import "time"
+
+const (
+	MARSHALED_TIMESTAMP_START = 0
+	MARSHALED_NONCE_START     = MARSHALED_TIMESTAMP_END
+	MARSHALED_RECIPIENT_START = MARSHALED_NONCE_END
+
+	MARSHALED_TIMESTAMP_END = MARSHALED_TIMESTAMP_START + MARSHALED_TIMESTAMP_SIZE
+	MARSHALED_NONCE_END     = MARSHALED_NONCE_START + MARSHALED_NONCE_SIZE
+	MARSHALED_RECIPIENT_END = MARSHALED_RECIPIENT_START + MARSHALED_RECIPIENT_SIZE
+
+	MARSHALED_TIMESTAMP_SIZE = 8
+	MARSHALED_NONCE_SIZE     = 16
+	MARSHALED_RECIPIENT_SIZE = 20
+
+	MARSHALED_TOTAL_SIZE = MARSHALED_RECIPIENT_END
+)
+
+const (
+	// messages that are older are rejected (their nonces do not need to be remembered any longer)
+	MAX_MESSAGE_AGE = 2 * time.Minute
+
+	// messages with a timestamp that lies further in the future are rejected
+	MAX_CLOCK_SKEW = 1 * time.Minute
+
+	// the maximum amount of nonces that are remembered by the replay cache
+	REPLAY_CACHE_CAPACITY = 100000
+)
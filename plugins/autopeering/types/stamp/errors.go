@@ -0,0 +1,12 @@
+package stamp
+
+import "github.com/pkg/errors"
+
+var (
+	ErrMalformedStamp  = errors.New("malformed message stamp")
+	ErrExpiredMessage  = errors.New("expired peering message")
+	ErrFutureMessage   = errors.New("peering message from the future")
+	ErrReplayedMessage = errors.New("replayed peering message")
+	ErrReplayCacheFull = errors.New("replay cache is full")
+	ErrWrongRecipient  = errors.New("peering message addressed to another node")
+)
@@ -0,0 +1,88 @@
+package stamp
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/filter"
+	"github.com/iotaledger/goshimmer/packages/identity"
+)
+
+// Stamp makes a signed peering message unique: the timestamp limits how long a message is valid and the nonce allows
+// us to recognize (and reject) replayed messages within that time. The recipient binds the message to the node it was
+// sent to, so it cannot be replayed to other nodes either.
+type Stamp struct {
+	Timestamp time.Time
+	Nonce     [MARSHALED_NONCE_SIZE]byte
+	Recipient [MARSHALED_RECIPIENT_SIZE]byte
+}
+
+// Creates a new stamp for a message to the given recipient with the current time and a random nonce.
+func New(recipient *identity.Identity) *Stamp {
+	stamp := &Stamp{
+		Timestamp: time.Now(),
+	}
+	copy(stamp.Recipient[:], recipient.Identifier)
+
+	if _, err := rand.Read(stamp.Nonce[:]); err != nil {
+		panic(err)
+	}
+
+	return stamp
+}
+
+func Unmarshal(data []byte) (*Stamp, error) {
+	if len(data) < MARSHALED_TOTAL_SIZE {
+		return nil, ErrMalformedStamp
+	}
+
+	stamp := &Stamp{
+		Timestamp: time.Unix(0, int64(binary.BigEndian.Uint64(data[MARSHALED_TIMESTAMP_START:MARSHALED_TIMESTAMP_END]))),
+	}
+	copy(stamp.Nonce[:], data[MARSHALED_NONCE_START:MARSHALED_NONCE_END])
+	copy(stamp.Recipient[:], data[MARSHALED_RECIPIENT_START:MARSHALED_RECIPIENT_END])
+
+	return stamp, nil
+}
+
+func (stamp *Stamp) Marshal() []byte {
+	result := make([]byte, MARSHALED_TOTAL_SIZE)
+
+	binary.BigEndian.PutUint64(result[MARSHALED_TIMESTAMP_START:MARSHALED_TIMESTAMP_END], uint64(stamp.Timestamp.UnixNano()))
+	copy(result[MARSHALED_NONCE_START:MARSHALED_NONCE_END], stamp.Nonce[:])
+	copy(result[MARSHALED_RECIPIENT_START:MARSHALED_RECIPIENT_END], stamp.Recipient[:])
+
+	return result
+}
+
+// Checks if the stamp of a message (whose signature was verified already) is addressed to us, is fresh and was not seen
+// before - it needs to be called after the signature check (by the processors), so that forged messages cannot fill
+// the replay cache.
+func Verify(ownIdentity *identity.Identity, issuerIdentifier string, stamp *Stamp) error {
+	now := time.Now()
+
+	if !bytes.Equal(stamp.Recipient[:], ownIdentity.Identifier) {
+		return ErrWrongRecipient
+	}
+	if stamp.Timestamp.Before(now.Add(-MAX_MESSAGE_AGE)) {
+		return ErrExpiredMessage
+	}
+	if stamp.Timestamp.After(now.Add(MAX_CLOCK_SKEW)) {
+		return ErrFutureMessage
+	}
+
+	// the nonces are only evicted once their messages expired - if the cache is full, we reject all new messages (instead
+	// of forgetting nonces that could still be replayed)
+	if added, full := replayCache.AddWithoutEviction(issuerIdentifier + string(stamp.Nonce[:])); full {
+		return ErrReplayCacheFull
+	} else if !added {
+		return ErrReplayedMessage
+	}
+
+	return nil
+}
+
+// the nonces only need to be remembered as long as their messages are valid
+var replayCache = filter.NewSeenSet(REPLAY_CACHE_CAPACITY, MAX_MESSAGE_AGE+MAX_CLOCK_SKEW)
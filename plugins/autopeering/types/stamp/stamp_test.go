@@ -0,0 +1,80 @@
+package stamp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/filter"
+	"github.com/iotaledger/goshimmer/packages/identity"
+)
+
+func TestVerify(t *testing.T) {
+	recipient := identity.GenerateRandomIdentity()
+
+	stamp := New(recipient)
+
+	restoredStamp, err := Unmarshal(stamp.Marshal())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !restoredStamp.Timestamp.Equal(stamp.Timestamp) || restoredStamp.Nonce != stamp.Nonce || restoredStamp.Recipient != stamp.Recipient {
+		t.Error("restored stamp differs from the original")
+	}
+
+	// a stamp addressed to another node is rejected (and does not use up the nonce)
+	if err := Verify(identity.GenerateRandomIdentity(), "issuer", restoredStamp); err != ErrWrongRecipient {
+		t.Error("stamp of another recipient was accepted", err)
+	}
+
+	if err := Verify(recipient, "issuer", restoredStamp); err != nil {
+		t.Error(err)
+	}
+	if err := Verify(recipient, "issuer", restoredStamp); err != ErrReplayedMessage {
+		t.Error("replayed stamp was accepted", err)
+	}
+
+	// the same nonce of a different issuer is not a replay
+	if err := Verify(recipient, "other issuer", restoredStamp); err != nil {
+		t.Error(err)
+	}
+
+	expiredStamp := New(recipient)
+	expiredStamp.Timestamp = time.Now().Add(-MAX_MESSAGE_AGE - time.Second)
+	if err := Verify(recipient, "issuer", expiredStamp); err != ErrExpiredMessage {
+		t.Error("expired stamp was accepted", err)
+	}
+
+	futureStamp := New(recipient)
+	futureStamp.Timestamp = time.Now().Add(MAX_CLOCK_SKEW + time.Minute)
+	if err := Verify(recipient, "issuer", futureStamp); err != ErrFutureMessage {
+		t.Error("stamp from the future was accepted", err)
+	}
+}
+
+func TestVerify_FullReplayCache(t *testing.T) {
+	originalReplayCache := replayCache
+	replayCache = filter.NewSeenSet(3, MAX_MESSAGE_AGE+MAX_CLOCK_SKEW)
+	defer func() { replayCache = originalReplayCache }()
+
+	recipient := identity.GenerateRandomIdentity()
+
+	stamps := make([]*Stamp, 3)
+	for i := range stamps {
+		stamps[i] = New(recipient)
+
+		if err := Verify(recipient, "issuer", stamps[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the cache is full of nonces that did not expire, yet - new messages are rejected instead of evicting them
+	if err := Verify(recipient, "issuer", New(recipient)); err != ErrReplayCacheFull {
+		t.Error("message was accepted although the replay cache is full", err)
+	}
+
+	for _, stamp := range stamps {
+		if err := Verify(recipient, "issuer", stamp); err != ErrReplayedMessage {
+			t.Error("replayed stamp was accepted", err)
+		}
+	}
+}
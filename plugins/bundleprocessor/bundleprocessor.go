@@ -12,15 +12,22 @@ import (
 	"github.com/iotaledger/iota.go/trinary"
 )
 
-var workerPool = workerpool.New(func(task workerpool.Task) {
-	if err := ProcessSolidBundleHead(task.Param(0).(*value_transaction.ValueTransaction)); err != nil {
-		Events.Error.Trigger(err)
-	}
+var workerPool *workerpool.WorkerPool
 
-	task.Return(nil)
-}, workerpool.WorkerCount(WORKER_COUNT), workerpool.QueueSize(2*WORKER_COUNT))
+func configureWorkerPool() {
+	workerPool = workerpool.New(func(task workerpool.Task) {
+		if err := ProcessSolidBundleHead(task.Param(0).(*value_transaction.ValueTransaction)); err != nil {
+			Events.Error.Trigger(err)
+		}
 
-const WORKER_COUNT = 10000
+		task.Return(nil)
+	}, workerpool.WorkerCount(int(*WORKER_COUNT.Value)), workerpool.QueueSize(2*int(*WORKER_COUNT.Value)))
+}
+
+// Returns the amount of solid bundle heads that are still waiting to be processed.
+func GetQueueSize() int {
+	return workerPool.GetPendingQueueSize()
+}
 
 func ProcessSolidBundleHead(headTransaction *value_transaction.ValueTransaction) errors.IdentifiableError {
 	// only process the bundle if we didn't process it, yet
@@ -63,7 +63,7 @@ func TestValidateSignatures(t *testing.T) {
 
 func TestProcessSolidBundleHead_Data(t *testing.T) {
 	// show all error messages for tests
-	*node.LOG_LEVEL.Value = node.LOG_LEVEL_FAILURE
+	node.LOG_LEVEL.SetValue(node.LOG_LEVEL_FAILURE)
 
 	// start a test node
 	node.Start(tangle.PLUGIN, PLUGIN)
@@ -104,7 +104,7 @@ func TestProcessSolidBundleHead_Data(t *testing.T) {
 
 func TestProcessSolidBundleHead_Value(t *testing.T) {
 	// show all error messages for tests
-	*node.LOG_LEVEL.Value = node.LOG_LEVEL_FAILURE
+	node.LOG_LEVEL.SetValue(node.LOG_LEVEL_FAILURE)
 
 	// start a test node
 	node.Start(tangle.PLUGIN, PLUGIN)
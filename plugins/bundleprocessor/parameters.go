@@ -0,0 +1,7 @@
+package bundleprocessor
+
+import "github.com/iotaledger/goshimmer/packages/parameter"
+
+var (
+	WORKER_COUNT = parameter.AddUint("BUNDLEPROCESSOR/WORKER_COUNT", 10000, "amount of workers that process solid bundles (the queue can hold twice as many bundles)").SetRange(1, 100000)
+)
@@ -6,12 +6,16 @@ import (
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/model/value_transaction"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/plugins/tangle"
 )
 
-var PLUGIN = node.NewPlugin("Bundle Processor", node.Enabled, configure, run)
+var PLUGIN = node.NewPlugin("Bundle Processor", node.Enabled, configure, run).DependsOn(tangle.PLUGIN)
 
 func configure(plugin *node.Plugin) {
+	configureWorkerPool()
+	configureValueBundleProcessorWorkerPool()
+
 	tangle.Events.TransactionSolid.Attach(events.NewClosure(func(tx *value_transaction.ValueTransaction) {
 		if tx.IsHead() {
 			workerPool.Submit(tx)
@@ -21,36 +25,46 @@ func configure(plugin *node.Plugin) {
 	Events.Error.Attach(events.NewClosure(func(err errors.IdentifiableError) {
 		plugin.LogFailure(err.Error())
 	}))
-
-	daemon.Events.Shutdown.Attach(events.NewClosure(func() {
-		plugin.LogInfo("Stopping Bundle Processor ...")
-
-		workerPool.Stop()
-
-		plugin.LogInfo("Stopping Value Bundle Processor ...")
-
-		valueBundleProcessorWorkerPool.Stop()
-	}))
 }
 
 func run(plugin *node.Plugin) {
 	plugin.LogInfo("Starting Bundle Processor ...")
 
-	daemon.BackgroundWorker("Bundle Processor", func() {
+	// start the pools right away, so no bundles get dropped while the workers are being scheduled
+	workerPool.Start()
+	valueBundleProcessorWorkerPool.Start()
+
+	daemon.BackgroundWorker("Bundle Processor", func(shutdownSignal <-chan struct{}) {
 		plugin.LogSuccess("Starting Bundle Processor ... done")
 
+		go func() {
+			<-shutdownSignal
+
+			plugin.LogInfo("Stopping Bundle Processor ...")
+
+			workerPool.Stop()
+		}()
+
 		workerPool.Run()
 
 		plugin.LogSuccess("Stopping Bundle Processor ... done")
-	})
+	}, shutdown.PRIORITY_BUNDLE_PROCESSOR)
 
 	plugin.LogInfo("Starting Value Bundle Processor ...")
 
-	daemon.BackgroundWorker("Value Bundle Processor", func() {
+	daemon.BackgroundWorker("Value Bundle Processor", func(shutdownSignal <-chan struct{}) {
 		plugin.LogSuccess("Starting Value Bundle Processor ... done")
 
+		go func() {
+			<-shutdownSignal
+
+			plugin.LogInfo("Stopping Value Bundle Processor ...")
+
+			valueBundleProcessorWorkerPool.Stop()
+		}()
+
 		valueBundleProcessorWorkerPool.Run()
 
 		plugin.LogSuccess("Stopping Value Bundle Processor ... done")
-	})
+	}, shutdown.PRIORITY_BUNDLE_PROCESSOR)
 }
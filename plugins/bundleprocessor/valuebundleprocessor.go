@@ -10,13 +10,22 @@ import (
 	"github.com/iotaledger/iota.go/trinary"
 )
 
-var valueBundleProcessorWorkerPool = workerpool.New(func(task workerpool.Task) {
-	if err := ProcessSolidValueBundle(task.Param(0).(*bundle.Bundle), task.Param(1).([]*value_transaction.ValueTransaction)); err != nil {
-		Events.Error.Trigger(err)
-	}
+var valueBundleProcessorWorkerPool *workerpool.WorkerPool
+
+func configureValueBundleProcessorWorkerPool() {
+	valueBundleProcessorWorkerPool = workerpool.New(func(task workerpool.Task) {
+		if err := ProcessSolidValueBundle(task.Param(0).(*bundle.Bundle), task.Param(1).([]*value_transaction.ValueTransaction)); err != nil {
+			Events.Error.Trigger(err)
+		}
 
-	task.Return(nil)
-}, workerpool.WorkerCount(WORKER_COUNT), workerpool.QueueSize(2*WORKER_COUNT))
+		task.Return(nil)
+	}, workerpool.WorkerCount(int(*WORKER_COUNT.Value)), workerpool.QueueSize(2*int(*WORKER_COUNT.Value)))
+}
+
+// Returns the amount of solid value bundles that are still waiting for their signatures to be checked.
+func GetValueBundleQueueSize() int {
+	return valueBundleProcessorWorkerPool.GetPendingQueueSize()
+}
 
 func ProcessSolidValueBundle(bundle *bundle.Bundle, bundleTransactions []*value_transaction.ValueTransaction) errors.IdentifiableError {
 	bundle.SetBundleEssenceHash(CalculateBundleHash(bundleTransactions))
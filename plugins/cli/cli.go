@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/parameter"
 )
 
 func AddBoolParameter(p *bool, name string, usage string) {
@@ -23,6 +24,11 @@ func AddStringParameter(p *string, name string, usage string) {
 	flag.StringVar(p, name, *p, usage)
 }
 
+// Adds a parameter that parses (and validates) its own values.
+func AddValueParameter(value flag.Value, name string, usage string) {
+	flag.Var(value, name, usage)
+}
+
 var enabledPlugins []string
 var disabledPlugins []string
 
@@ -40,6 +46,15 @@ func getList(a []string) string {
 	return strings.Join(a, " ")
 }
 
+func getReloadableFlagNames() []string {
+	result := make([]string, 0)
+	for name := range parameter.GetReloadableValues() {
+		result = append(result, "-"+getFlagName(name))
+	}
+
+	return result
+}
+
 func printUsage() {
 	fmt.Fprintf(
 		os.Stderr,
@@ -53,6 +68,10 @@ func printUsage() {
 	)
 	flag.PrintDefaults()
 
+	fmt.Fprintf(os.Stderr, "\nAll options can also be set in the configuration file (-%s) or by environment variables (i.e. %s).\n", getFlagName(node.CONFIG_FILE.Name), parameter.GetEnvironmentVariableName(ENVIRONMENT_VARIABLE_PREFIX, "GOSSIP/PORT"))
+	fmt.Fprintln(os.Stderr, "Flags take precedence over environment variables, which take precedence over the configuration file.")
+	fmt.Fprintf(os.Stderr, "Sending SIGHUP reloads the options that can be changed while the node is running:\n  %s\n", getList(getReloadableFlagNames()))
+
 	fmt.Fprintf(os.Stderr, "\nThe following plugins are enabled by default and can be disabled with -%s:\n  %s\n", getFlagName(node.DISABLE_PLUGINS.Name), getList(enabledPlugins))
 	fmt.Fprintf(os.Stderr, "The following plugins are disabled by default and can be enabled with -%s:\n  %s\n\n", getFlagName(node.ENABLE_PLUGINS.Name), getList(disabledPlugins))
 }
@@ -0,0 +1,6 @@
+package cli
+
+const (
+	// the prefix of the environment variables that set parameters (i.e. GOSHIMMER_GOSSIP_PORT)
+	ENVIRONMENT_VARIABLE_PREFIX = "GOSHIMMER"
+)
@@ -3,6 +3,7 @@ package cli
 import (
 	"flag"
 	"fmt"
+	"os"
 	"strings"
 
 	"github.com/iotaledger/goshimmer/packages/events"
@@ -15,17 +16,47 @@ func getFlagName(paramName string) string {
 }
 
 func onAddBoolParameter(param *parameter.BoolParameter) {
+	flagParameters[getFlagName(param.Name)] = param.Name
+
 	AddBoolParameter(param.Value, getFlagName(param.Name), param.Description)
 }
 
 func onAddIntParameter(param *parameter.IntParameter) {
+	flagParameters[getFlagName(param.Name)] = param.Name
+
 	AddIntParameter(param.Value, getFlagName(param.Name), param.Description)
 }
 
 func onAddStringParameter(param *parameter.StringParameter) {
+	flagParameters[getFlagName(param.Name)] = param.Name
+
 	AddStringParameter(param.Value, getFlagName(param.Name), param.Description)
 }
 
+func onAddDurationParameter(param *parameter.DurationParameter) {
+	flagParameters[getFlagName(param.Name)] = param.Name
+
+	AddValueParameter(param, getFlagName(param.Name), param.Description)
+}
+
+func onAddStringSliceParameter(param *parameter.StringSliceParameter) {
+	flagParameters[getFlagName(param.Name)] = param.Name
+
+	AddValueParameter(param, getFlagName(param.Name), param.Description)
+}
+
+func onAddFloatParameter(param *parameter.FloatParameter) {
+	flagParameters[getFlagName(param.Name)] = param.Name
+
+	AddValueParameter(param, getFlagName(param.Name), param.Description)
+}
+
+func onAddUintParameter(param *parameter.UintParameter) {
+	flagParameters[getFlagName(param.Name)] = param.Name
+
+	AddValueParameter(param, getFlagName(param.Name), param.Description)
+}
+
 func onAddPlugin(name string, status int) {
 	AddPluginStatus(node.GetPluginIdentifier(name), status)
 }
@@ -40,6 +71,18 @@ func init() {
 	for _, param := range parameter.GetStrings() {
 		onAddStringParameter(param)
 	}
+	for _, param := range parameter.GetDurations() {
+		onAddDurationParameter(param)
+	}
+	for _, param := range parameter.GetStringSlices() {
+		onAddStringSliceParameter(param)
+	}
+	for _, param := range parameter.GetFloats() {
+		onAddFloatParameter(param)
+	}
+	for _, param := range parameter.GetUints() {
+		onAddUintParameter(param)
+	}
 	for name, status := range parameter.GetPlugins() {
 		onAddPlugin(name, status)
 	}
@@ -47,6 +90,10 @@ func init() {
 	parameter.Events.AddBool.Attach(events.NewClosure(onAddBoolParameter))
 	parameter.Events.AddInt.Attach(events.NewClosure(onAddIntParameter))
 	parameter.Events.AddString.Attach(events.NewClosure(onAddStringParameter))
+	parameter.Events.AddDuration.Attach(events.NewClosure(onAddDurationParameter))
+	parameter.Events.AddStringSlice.Attach(events.NewClosure(onAddStringSliceParameter))
+	parameter.Events.AddFloat.Attach(events.NewClosure(onAddFloatParameter))
+	parameter.Events.AddUint.Attach(events.NewClosure(onAddUintParameter))
 	parameter.Events.AddPlugin.Attach(events.NewClosure(onAddPlugin))
 
 	flag.Usage = printUsage
@@ -61,9 +108,52 @@ func parseParameters() {
 	}
 }
 
+// Applies the configuration file and the environment variables - flags that were passed explicitly take precedence.
+func loadConfiguration() error {
+	explicitFlags := make(map[string]string)
+	flag.Visit(func(f *flag.Flag) {
+		explicitFlags[f.Name] = f.Value.String()
+		explicitParameters[flagParameters[f.Name]] = true
+	})
+
+	configFile = *node.CONFIG_FILE.Value
+	if configFile == "" {
+		configFile = os.Getenv(parameter.GetEnvironmentVariableName(ENVIRONMENT_VARIABLE_PREFIX, node.CONFIG_FILE.Name))
+	}
+	if configFile != "" {
+		if err := parameter.LoadConfigFile(configFile); err != nil {
+			return err
+		}
+	}
+
+	if err := parameter.LoadEnvironment(ENVIRONMENT_VARIABLE_PREFIX); err != nil {
+		return err
+	}
+
+	for name, value := range explicitFlags {
+		if err := flag.Set(name, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func configure(ctx *node.Plugin) {
 	flag.Parse()
 
+	if err := loadConfiguration(); err != nil {
+		fmt.Fprintln(os.Stderr, "invalid configuration: "+err.Error())
+
+		os.Exit(1)
+	}
+
+	if err := node.ConfigureLogging(); err != nil {
+		fmt.Fprintln(os.Stderr, "invalid logging configuration: "+err.Error())
+
+		os.Exit(1)
+	}
+
 	parseParameters()
 
 	fmt.Println("  _____ _   _ ________  ______  ___ ___________ ")
@@ -78,7 +168,7 @@ func configure(ctx *node.Plugin) {
 }
 
 func run(ctx *node.Plugin) {
-	// do nothing; everything is handled in the configure step
+	runReloadHandler(ctx)
 }
 
 var PLUGIN = node.NewPlugin("CLI", node.Enabled, configure, run)
@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+
+	"github.com/iotaledger/goshimmer/packages/daemon"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/parameter"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+)
+
+var (
+	// maps the names of the command line flags to the names of their parameters
+	flagParameters = make(map[string]string)
+
+	// parameters that were passed as flags keep their values when the configuration is reloaded
+	explicitParameters = make(map[string]bool)
+
+	configFile string
+
+	reloadMutex sync.Mutex
+)
+
+// Reloads the reloadable parameters from the configuration file and the environment variables (the environment is
+// applied again, so that it still takes precedence over the file).
+func Reload() error {
+	reloadMutex.Lock()
+	defer reloadMutex.Unlock()
+
+	filter := func(name string) bool {
+		return !explicitParameters[name]
+	}
+
+	if configFile != "" {
+		if err := parameter.ReloadConfigFile(configFile, filter); err != nil {
+			return err
+		}
+	}
+
+	return parameter.ReloadEnvironment(ENVIRONMENT_VARIABLE_PREFIX, filter)
+}
+
+func runReloadHandler(plugin *node.Plugin) {
+	daemon.BackgroundWorker("Configuration Reloader", func(shutdownSignal <-chan struct{}) {
+		reloadSignal := make(chan os.Signal, 1)
+		signal.Notify(reloadSignal, syscall.SIGHUP)
+		defer signal.Stop(reloadSignal)
+
+		for {
+			select {
+			case <-shutdownSignal:
+				return
+
+			case <-reloadSignal:
+				plugin.LogInfo("Reloading configuration ...")
+
+				if err := Reload(); err != nil {
+					plugin.Log.Failure("Reloading configuration ... failed", "error", err)
+				} else {
+					plugin.LogSuccess("Reloading configuration ... done")
+				}
+			}
+		}
+	}, shutdown.PRIORITY_CONFIGURATION)
+}
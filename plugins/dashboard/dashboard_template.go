@@ -0,0 +1,466 @@
+package dashboard
+
+var dashboardTemplate = `
+<!DOCTYPE html>
+<html>
+
+<head>
+    <meta charset="utf-8">
+    <title>GoShimmer Dashboard</title>
+    <script src="https://code.highcharts.com/stock/highstock.js"></script>
+    <style>
+        body {
+            margin: 0;
+            padding: 16px;
+            background: #2a2a2b;
+            color: #e0e0e3;
+            font-family: sans-serif;
+            font-size: 14px;
+        }
+
+        h1 {
+            margin: 0 0 16px 0;
+            font-size: 22px;
+        }
+
+        h2 {
+            margin: 0 0 8px 0;
+            font-size: 16px;
+            color: #90ee7e;
+        }
+
+        .grid {
+            display: grid;
+            grid-template-columns: repeat(auto-fill, minmax(420px, 1fr));
+            grid-gap: 16px;
+        }
+
+        .panel {
+            background: #3e3e40;
+            border-radius: 4px;
+            padding: 12px;
+            overflow: auto;
+        }
+
+        .wide {
+            grid-column: 1 / -1;
+        }
+
+        table {
+            width: 100%;
+            border-collapse: collapse;
+        }
+
+        th, td {
+            padding: 3px 6px;
+            text-align: left;
+            white-space: nowrap;
+        }
+
+        th {
+            color: #a0a0a3;
+            font-weight: normal;
+            border-bottom: 1px solid #606063;
+        }
+
+        .mono {
+            font-family: monospace;
+        }
+
+        .ok {
+            color: #90ee7e;
+        }
+
+        .failed {
+            color: #f45b5b;
+        }
+
+        .hash {
+            font-family: monospace;
+            color: #7798bf;
+            cursor: pointer;
+        }
+
+        input {
+            width: 80%;
+            padding: 6px;
+            background: #2a2a2b;
+            color: #e0e0e3;
+            border: 1px solid #606063;
+            font-family: monospace;
+        }
+
+        button {
+            padding: 6px 12px;
+            background: #2b908f;
+            color: #ffffff;
+            border: none;
+            cursor: pointer;
+        }
+    </style>
+</head>
+
+<body>
+    <h1>GoShimmer Dashboard <span id="connection" class="failed">(disconnected)</span></h1>
+
+    <div class="grid">
+        <div class="panel wide">
+            <div id="tpsChart" style="height: 350px"></div>
+        </div>
+
+        <div class="panel">
+            <h2>Node</h2>
+            <table>
+                <tr><th>Version</th><td id="nodeVersion"></td></tr>
+                <tr><th>Identity</th><td id="nodeIdentity" class="mono"></td></tr>
+                <tr><th>Uptime</th><td id="nodeUptime"></td></tr>
+                <tr><th>Tips</th><td id="nodeTips"></td></tr>
+                <tr><th>Solid transactions</th><td id="nodeSolidTransactions"></td></tr>
+                <tr><th>TPS</th><td id="nodeTps"></td></tr>
+            </table>
+        </div>
+
+        <div class="panel">
+            <h2>Solidification latency</h2>
+            <div id="latencyChart" style="height: 220px"></div>
+        </div>
+
+        <div class="panel wide">
+            <h2>Neighbors</h2>
+            <table>
+                <thead>
+                    <tr>
+                        <th>Identity</th>
+                        <th>Address</th>
+                        <th>Type</th>
+                        <th>Status</th>
+                        <th>Received</th>
+                        <th>Sent</th>
+                        <th>Dropped</th>
+                        <th>Queued</th>
+                    </tr>
+                </thead>
+                <tbody id="neighbors"></tbody>
+            </table>
+        </div>
+
+        <div class="panel">
+            <h2>Auto peering</h2>
+            <table>
+                <tr><th>Known peers</th><td id="knownPeers"></td></tr>
+                <tr><th>Neighborhood</th><td id="neighborhood"></td></tr>
+            </table>
+            <br>
+            <table>
+                <thead>
+                    <tr><th>Chosen neighbor</th><th>Address</th></tr>
+                </thead>
+                <tbody id="chosenNeighbors"></tbody>
+            </table>
+            <br>
+            <table>
+                <thead>
+                    <tr><th>Accepted neighbor</th><th>Address</th></tr>
+                </thead>
+                <tbody id="acceptedNeighbors"></tbody>
+            </table>
+        </div>
+
+        <div class="panel">
+            <h2>Plugins</h2>
+            <table>
+                <tbody id="plugins"></tbody>
+            </table>
+        </div>
+
+        <div class="panel wide">
+            <h2>Transaction explorer</h2>
+            <form id="searchForm">
+                <input id="searchHash" placeholder="transaction hash (81 trytes)" maxlength="81">
+                <button type="submit">Search</button>
+            </form>
+            <br>
+            <div id="searchResult"></div>
+        </div>
+    </div>
+
+    <script>
+        Highcharts.setOptions({
+            colors: ['#2b908f', '#90ee7e', '#f45b5b', '#7798bf', '#aaeeee', '#ff0066'],
+            chart: {
+                backgroundColor: '#3e3e40',
+                plotBorderColor: '#606063'
+            },
+            title: {
+                style: {
+                    color: '#e0e0e3'
+                }
+            },
+            legend: {
+                itemStyle: {
+                    color: '#e0e0e3'
+                }
+            },
+            xAxis: {
+                gridLineColor: '#707073',
+                lineColor: '#707073',
+                labels: {
+                    style: {
+                        color: '#e0e0e3'
+                    }
+                }
+            },
+            yAxis: {
+                gridLineColor: '#707073',
+                labels: {
+                    style: {
+                        color: '#e0e0e3'
+                    }
+                },
+                title: {
+                    style: {
+                        color: '#a0a0a3'
+                    }
+                }
+            },
+            time: {
+                timezoneOffset: new Date().getTimezoneOffset()
+            },
+            credits: {
+                enabled: false
+            }
+        });
+
+        const tpsSeries = [
+            {key: 'receivedTps', name: 'Received TPS'},
+            {key: 'solidTps', name: 'Solid TPS'},
+            {key: 'duplicateTps', name: 'Duplicate TPS'},
+            {key: 'bundlesPerSecond', name: 'Bundles per second'}
+        ];
+
+        const tpsChart = Highcharts.stockChart('tpsChart', {
+            title: {
+                text: 'Transactions per second'
+            },
+            legend: {
+                enabled: true
+            },
+            rangeSelector: {
+                buttons: [
+                    {type: 'minute', count: 5, text: '5m'},
+                    {type: 'minute', count: 15, text: '15m'},
+                    {type: 'minute', count: 30, text: '30m'},
+                    {type: 'hour', count: 1, text: '1h'}
+                ],
+                selected: 0,
+                inputEnabled: false
+            },
+            navigator: {
+                enabled: false
+            },
+            scrollbar: {
+                enabled: false
+            },
+            series: tpsSeries.map(function (series) {
+                return {name: series.name, data: [], type: 'spline', tooltip: {valueDecimals: 0}};
+            })
+        });
+
+        const latencyChart = Highcharts.chart('latencyChart', {
+            chart: {
+                type: 'column'
+            },
+            title: {
+                text: null
+            },
+            legend: {
+                enabled: false
+            },
+            xAxis: {
+                categories: []
+            },
+            yAxis: {
+                title: {
+                    text: 'transactions'
+                }
+            },
+            series: [{name: 'Transactions', data: []}]
+        });
+
+        function escapeHtml(value) {
+            return String(value).replace(/[&<>"']/g, function (character) {
+                return {'&': '&amp;', '<': '&lt;', '>': '&gt;', '"': '&quot;', "'": '&#39;'}[character];
+            });
+        }
+
+        function formatDuration(seconds) {
+            const days = Math.floor(seconds / 86400);
+            const hours = Math.floor(seconds % 86400 / 3600);
+            const minutes = Math.floor(seconds % 3600 / 60);
+
+            return (days > 0 ? days + 'd ' : '') + hours + 'h ' + minutes + 'm ' + seconds % 60 + 's';
+        }
+
+        function hashLink(hash) {
+            return '<span class="hash" data-hash="' + escapeHtml(hash) + '">' + escapeHtml(hash) + '</span>';
+        }
+
+        function setText(id, value) {
+            document.getElementById(id).textContent = value;
+        }
+
+        function addMetricsSample(sample, redraw) {
+            tpsSeries.forEach(function (series, i) {
+                tpsChart.series[i].addPoint([sample.timestamp, sample[series.key]], false, tpsChart.series[i].data.length >= 3600);
+            });
+            if (redraw) {
+                tpsChart.redraw();
+            }
+
+            setText('nodeTips', sample.tipsCount);
+            setText('nodeSolidTransactions', sample.solidTransactionCount);
+            setText('nodeTps', sample.receivedTps + ' received / ' + sample.solidTps + ' solid');
+        }
+
+        function showLatency(histogram) {
+            const categories = [];
+            const counts = [];
+            let previousCount = 0;
+            histogram.cumulativeCounts.forEach(function (cumulativeCount, i) {
+                categories.push(i < histogram.upperBounds.length ? '≤ ' + histogram.upperBounds[i] + 's' : '> ' + histogram.upperBounds[histogram.upperBounds.length - 1] + 's');
+                counts.push(cumulativeCount - previousCount);
+                previousCount = cumulativeCount;
+            });
+
+            latencyChart.xAxis[0].setCategories(categories, false);
+            latencyChart.series[0].setData(counts);
+        }
+
+        function showNode(node) {
+            setText('nodeVersion', node.version);
+            setText('nodeIdentity', node.identity);
+            setText('nodeUptime', formatDuration(node.uptime));
+
+            document.getElementById('plugins').innerHTML = node.plugins.map(function (plugin) {
+                return '<tr><td>' + escapeHtml(plugin.name) + '</td><td class="' + (plugin.enabled ? 'ok">enabled' : 'failed">disabled') + '</td></tr>';
+            }).join('');
+        }
+
+        function showNeighbors(neighbors) {
+            document.getElementById('neighbors').innerHTML = neighbors.map(function (neighbor) {
+                return '<tr>' +
+                    '<td class="mono">' + escapeHtml(neighbor.identity) + '</td>' +
+                    '<td class="mono">' + escapeHtml(neighbor.address) + '</td>' +
+                    '<td>' + (neighbor.static ? 'static' : 'auto peering') + '</td>' +
+                    '<td class="' + (neighbor.connected ? 'ok">connected' : 'failed">disconnected') + '</td>' +
+                    '<td>' + neighbor.receivedTransactions + '</td>' +
+                    '<td>' + neighbor.sentTransactions + '</td>' +
+                    '<td>' + neighbor.droppedTransactions + '</td>' +
+                    '<td>' + neighbor.queuedTransactions + '</td>' +
+                    '</tr>';
+            }).join('');
+        }
+
+        function showPeers(id, peers) {
+            document.getElementById(id).innerHTML = peers.map(function (peer) {
+                return '<tr><td class="mono">' + escapeHtml(peer.identity) + '</td><td class="mono">' + escapeHtml(peer.address) + '</td></tr>';
+            }).join('');
+        }
+
+        function showAutopeering(autopeering) {
+            setText('knownPeers', autopeering.knownPeers);
+            setText('neighborhood', autopeering.neighborhood);
+            showPeers('chosenNeighbors', autopeering.chosenNeighbors);
+            showPeers('acceptedNeighbors', autopeering.acceptedNeighbors);
+        }
+
+        function showTransaction(transaction) {
+            document.getElementById('searchResult').innerHTML = '<table>' +
+                '<tr><th>Hash</th><td class="mono">' + escapeHtml(transaction.hash) + '</td></tr>' +
+                '<tr><th>Address</th><td class="mono">' + escapeHtml(transaction.address) + '</td></tr>' +
+                '<tr><th>Value</th><td>' + transaction.value + '</td></tr>' +
+                '<tr><th>Timestamp</th><td>' + new Date(transaction.timestamp * 1000).toLocaleString() + '</td></tr>' +
+                '<tr><th>Received</th><td>' + (transaction.receivedTime ? new Date(transaction.receivedTime * 1000).toLocaleString() : '-') + '</td></tr>' +
+                '<tr><th>Solid</th><td class="' + (transaction.solid ? 'ok">yes' : 'failed">no') + '</td></tr>' +
+                '<tr><th>Weight magnitude</th><td>' + transaction.weightMagnitude + '</td></tr>' +
+                '<tr><th>Trunk</th><td>' + hashLink(transaction.trunkHash) + '</td></tr>' +
+                '<tr><th>Branch</th><td>' + hashLink(transaction.branchHash) + '</td></tr>' +
+                (transaction.bundleHeadHash ? '<tr><th>Bundle head</th><td>' + hashLink(transaction.bundleHeadHash) + '</td></tr>' : '') +
+                '<tr><th>Approvers</th><td>' + (transaction.approvers.length ? transaction.approvers.map(hashLink).join('<br>') : '-') + '</td></tr>' +
+                '</table>';
+        }
+
+        let connection;
+        let requestCounter = 0;
+
+        function searchTransaction(hash) {
+            document.getElementById('searchHash').value = hash;
+            document.getElementById('searchResult').textContent = 'searching ...';
+
+            connection.send(JSON.stringify({type: 'searchTransaction', id: String(++requestCounter), data: {hash: hash}}));
+        }
+
+        document.getElementById('searchForm').addEventListener('submit', function (event) {
+            event.preventDefault();
+
+            searchTransaction(document.getElementById('searchHash').value.trim().toUpperCase());
+        });
+
+        document.getElementById('searchResult').addEventListener('click', function (event) {
+            if (event.target.dataset.hash) {
+                searchTransaction(event.target.dataset.hash);
+            }
+        });
+
+        const handlers = {
+            metrics: function (sample) {
+                addMetricsSample(sample, true);
+            },
+            history: function (samples) {
+                tpsChart.series.forEach(function (series) {
+                    series.setData([], false);
+                });
+                samples.forEach(function (sample) {
+                    addMetricsSample(sample, false);
+                });
+                tpsChart.redraw();
+            },
+            latency: showLatency,
+            node: showNode,
+            neighbors: showNeighbors,
+            autopeering: showAutopeering,
+            transaction: showTransaction,
+            error: function (error) {
+                document.getElementById('searchResult').innerHTML = '<span class="failed">' + escapeHtml(error.message) + '</span>';
+            }
+        };
+
+        function connect() {
+            connection = new WebSocket((location.protocol === 'https:' ? 'wss://' : 'ws://') + location.host + '/ws');
+
+            connection.onopen = function () {
+                document.getElementById('connection').className = 'ok';
+                setText('connection', '(connected)');
+            };
+
+            connection.onclose = function () {
+                document.getElementById('connection').className = 'failed';
+                setText('connection', '(disconnected)');
+
+                setTimeout(connect, 2000);
+            };
+
+            connection.onmessage = function (event) {
+                const msg = JSON.parse(event.data);
+                if (handlers[msg.type]) {
+                    handlers[msg.type](msg.data);
+                }
+            };
+        }
+
+        connect();
+    </script>
+</body>
+
+</html>
+`
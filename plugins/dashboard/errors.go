@@ -0,0 +1,9 @@
+package dashboard
+
+import "github.com/iotaledger/goshimmer/packages/errors"
+
+var (
+	ErrInvalidRequest      = errors.New("invalid request")
+	ErrUnknownRequestType  = errors.New("unknown request type")
+	ErrTransactionNotFound = errors.New("transaction not found")
+)
@@ -0,0 +1,73 @@
+package dashboard
+
+import (
+	"encoding/json"
+
+	"github.com/iotaledger/goshimmer/plugins/tangle"
+	"github.com/iotaledger/iota.go/guards"
+	"github.com/iotaledger/iota.go/trinary"
+)
+
+type searchTransactionRequest struct {
+	Hash trinary.Trytes `json:"hash"`
+}
+
+type transactionDetails struct {
+	Hash            trinary.Trytes   `json:"hash"`
+	Address         trinary.Trytes   `json:"address"`
+	Value           int64            `json:"value"`
+	Timestamp       uint             `json:"timestamp"`
+	TrunkHash       trinary.Trytes   `json:"trunkHash"`
+	BranchHash      trinary.Trytes   `json:"branchHash"`
+	WeightMagnitude int              `json:"weightMagnitude"`
+	Solid           bool             `json:"solid"`
+	ReceivedTime    int64            `json:"receivedTime"`
+	BundleHeadHash  trinary.Trytes   `json:"bundleHeadHash,omitempty"`
+	Approvers       []trinary.Trytes `json:"approvers"`
+}
+
+// Looks up the requested transaction (including its metadata and approvers) in the tangle.
+func searchTransaction(requestData json.RawMessage) (*transactionDetails, error) {
+	var searchRequest searchTransactionRequest
+	if err := json.Unmarshal(requestData, &searchRequest); err != nil {
+		return nil, ErrInvalidRequest.Derive("failed to parse the search request: " + err.Error())
+	}
+
+	if !guards.IsTransactionHash(searchRequest.Hash) {
+		return nil, ErrInvalidRequest.Derive("invalid transaction hash")
+	}
+
+	transaction, err := tangle.GetTransaction(searchRequest.Hash)
+	if err != nil {
+		return nil, err
+	} else if transaction == nil {
+		return nil, ErrTransactionNotFound.Derive("transaction " + searchRequest.Hash + " is not known")
+	}
+
+	details := &transactionDetails{
+		Hash:            transaction.GetHash(),
+		Address:         transaction.GetAddress(),
+		Value:           transaction.GetValue(),
+		Timestamp:       transaction.GetTimestamp(),
+		TrunkHash:       transaction.GetTrunkTransactionHash(),
+		BranchHash:      transaction.GetBranchTransactionHash(),
+		WeightMagnitude: transaction.GetWeightMagnitude(),
+		Approvers:       make([]trinary.Trytes, 0),
+	}
+
+	if txMetadata, err := tangle.GetTransactionMetadata(searchRequest.Hash); err != nil {
+		return nil, err
+	} else if txMetadata != nil {
+		details.Solid = txMetadata.GetSolid()
+		details.ReceivedTime = txMetadata.GetReceivedTime().Unix()
+		details.BundleHeadHash = txMetadata.GetBundleHeadHash()
+	}
+
+	if approvers, err := tangle.GetApprovers(searchRequest.Hash); err != nil {
+		return nil, err
+	} else if approvers != nil {
+		details.Approvers = approvers.GetHashes()
+	}
+
+	return details, nil
+}
@@ -0,0 +1,31 @@
+package dashboard
+
+import (
+	"sync"
+)
+
+// Keeps the most recent metrics samples, so newly connected clients can draw the charts of the last hour right away.
+type metricsHistory struct {
+	samples []*metricsSample
+	mutex   sync.RWMutex
+}
+
+func (history *metricsHistory) add(sample *metricsSample) {
+	history.mutex.Lock()
+	defer history.mutex.Unlock()
+
+	history.samples = append(history.samples, sample)
+	if len(history.samples) > MAX_HISTORY_SIZE {
+		history.samples = history.samples[len(history.samples)-MAX_HISTORY_SIZE:]
+	}
+}
+
+func (history *metricsHistory) get() []*metricsSample {
+	history.mutex.RLock()
+	defer history.mutex.RUnlock()
+
+	return append([]*metricsSample{}, history.samples...)
+}
+
+// amount of samples (one per second) that are kept in the history
+const MAX_HISTORY_SIZE = 3600
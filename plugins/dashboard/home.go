@@ -0,0 +1,20 @@
+package dashboard
+
+import (
+	"net/http"
+)
+
+// Serves the single page of the dashboard (all data is loaded through the websocket).
+func serveHome(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/dashboard" {
+		http.Error(w, "Not found", http.StatusNotFound)
+		return
+	}
+	if r.Method != "GET" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write([]byte(dashboardTemplate))
+}
@@ -0,0 +1,135 @@
+package dashboard
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// region client ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// A connected websocket client - all messages are written by a single goroutine, so slow clients do not block the
+// node (messages are dropped if the client can not keep up).
+type client struct {
+	conn      *websocket.Conn
+	sendQueue chan *message
+	closeChan chan struct{}
+	closeOnce sync.Once
+}
+
+func newClient(conn *websocket.Conn) *client {
+	return &client{
+		conn:      conn,
+		sendQueue: make(chan *message, CLIENT_SEND_QUEUE_SIZE),
+		closeChan: make(chan struct{}),
+	}
+}
+
+// Queues the message for the client and returns false if it had to be dropped.
+func (client *client) send(msg *message) bool {
+	select {
+	case <-client.closeChan:
+		return false
+
+	case client.sendQueue <- msg:
+		return true
+
+	default:
+		return false
+	}
+}
+
+func (client *client) close() {
+	client.closeOnce.Do(func() {
+		close(client.closeChan)
+
+		_ = client.conn.Close()
+	})
+}
+
+// writes the queued messages until the client is closed
+func (client *client) writeMessages() {
+	for {
+		select {
+		case <-client.closeChan:
+			return
+
+		case msg := <-client.sendQueue:
+			_ = client.conn.SetWriteDeadline(time.Now().Add(CLIENT_WRITE_TIMEOUT))
+
+			if err := client.conn.WriteJSON(msg); err != nil {
+				client.close()
+
+				return
+			}
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region hub //////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Keeps track of the connected clients and broadcasts messages to them.
+type hub struct {
+	clients      map[*client]bool
+	clientsMutex sync.RWMutex
+}
+
+func newHub() *hub {
+	return &hub{
+		clients: make(map[*client]bool),
+	}
+}
+
+func (hub *hub) register(client *client) {
+	hub.clientsMutex.Lock()
+	hub.clients[client] = true
+	hub.clientsMutex.Unlock()
+}
+
+func (hub *hub) unregister(client *client) {
+	hub.clientsMutex.Lock()
+	delete(hub.clients, client)
+	hub.clientsMutex.Unlock()
+
+	client.close()
+}
+
+func (hub *hub) hasClients() bool {
+	hub.clientsMutex.RLock()
+	defer hub.clientsMutex.RUnlock()
+
+	return len(hub.clients) != 0
+}
+
+func (hub *hub) broadcast(msg *message) {
+	hub.clientsMutex.RLock()
+	for client := range hub.clients {
+		client.send(msg)
+	}
+	hub.clientsMutex.RUnlock()
+}
+
+// Disconnects all clients (the http server does not close hijacked websocket connections when it shuts down).
+func (hub *hub) closeAll() {
+	hub.clientsMutex.Lock()
+	for client := range hub.clients {
+		client.close()
+
+		delete(hub.clients, client)
+	}
+	hub.clientsMutex.Unlock()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region constants and variables //////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	CLIENT_SEND_QUEUE_SIZE = 100
+	CLIENT_WRITE_TIMEOUT   = 5 * time.Second
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,208 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"net"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/accountability"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/parameter"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/acceptedneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/neighborhood"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerlist"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/types/peerregister"
+	"github.com/iotaledger/goshimmer/plugins/gossip"
+	"github.com/iotaledger/goshimmer/plugins/metrics"
+	"github.com/iotaledger/goshimmer/plugins/tipselection"
+)
+
+// region protocol /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// The envelope of all messages that are exchanged over the websocket (in both directions). Replies to requests of the
+// client carry the id of the request.
+type message struct {
+	Type string      `json:"type"`
+	Id   string      `json:"id,omitempty"`
+	Data interface{} `json:"data,omitempty"`
+}
+
+// The raw version of a message that was received from a client (the data is decoded depending on the type).
+type request struct {
+	Type string          `json:"type"`
+	Id   string          `json:"id"`
+	Data json.RawMessage `json:"data"`
+}
+
+const (
+	// server -> client
+	MSG_TYPE_METRICS     = "metrics"
+	MSG_TYPE_HISTORY     = "history"
+	MSG_TYPE_LATENCY     = "latency"
+	MSG_TYPE_NODE        = "node"
+	MSG_TYPE_NEIGHBORS   = "neighbors"
+	MSG_TYPE_AUTOPEERING = "autopeering"
+	MSG_TYPE_TRANSACTION = "transaction"
+	MSG_TYPE_ERROR       = "error"
+
+	// client -> server
+	MSG_TYPE_SEARCH_TRANSACTION = "searchTransaction"
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region metrics //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type metricsSample struct {
+	Timestamp             int64  `json:"timestamp"`
+	ReceivedTPS           uint64 `json:"receivedTps"`
+	SolidTPS              uint64 `json:"solidTps"`
+	DuplicateTPS          uint64 `json:"duplicateTps"`
+	BundlesPerSecond      uint64 `json:"bundlesPerSecond"`
+	SolidTransactionCount uint64 `json:"solidTransactionCount"`
+	TipsCount             int    `json:"tipsCount"`
+}
+
+func newMetricsSample(receivedTPS uint64) *metricsSample {
+	return &metricsSample{
+		Timestamp:             time.Now().UnixNano() / int64(time.Millisecond),
+		ReceivedTPS:           receivedTPS,
+		SolidTPS:              metrics.GetSolidTPS(),
+		DuplicateTPS:          metrics.GetReceivedDuplicateTPS(),
+		BundlesPerSecond:      metrics.GetBundlesPerSecond(),
+		SolidTransactionCount: metrics.GetSolidTransactionCount(),
+		TipsCount:             tipselection.GetTipsCount(),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region node status //////////////////////////////////////////////////////////////////////////////////////////////////
+
+type nodeStatus struct {
+	Version  string         `json:"version"`
+	Identity string         `json:"identity"`
+	Uptime   int64          `json:"uptime"`
+	Plugins  []pluginStatus `json:"plugins"`
+}
+
+type pluginStatus struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+}
+
+func getNodeStatus() *nodeStatus {
+	loadedPlugins := make(map[string]bool)
+	for _, pluginName := range currentNode.GetLoadedPlugins() {
+		loadedPlugins[pluginName] = true
+	}
+
+	plugins := make([]pluginStatus, 0, len(parameter.GetPlugins()))
+	for pluginName := range parameter.GetPlugins() {
+		plugins = append(plugins, pluginStatus{
+			Name:    pluginName,
+			Enabled: loadedPlugins[pluginName],
+		})
+	}
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Name < plugins[j].Name
+	})
+
+	return &nodeStatus{
+		Version:  node.VERSION,
+		Identity: accountability.OwnId().StringIdentifier,
+		Uptime:   int64(currentNode.GetUptime() / time.Second),
+		Plugins:  plugins,
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region neighbors ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+type neighborStatus struct {
+	Identity             string `json:"identity"`
+	Address              string `json:"address"`
+	Static               bool   `json:"static"`
+	Connected            bool   `json:"connected"`
+	ReceivedTransactions uint64 `json:"receivedTransactions"`
+	SentTransactions     uint64 `json:"sentTransactions"`
+	DroppedTransactions  uint64 `json:"droppedTransactions"`
+	QueuedTransactions   int    `json:"queuedTransactions"`
+}
+
+func getNeighborStatus() []*neighborStatus {
+	result := make([]*neighborStatus, 0)
+	for _, neighbor := range gossip.GetNeighbors() {
+		sendQueueStatistics := neighbor.GetSendQueueStatistics()
+
+		result = append(result, &neighborStatus{
+			Identity:             neighbor.Identity.StringIdentifier,
+			Address:              net.JoinHostPort(neighbor.Address.String(), strconv.Itoa(int(neighbor.Port))),
+			Static:               gossip.IsStaticNeighbor(neighbor.Identity.StringIdentifier),
+			Connected:            neighbor.IsConnected(),
+			ReceivedTransactions: neighbor.GetReceivedTransactionCount(),
+			SentTransactions:     sendQueueStatistics.SentTransactions,
+			DroppedTransactions:  sendQueueStatistics.DroppedTransactions + sendQueueStatistics.DroppedPriorityTransactions,
+			QueuedTransactions:   sendQueueStatistics.QueuedTransactions + sendQueueStatistics.QueuedPriorityTransactions,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Identity < result[j].Identity
+	})
+
+	return result
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region autopeering //////////////////////////////////////////////////////////////////////////////////////////////////
+
+type autopeeringStatus struct {
+	KnownPeers        int           `json:"knownPeers"`
+	Neighborhood      int           `json:"neighborhood"`
+	ChosenNeighbors   []*peerStatus `json:"chosenNeighbors"`
+	AcceptedNeighbors []*peerStatus `json:"acceptedNeighbors"`
+}
+
+type peerStatus struct {
+	Identity string `json:"identity"`
+	Address  string `json:"address"`
+}
+
+func getAutopeeringStatus() *autopeeringStatus {
+	return &autopeeringStatus{
+		KnownPeers:        len(listPeers(knownpeers.INSTANCE)),
+		Neighborhood:      len(listPeers(neighborhood.INSTANCE)),
+		ChosenNeighbors:   getPeerStatus(listPeers(chosenneighbors.INSTANCE)),
+		AcceptedNeighbors: getPeerStatus(listPeers(acceptedneighbors.INSTANCE)),
+	}
+}
+
+// copies the peers of the register while it is locked, so they can be read while the auto peering modifies it
+func listPeers(register *peerregister.PeerRegister) peerlist.PeerList {
+	defer register.Lock()()
+
+	return register.List()
+}
+
+func getPeerStatus(peers peerlist.PeerList) []*peerStatus {
+	result := make([]*peerStatus, len(peers))
+	for i, p := range peers {
+		result[i] = &peerStatus{
+			Identity: p.Identity.StringIdentifier,
+			Address:  net.JoinHostPort(p.Address.String(), strconv.Itoa(int(p.GossipPort))),
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Identity < result[j].Identity
+	})
+
+	return result
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
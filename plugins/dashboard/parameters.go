@@ -0,0 +1,13 @@
+package dashboard
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/parameter"
+)
+
+var (
+	ADDRESS         = parameter.AddString("DASHBOARD/ADDRESS", "", "address to bind the dashboard to (empty = all interfaces)")
+	PORT            = parameter.AddInt("DASHBOARD/PORT", 8081, "tcp port that the dashboard and its websocket are served on")
+	STATUS_INTERVAL = parameter.AddDuration("DASHBOARD/STATUS_INTERVAL", 2*time.Second, "interval in which the node, neighbor and autopeering status is pushed to the connected clients").SetRange(100*time.Millisecond, time.Minute)
+)
@@ -1,52 +1,92 @@
 package dashboard
 
 import (
+	"context"
+	"net"
 	"net/http"
+	"strconv"
 	"time"
 
-	"golang.org/x/net/context"
-
 	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/prometheus"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/timeutil"
+	"github.com/iotaledger/goshimmer/plugins/gossip"
 	"github.com/iotaledger/goshimmer/plugins/metrics"
+	"github.com/iotaledger/goshimmer/plugins/tangle"
+	"github.com/iotaledger/goshimmer/plugins/tipselection"
 )
 
+var PLUGIN = node.NewPlugin("Dashboard", node.Disabled, configure, run).DependsOn(metrics.PLUGIN, gossip.PLUGIN, tangle.PLUGIN, tipselection.PLUGIN)
+
 var server *http.Server
 
-var router *http.ServeMux
+var clients = newHub()
+
+var history = &metricsHistory{}
 
-var PLUGIN = node.NewPlugin("Dashboard", node.Disabled, configure, run)
+var currentNode *node.Node
 
 func configure(plugin *node.Plugin) {
-	router = http.NewServeMux()
-	server = &http.Server{Addr: ":8081", Handler: router}
+	currentNode = plugin.Node
 
-	router.HandleFunc("/dashboard", ServeHome)
-	router.HandleFunc("/ws", ServeWs)
+	router := http.NewServeMux()
+	router.HandleFunc("/dashboard", serveHome)
+	router.HandleFunc("/ws", serveWebsocket(plugin))
 
-	// send the sampledTPS to client via websocket, use uint32 to save mem
-	metrics.Events.ReceivedTPSUpdated.Attach(events.NewClosure(func(sampledTPS uint64) {
-		TPSQ = append(TPSQ, uint32(sampledTPS))
-		if len(TPSQ) > MAX_Q_SIZE {
-			TPSQ = TPSQ[1:]
-		}
-	}))
+	server = &http.Server{
+		Addr:    net.JoinHostPort(*ADDRESS.Value, strconv.Itoa(*PORT.Value)),
+		Handler: router,
+	}
 
-	daemon.Events.Shutdown.Attach(events.NewClosure(func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 0*time.Second)
-		defer cancel()
+	// record and publish a sample of the metrics whenever the received TPS got measured (once per second)
+	metrics.Events.ReceivedTPSUpdated.Attach(events.NewClosure(func(receivedTPS uint64) {
+		sample := newMetricsSample(receivedTPS)
 
-		_ = server.Shutdown(ctx)
+		history.add(sample)
+		clients.broadcast(&message{Type: MSG_TYPE_METRICS, Data: sample})
+	}))
+
+	metrics.Events.SolidificationLatencyUpdated.Attach(events.NewClosure(func(latency prometheus.HistogramSnapshot) {
+		clients.broadcast(&message{Type: MSG_TYPE_LATENCY, Data: latency})
 	}))
 }
 
 func run(plugin *node.Plugin) {
-	daemon.BackgroundWorker("Dashboard Updater", func() {
+	plugin.LogInfo("Starting Dashboard ...")
+
+	daemon.BackgroundWorker("Dashboard Server", func(shutdownSignal <-chan struct{}) {
+		plugin.Log.Success("Starting Dashboard ... done", "address", server.Addr)
+
 		go func() {
-			if err := server.ListenAndServe(); err != nil {
-				plugin.LogFailure(err.Error())
+			<-shutdownSignal
+
+			plugin.LogInfo("Stopping Dashboard ...")
+
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer cancel()
+
+			if err := server.Shutdown(ctx); err != nil {
+				plugin.Log.Failure("failed to stop the dashboard", "error", err)
 			}
+
+			clients.closeAll()
 		}()
-	})
+
+		if err := server.ListenAndServe(); err != http.ErrServerClosed {
+			plugin.Log.Failure("failed to start the dashboard", "error", err)
+		}
+
+		plugin.LogSuccess("Stopping Dashboard ... done")
+	}, shutdown.PRIORITY_DASHBOARD)
+
+	daemon.BackgroundWorker("Dashboard Status Updater", func(shutdownSignal <-chan struct{}) {
+		timeutil.Ticker(func() {
+			if clients.hasClients() {
+				sendStatus(clients.broadcast)
+			}
+		}, *STATUS_INTERVAL.Value, shutdownSignal)
+	}, shutdown.PRIORITY_DASHBOARD)
 }
@@ -0,0 +1,89 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/metrics"
+)
+
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  1024,
+	WriteBufferSize: 1024,
+}
+
+// Upgrades the connection to a websocket, sends the current state of the node and then answers the requests of the
+// client until it disconnects (the periodic updates are broadcasted by the hub).
+func serveWebsocket(plugin *node.Plugin) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			plugin.Log.Debug("failed to upgrade the websocket connection", "error", err)
+
+			return
+		}
+
+		client := newClient(conn)
+		go client.writeMessages()
+
+		client.send(&message{Type: MSG_TYPE_HISTORY, Data: history.get()})
+		client.send(&message{Type: MSG_TYPE_LATENCY, Data: metrics.GetSolidificationLatency().Snapshot()})
+		sendStatus(func(msg *message) { client.send(msg) })
+
+		clients.register(client)
+		defer clients.unregister(client)
+
+		for {
+			var clientRequest request
+			if err := conn.ReadJSON(&clientRequest); err != nil {
+				// only malformed messages are answered - all other errors mean that the connection is broken
+				switch err.(type) {
+				case *json.SyntaxError, *json.UnmarshalTypeError:
+				default:
+					return
+				}
+
+				client.send(&message{Type: MSG_TYPE_ERROR, Data: errorDetails{Message: ErrInvalidRequest.Derive(err.Error()).Error()}})
+
+				continue
+			}
+
+			client.send(handleRequest(&clientRequest))
+		}
+	}
+}
+
+// Answers a single request of a client.
+func handleRequest(clientRequest *request) *message {
+	var responseType string
+	var result interface{}
+	var err error
+
+	switch clientRequest.Type {
+	case MSG_TYPE_SEARCH_TRANSACTION:
+		responseType = MSG_TYPE_TRANSACTION
+		result, err = searchTransaction(clientRequest.Data)
+
+	default:
+		err = ErrUnknownRequestType.Derive("\"" + clientRequest.Type + "\" is not supported")
+	}
+
+	if err != nil {
+		return &message{Type: MSG_TYPE_ERROR, Id: clientRequest.Id, Data: errorDetails{Message: err.Error()}}
+	}
+
+	return &message{Type: responseType, Id: clientRequest.Id, Data: result}
+}
+
+// Sends the node, neighbor and auto peering status using the given function (a single client or all of them).
+func sendStatus(send func(*message)) {
+	send(&message{Type: MSG_TYPE_NODE, Data: getNodeStatus()})
+	send(&message{Type: MSG_TYPE_NEIGHBORS, Data: getNeighborStatus()})
+	send(&message{Type: MSG_TYPE_AUTOPEERING, Data: getAutopeeringStatus()})
+}
+
+type errorDetails struct {
+	Message string `json:"message"`
+}
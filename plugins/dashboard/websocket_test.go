@@ -0,0 +1,35 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestHandleRequest(t *testing.T) {
+	response := handleRequest(&request{Type: "unknown", Id: "1"})
+	if response.Type != MSG_TYPE_ERROR || response.Id != "1" {
+		t.Error("unknown requests should be answered with an error", response)
+	}
+
+	response = handleRequest(&request{Type: MSG_TYPE_SEARCH_TRANSACTION, Id: "2", Data: json.RawMessage(`{"hash": "ABC"}`)})
+	if response.Type != MSG_TYPE_ERROR || response.Id != "2" {
+		t.Error("searches for invalid hashes should be answered with an error", response)
+	}
+
+	response = handleRequest(&request{Type: MSG_TYPE_SEARCH_TRANSACTION, Id: "3", Data: json.RawMessage(`"ABC"`)})
+	if response.Type != MSG_TYPE_ERROR || response.Id != "3" {
+		t.Error("malformed search requests should be answered with an error", response)
+	}
+}
+
+func TestMetricsHistory(t *testing.T) {
+	history := &metricsHistory{}
+	for i := 0; i < MAX_HISTORY_SIZE+10; i++ {
+		history.add(&metricsSample{Timestamp: int64(i)})
+	}
+
+	samples := history.get()
+	if len(samples) != MAX_HISTORY_SIZE || samples[0].Timestamp != 10 || samples[MAX_HISTORY_SIZE-1].Timestamp != MAX_HISTORY_SIZE+9 {
+		t.Error("the history should keep the most recent samples only")
+	}
+}
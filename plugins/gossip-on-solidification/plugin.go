@@ -10,6 +10,11 @@ import (
 
 var PLUGIN = node.NewPlugin("Gossip On Solidification", node.Enabled, func(plugin *node.Plugin) {
 	tangle.Events.TransactionSolid.Attach(events.NewClosure(func(tx *value_transaction.ValueTransaction) {
-		gossip.SendTransaction(tx.MetaTransaction)
+		// our own transactions overtake the relayed ones, so they are not dropped when the send queues are full
+		if gossip.IsIssuedTransaction(tx.GetHash()) {
+			gossip.SendPriorityTransaction(tx.MetaTransaction)
+		} else {
+			gossip.SendTransaction(tx.MetaTransaction)
+		}
 	}))
-})
+}).DependsOn(gossip.PLUGIN, tangle.PLUGIN)
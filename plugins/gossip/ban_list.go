@@ -0,0 +1,172 @@
+package gossip
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger"
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/settings"
+)
+
+// region plugin module setup //////////////////////////////////////////////////////////////////////////////////////////
+
+func configureBanList(plugin *node.Plugin) {
+	if err := loadBanList(); err != nil {
+		plugin.Log.Failure("failed to load the list of banned neighbors", "error", err)
+	}
+
+	Events.BanNeighbor.Attach(events.NewClosure(func(identifier string, bannedUntil time.Time) {
+		plugin.Log.Warning("neighbor banned", "identifier", identifier, "until", bannedUntil.Format(time.RFC3339))
+	}))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region public api ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Bans the identity for the given duration, drops its connections and persists the updated ban list. Static neighbors
+// are trusted and never get banned automatically.
+func BanNeighbor(identifier string, duration time.Duration) {
+	if IsStaticNeighbor(identifier) {
+		return
+	}
+
+	bannedUntil := time.Now().Add(duration)
+
+	banListMutex.Lock()
+	bannedNeighbors[identifier] = bannedUntil
+	banListMutex.Unlock()
+
+	if err := storeBanList(); err != nil {
+		Events.Error.Trigger(ErrBanListPersistenceFailed.Derive(err, "failed to persist ban of "+identifier))
+	}
+
+	Events.BanNeighbor.Trigger(identifier, bannedUntil)
+
+	if neighbor, exists := GetNeighbor(identifier); exists {
+		RemoveNeighbor(identifier)
+
+		neighbor.Disconnect()
+	}
+}
+
+func UnbanNeighbor(identifier string) {
+	banListMutex.Lock()
+	delete(bannedNeighbors, identifier)
+	banListMutex.Unlock()
+
+	if err := storeBanList(); err != nil {
+		Events.Error.Trigger(ErrBanListPersistenceFailed.Derive(err, "failed to persist unban of "+identifier))
+	}
+}
+
+func IsBanned(identifier string) bool {
+	banListMutex.RLock()
+	defer banListMutex.RUnlock()
+
+	bannedUntil, exists := bannedNeighbors[identifier]
+
+	return exists && time.Now().Before(bannedUntil)
+}
+
+// Returns the banned identities and the time when their ban expires.
+func GetBannedNeighbors() map[string]time.Time {
+	banListMutex.RLock()
+	defer banListMutex.RUnlock()
+
+	now := time.Now()
+
+	result := make(map[string]time.Time)
+	for identifier, bannedUntil := range bannedNeighbors {
+		if now.Before(bannedUntil) {
+			result[identifier] = bannedUntil
+		}
+	}
+
+	return result
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region utility methods //////////////////////////////////////////////////////////////////////////////////////////////
+
+func loadBanList() error {
+	marshaledBanList, err := settings.Get(BAN_LIST_SETTINGS_KEY)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil
+		}
+
+		return err
+	}
+
+	if len(marshaledBanList)%MARSHALED_BAN_LIST_ENTRY_SIZE != 0 {
+		return ErrBanListPersistenceFailed.Derive(ErrInvalidBanList, "invalid size of the stored ban list ("+strconv.Itoa(len(marshaledBanList))+" bytes)")
+	}
+
+	now := time.Now()
+
+	banListMutex.Lock()
+	defer banListMutex.Unlock()
+
+	for offset := 0; offset < len(marshaledBanList); offset += MARSHALED_BAN_LIST_ENTRY_SIZE {
+		entry := marshaledBanList[offset : offset+MARSHALED_BAN_LIST_ENTRY_SIZE]
+
+		bannedUntil := time.Unix(int64(binary.BigEndian.Uint64(entry[MARSHALED_IDENTITY_SIZE:])), 0)
+		if now.Before(bannedUntil) {
+			bannedNeighbors[hex.EncodeToString(entry[:MARSHALED_IDENTITY_SIZE])] = bannedUntil
+		}
+	}
+
+	return nil
+}
+
+// stores the list of active bans (expired bans are removed) as a sequence of identifier / expiry time pairs
+func storeBanList() error {
+	now := time.Now()
+
+	banListMutex.Lock()
+	marshaledBanList := make([]byte, 0, len(bannedNeighbors)*MARSHALED_BAN_LIST_ENTRY_SIZE)
+	for identifier, bannedUntil := range bannedNeighbors {
+		if !now.Before(bannedUntil) {
+			delete(bannedNeighbors, identifier)
+
+			continue
+		}
+
+		decodedIdentifier, err := hex.DecodeString(identifier)
+		if err != nil || len(decodedIdentifier) != MARSHALED_IDENTITY_SIZE {
+			continue
+		}
+
+		marshaledExpiry := make([]byte, 8)
+		binary.BigEndian.PutUint64(marshaledExpiry, uint64(bannedUntil.Unix()))
+
+		marshaledBanList = append(marshaledBanList, decodedIdentifier...)
+		marshaledBanList = append(marshaledBanList, marshaledExpiry...)
+	}
+	banListMutex.Unlock()
+
+	return settings.Set(BAN_LIST_SETTINGS_KEY, marshaledBanList)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region constants and variables //////////////////////////////////////////////////////////////////////////////////////
+
+var bannedNeighbors = make(map[string]time.Time)
+
+var banListMutex sync.RWMutex
+
+var BAN_LIST_SETTINGS_KEY = []byte("GOSSIP_BANNED_NEIGHBORS")
+
+const (
+	MARSHALED_BAN_LIST_ENTRY_SIZE = MARSHALED_IDENTITY_SIZE + 8
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,49 @@
+package gossip
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+)
+
+func TestBanList(t *testing.T) {
+	// the ban list is persisted in the settings, so we use an empty database
+	directory, err := ioutil.TempDir("", "gossip")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+	database.DIRECTORY.SetValue(directory)
+
+	identifier := "2f7a876a4236091257e650da8dcf195fbe3cb625"
+
+	BanNeighbor(identifier, time.Hour)
+	if !IsBanned(identifier) {
+		t.Fatal("neighbor was not banned")
+	}
+
+	// the ban survives a restart
+	banListMutex.Lock()
+	bannedNeighbors = make(map[string]time.Time)
+	banListMutex.Unlock()
+
+	if err := loadBanList(); err != nil {
+		t.Fatal(err)
+	}
+	if !IsBanned(identifier) {
+		t.Error("ban was not persisted")
+	}
+
+	UnbanNeighbor(identifier)
+	if IsBanned(identifier) {
+		t.Error("neighbor is still banned")
+	}
+
+	BanNeighbor(identifier, -time.Second)
+	if IsBanned(identifier) {
+		t.Error("expired ban is still active")
+	}
+}
@@ -9,4 +9,9 @@ var (
 	ErrInvalidStateTransition       = errors.New("protocol error: invalid state transition message")
 	ErrSendFailed                   = errors.Wrap(errors.New("protocol error"), "failed to send message")
 	ErrInvalidSendParam             = errors.New("invalid parameter passed to send")
+	ErrInvalidNeighborDefinition    = errors.New("invalid neighbor definition")
+	ErrRateLimitExceeded            = errors.New("protocol error: rate limit exceeded")
+	ErrInvalidTransaction           = errors.New("protocol error: invalid transaction data")
+	ErrBanListPersistenceFailed     = errors.Wrap(errors.New("database error"), "failed to persist the ban list")
+	ErrInvalidBanList               = errors.New("invalid ban list")
 )
@@ -1,6 +1,8 @@
 package gossip
 
 import (
+	"time"
+
 	"github.com/iotaledger/goshimmer/packages/errors"
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/identity"
@@ -24,6 +26,7 @@ var Events = pluginEvents{
 	ReceiveTransaction:        events.NewEvent(transactionCaller),
 	ReceiveTransactionRequest: events.NewEvent(transactionCaller), // TODO
 	ProtocolError:             events.NewEvent(transactionCaller), // TODO
+	BanNeighbor:               events.NewEvent(banCaller),
 
 	// generic events
 	Error: events.NewEvent(errorCaller),
@@ -45,6 +48,7 @@ type pluginEvents struct {
 	ReceiveTransaction        *events.Event
 	ReceiveTransactionRequest *events.Event
 	ProtocolError             *events.Event
+	BanNeighbor               *events.Event
 
 	// generic events
 	Error *events.Event
@@ -92,6 +96,10 @@ func dataCaller(handler interface{}, params ...interface{}) {
 	handler.(func([]byte))(params[0].([]byte))
 }
 
+func banCaller(handler interface{}, params ...interface{}) {
+	handler.(func(string, time.Time))(params[0].(string), params[1].(time.Time))
+}
+
 func transactionCaller(handler interface{}, params ...interface{}) {
 	handler.(func(*meta_transaction.MetaTransaction))(params[0].(*meta_transaction.MetaTransaction))
 }
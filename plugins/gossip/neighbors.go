@@ -5,6 +5,7 @@ import (
 	"net"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/iotaledger/goshimmer/packages/accountability"
@@ -14,20 +15,40 @@ import (
 	"github.com/iotaledger/goshimmer/packages/identity"
 	"github.com/iotaledger/goshimmer/packages/network"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/ratelimiter"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 )
 
 func configureNeighbors(plugin *node.Plugin) {
 	Events.AddNeighbor.Attach(events.NewClosure(func(neighbor *Neighbor) {
-		plugin.LogSuccess("new neighbor added " + neighbor.Identity.StringIdentifier + "@" + neighbor.Address.String() + ":" + strconv.Itoa(int(neighbor.Port)))
+		plugin.Log.Success("new neighbor added", "neighbor", neighbor)
 	}))
 
 	Events.UpdateNeighbor.Attach(events.NewClosure(func(neighbor *Neighbor) {
-		plugin.LogSuccess("existing neighbor updated " + neighbor.Identity.StringIdentifier + "@" + neighbor.Address.String() + ":" + strconv.Itoa(int(neighbor.Port)))
+		plugin.Log.Success("existing neighbor updated", "neighbor", neighbor)
 	}))
 
 	Events.RemoveNeighbor.Attach(events.NewClosure(func(neighbor *Neighbor) {
-		plugin.LogSuccess("existing neighbor removed " + neighbor.Identity.StringIdentifier + "@" + neighbor.Address.String() + ":" + strconv.Itoa(int(neighbor.Port)))
+		plugin.Log.Success("existing neighbor removed", "neighbor", neighbor)
 	}))
+
+	TRANSACTION_RATE_LIMIT.Events.Change.Attach(events.NewClosure(func(oldValue int, newValue int) {
+		for _, neighbor := range GetNeighbors() {
+			neighbor.transactionRateLimiter.SetLimit(float64(newValue), newValue)
+		}
+
+		plugin.Log.Info("transaction rate limit changed", "transactionsPerSecond", newValue)
+	}))
+
+	REQUEST_RATE_LIMIT.Events.Change.Attach(events.NewClosure(func(oldValue int, newValue int) {
+		for _, neighbor := range GetNeighbors() {
+			neighbor.requestRateLimiter.SetLimit(float64(newValue), newValue)
+		}
+
+		plugin.Log.Info("request rate limit changed", "requestsPerSecond", newValue)
+	}))
+
+	configureStaticNeighbors(plugin)
 }
 
 func runNeighbors(plugin *node.Plugin) {
@@ -47,24 +68,30 @@ func runNeighbors(plugin *node.Plugin) {
 }
 
 func manageConnection(plugin *node.Plugin, neighbor *Neighbor) {
-	daemon.BackgroundWorker("Connection Manager ("+neighbor.Identity.StringIdentifier+")", func() {
+	daemon.BackgroundWorker("Connection Manager ("+neighbor.Identity.StringIdentifier+")", func(shutdownSignal <-chan struct{}) {
 		failedConnectionAttempts := 0
 
-		for _, exists := GetNeighbor(neighbor.Identity.StringIdentifier); exists && failedConnectionAttempts < CONNECTION_MAX_ATTEMPTS; {
+		for failedConnectionAttempts < CONNECTION_MAX_ATTEMPTS || IsStaticNeighbor(neighbor.Identity.StringIdentifier) {
+			if _, exists := GetNeighbor(neighbor.Identity.StringIdentifier); !exists {
+				return
+			}
+
 			protocol, dialed, err := neighbor.Connect()
 			if err != nil {
 				failedConnectionAttempts++
 
-				plugin.LogFailure("connection attempt [" + strconv.Itoa(int(failedConnectionAttempts)) + "/" + strconv.Itoa(CONNECTION_MAX_ATTEMPTS) + "] " + err.Error())
+				if IsStaticNeighbor(neighbor.Identity.StringIdentifier) {
+					plugin.Log.Failure("connection attempt failed", "attempt", failedConnectionAttempts, "error", err)
+				} else {
+					plugin.Log.Failure("connection attempt failed", "attempt", failedConnectionAttempts, "maxAttempts", CONNECTION_MAX_ATTEMPTS, "error", err)
+				}
 
-				if failedConnectionAttempts <= CONNECTION_MAX_ATTEMPTS {
-					select {
-					case <-daemon.ShutdownSignal:
-						return
+				select {
+				case <-shutdownSignal:
+					return
 
-					case <-time.After(time.Duration(int(math.Pow(2, float64(failedConnectionAttempts-1)))) * CONNECTION_BASE_TIMEOUT):
-						continue
-					}
+				case <-time.After(getReconnectTimeout(failedConnectionAttempts)):
+					continue
 				}
 			}
 
@@ -81,7 +108,7 @@ func manageConnection(plugin *node.Plugin, neighbor *Neighbor) {
 
 			// wait for shutdown or
 			select {
-			case <-daemon.ShutdownSignal:
+			case <-shutdownSignal:
 				return
 
 			case <-disconnectSignal:
@@ -90,28 +117,51 @@ func manageConnection(plugin *node.Plugin, neighbor *Neighbor) {
 		}
 
 		RemoveNeighbor(neighbor.Identity.StringIdentifier)
-	})
+	}, shutdown.PRIORITY_GOSSIP)
+}
+
+// Returns the exponential backoff before the next connection attempt (static neighbors retry forever, so the backoff is
+// capped at the timeout of the last regular attempt).
+func getReconnectTimeout(failedConnectionAttempts int) time.Duration {
+	if failedConnectionAttempts > CONNECTION_MAX_ATTEMPTS {
+		failedConnectionAttempts = CONNECTION_MAX_ATTEMPTS
+	}
+
+	return time.Duration(int(math.Pow(2, float64(failedConnectionAttempts-1)))) * CONNECTION_BASE_TIMEOUT
 }
 
 type Neighbor struct {
+	// accessed atomically (it is the first field, so it is 64-bit aligned on 32-bit platforms as well)
+	receivedTransactions uint64
+
 	Identity               *identity.Identity
 	Address                net.IP
+	AlternativeAddresses   []net.IP
 	Port                   uint16
 	InitiatedProtocol      *protocol
 	AcceptedProtocol       *protocol
 	Events                 neighborEvents
+	transactionRateLimiter *ratelimiter.TokenBucket
+	requestRateLimiter     *ratelimiter.TokenBucket
 	initiatedProtocolMutex sync.RWMutex
 	acceptedProtocolMutex  sync.RWMutex
 }
 
-func NewNeighbor(identity *identity.Identity, address net.IP, port uint16) *Neighbor {
+// Creates a new neighbor - the alternative addresses are dialed if the neighbor cannot be reached on its main address.
+func NewNeighbor(identity *identity.Identity, address net.IP, port uint16, alternativeAddresses ...net.IP) *Neighbor {
+	transactionRateLimit := TRANSACTION_RATE_LIMIT.GetValue()
+	requestRateLimit := REQUEST_RATE_LIMIT.GetValue()
+
 	return &Neighbor{
-		Identity: identity,
-		Address:  address,
-		Port:     port,
+		Identity:             identity,
+		Address:              address,
+		AlternativeAddresses: alternativeAddresses,
+		Port:                 port,
 		Events: neighborEvents{
 			ProtocolConnectionEstablished: events.NewEvent(protocolCaller),
 		},
+		transactionRateLimiter: ratelimiter.NewTokenBucket(float64(transactionRateLimit), transactionRateLimit),
+		requestRateLimiter:     ratelimiter.NewTokenBucket(float64(requestRateLimit), requestRateLimit),
 	}
 }
 
@@ -140,10 +190,9 @@ func (neighbor *Neighbor) Connect() (*protocol, bool, errors.IdentifiableError)
 	}
 
 	// otherwise try to dial
-	conn, err := net.Dial("tcp", neighbor.Address.String()+":"+strconv.Itoa(int(neighbor.Port)))
+	conn, err := neighbor.dial()
 	if err != nil {
-		return nil, false, ErrConnectionFailed.Derive(err, "error when connecting to neighbor "+
-			neighbor.Identity.StringIdentifier+"@"+neighbor.Address.String()+":"+strconv.Itoa(int(neighbor.Port)))
+		return nil, false, ErrConnectionFailed.Derive(err, "error when connecting to neighbor "+neighbor.String())
 	}
 
 	neighbor.InitiatedProtocol = newProtocol(network.NewManagedConnection(conn))
@@ -176,16 +225,99 @@ func (neighbor *Neighbor) Connect() (*protocol, bool, errors.IdentifiableError)
 	return neighbor.InitiatedProtocol, true, nil
 }
 
+// tries to connect to all known addresses of the neighbor and returns the first successful connection
+func (neighbor *Neighbor) dial() (conn net.Conn, err error) {
+	for _, address := range neighbor.GetAddresses() {
+		if conn, err = net.Dial("tcp", net.JoinHostPort(address.String(), strconv.Itoa(int(neighbor.Port)))); err == nil {
+			return
+		}
+	}
+
+	return
+}
+
+// Returns the main address of the neighbor, followed by its alternative addresses.
+func (neighbor *Neighbor) GetAddresses() []net.IP {
+	return append([]net.IP{neighbor.Address}, neighbor.AlternativeAddresses...)
+}
+
+func (neighbor *Neighbor) String() string {
+	return neighbor.Identity.StringIdentifier + "@" + net.JoinHostPort(neighbor.Address.String(), strconv.Itoa(int(neighbor.Port)))
+}
+
+// Closes all connections to the neighbor.
+func (neighbor *Neighbor) Disconnect() {
+	neighbor.initiatedProtocolMutex.RLock()
+	var initiatedProtocolConn *network.ManagedConnection
+	if neighbor.InitiatedProtocol != nil {
+		initiatedProtocolConn = neighbor.InitiatedProtocol.Conn
+	}
+	neighbor.initiatedProtocolMutex.RUnlock()
+
+	neighbor.acceptedProtocolMutex.RLock()
+	var acceptedProtocolConn *network.ManagedConnection
+	if neighbor.AcceptedProtocol != nil {
+		acceptedProtocolConn = neighbor.AcceptedProtocol.Conn
+	}
+	neighbor.acceptedProtocolMutex.RUnlock()
+
+	if initiatedProtocolConn != nil {
+		_ = initiatedProtocolConn.Close()
+	}
+	if acceptedProtocolConn != nil {
+		_ = acceptedProtocolConn.Close()
+	}
+}
+
+// Returns true if at least one of the protocol connections to the neighbor is established.
+func (neighbor *Neighbor) IsConnected() bool {
+	neighbor.initiatedProtocolMutex.RLock()
+	initiated := neighbor.InitiatedProtocol != nil
+	neighbor.initiatedProtocolMutex.RUnlock()
+
+	neighbor.acceptedProtocolMutex.RLock()
+	accepted := neighbor.AcceptedProtocol != nil
+	neighbor.acceptedProtocolMutex.RUnlock()
+
+	return initiated || accepted
+}
+
+// Returns the amount of transactions that were received from the neighbor (including duplicates).
+func (neighbor *Neighbor) GetReceivedTransactionCount() uint64 {
+	return atomic.LoadUint64(&neighbor.receivedTransactions)
+}
+
 func (neighbor *Neighbor) Marshal() []byte {
 	return nil
 }
 
 func (neighbor *Neighbor) Equals(other *Neighbor) bool {
-	return neighbor.Identity.StringIdentifier == other.Identity.StringIdentifier &&
-		neighbor.Port == other.Port && neighbor.Address.String() == other.Address.String()
+	if neighbor.Identity.StringIdentifier != other.Identity.StringIdentifier || neighbor.Port != other.Port ||
+		!neighbor.Address.Equal(other.Address) || len(neighbor.AlternativeAddresses) != len(other.AlternativeAddresses) {
+
+		return false
+	}
+
+	for i, alternativeAddress := range neighbor.AlternativeAddresses {
+		if !alternativeAddress.Equal(other.AlternativeAddresses[i]) {
+			return false
+		}
+	}
+
+	return true
 }
 
+// Adds or updates a neighbor that was discovered by the auto peering - static neighbors keep their configured address
+// and banned identities are ignored.
 func AddNeighbor(newNeighbor *Neighbor) {
+	if IsStaticNeighbor(newNeighbor.Identity.StringIdentifier) || IsBanned(newNeighbor.Identity.StringIdentifier) {
+		return
+	}
+
+	addNeighbor(newNeighbor)
+}
+
+func addNeighbor(newNeighbor *Neighbor) {
 	neighborLock.Lock()
 	defer neighborLock.Unlock()
 
@@ -198,13 +330,24 @@ func AddNeighbor(newNeighbor *Neighbor) {
 			neighbor.Identity = newNeighbor.Identity
 			neighbor.Port = newNeighbor.Port
 			neighbor.Address = newNeighbor.Address
+			neighbor.AlternativeAddresses = newNeighbor.AlternativeAddresses
 
 			Events.UpdateNeighbor.Trigger(neighbor)
 		}
 	}
 }
 
+// Removes a neighbor that was discovered by the auto peering - static neighbors can only be removed through
+// RemoveStaticNeighbor.
 func RemoveNeighbor(identifier string) {
+	if IsStaticNeighbor(identifier) {
+		return
+	}
+
+	removeNeighbor(identifier)
+}
+
+func removeNeighbor(identifier string) {
 	if _, exists := neighbors[identifier]; exists {
 		neighborLock.Lock()
 		defer neighborLock.Unlock()
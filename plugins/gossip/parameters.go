@@ -1,7 +1,24 @@
 package gossip
 
-import "github.com/iotaledger/goshimmer/packages/parameter"
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/parameter"
+)
 
 var (
-	PORT = parameter.AddInt("GOSSIP/PORT", 14666, "tcp port for gossip connection")
+	ADDRESS   = parameter.AddString("GOSSIP/ADDRESS", "", "list of addresses (IPv4 or IPv6) to bind for incoming gossip connections (empty = all interfaces)")
+	PORT      = parameter.AddInt("GOSSIP/PORT", 14666, "tcp port for gossip connection")
+	NEIGHBORS = parameter.AddString("GOSSIP/NEIGHBORS", "", "list of static neighbors (identity@host:port) that are maintained independently of the auto peering")
+
+	TRANSACTION_FILTER_CAPACITY = parameter.AddInt("GOSSIP/TRANSACTION_FILTER_CAPACITY", TRANSACTION_FILTER_DEFAULT_CAPACITY, "amount of recently received transaction hashes that are remembered to filter duplicates")
+	TRANSACTION_FILTER_TTL      = parameter.AddDuration("GOSSIP/TRANSACTION_FILTER_TTL", TRANSACTION_FILTER_DEFAULT_TTL, "time after which a received transaction hash is forgotten (0 = only limited by the capacity)").SetRange(0, 24*time.Hour)
+
+	TRANSACTION_RATE_LIMIT = parameter.AddInt("GOSSIP/TRANSACTION_RATE_LIMIT", 1000, "maximum amount of transactions per second that a neighbor may send us (0 = unlimited)").MakeReloadable()
+	REQUEST_RATE_LIMIT     = parameter.AddInt("GOSSIP/REQUEST_RATE_LIMIT", 500, "maximum amount of transaction requests per second that a neighbor may send us (0 = unlimited)").MakeReloadable()
+	MIN_WEIGHT_MAGNITUDE   = parameter.AddUint("GOSSIP/MIN_WEIGHT_MAGNITUDE", 0, "minimum amount of trailing zero trits that the hashes of received transactions need (0 = no proof of work required)").SetRange(0, 243).MakeReloadable()
+	BAN_DURATION           = parameter.AddDuration("GOSSIP/BAN_DURATION", time.Hour, "time that neighbors get banned for when they exceed the rate limits or send invalid transactions").SetRange(time.Second, 30*24*time.Hour)
+
+	SEND_QUEUE_SIZE          = parameter.AddInt("GOSSIP/SEND_QUEUE_SIZE", DEFAULT_SEND_QUEUE_SIZE, "amount of relayed transactions that are buffered per neighbor before they get dropped")
+	PRIORITY_SEND_QUEUE_SIZE = parameter.AddInt("GOSSIP/PRIORITY_SEND_QUEUE_SIZE", DEFAULT_PRIORITY_SEND_QUEUE_SIZE, "amount of own transactions that are buffered per neighbor before they get dropped")
 )
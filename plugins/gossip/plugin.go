@@ -8,6 +8,8 @@ var PLUGIN = node.NewPlugin("Gossip", node.Enabled, configure, run)
 
 func configure(plugin *node.Plugin) {
 	configureNeighbors(plugin)
+	configureTransactionProcessor(plugin)
+	configureBanList(plugin)
 	configureServer(plugin)
 	configureSendQueue(plugin)
 }
@@ -108,6 +108,12 @@ func (protocol *protocol) Receive(data []byte) {
 
 			_ = protocol.Conn.Close()
 
+			// neighbors that send invalid transactions or exceed the rate limits get banned (other errors can be caused by
+			// network problems, so we only close the connection)
+			if protocol.Neighbor != nil && (err.Equals(ErrRateLimitExceeded) || err.Equals(ErrInvalidTransaction)) {
+				BanNeighbor(protocol.Neighbor.Identity.StringIdentifier, *BAN_DURATION.Value)
+			}
+
 			return
 		} else {
 			offset += readBytes
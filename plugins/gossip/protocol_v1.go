@@ -3,6 +3,7 @@ package gossip
 import (
 	"bytes"
 	"strconv"
+	"sync/atomic"
 
 	"github.com/iotaledger/goshimmer/packages/accountability"
 	"github.com/iotaledger/goshimmer/packages/byteutils"
@@ -87,7 +88,7 @@ func (state *indentificationStateV1) Receive(data []byte, offset int, length int
 		} else {
 			protocol := state.protocol
 
-			if neighbor, exists := GetNeighbor(receivedIdentity.StringIdentifier); exists {
+			if neighbor, exists := GetNeighbor(receivedIdentity.StringIdentifier); exists && !IsBanned(receivedIdentity.StringIdentifier) {
 				protocol.Neighbor = neighbor
 			} else {
 				protocol.Neighbor = nil
@@ -220,7 +221,7 @@ func newDispatchStateV1(protocol *protocol) *dispatchStateV1 {
 }
 
 func (state *dispatchStateV1) Receive(data []byte, offset int, length int) (int, errors.IdentifiableError) {
-	switch data[0] {
+	switch data[offset] {
 	case DISPATCH_DROP:
 		protocol := state.protocol
 
@@ -238,6 +239,10 @@ func (state *dispatchStateV1) Receive(data []byte, offset int, length int) (int,
 	case DISPATCH_REQUEST:
 		protocol := state.protocol
 
+		if protocol.Neighbor != nil && !protocol.Neighbor.requestRateLimiter.Allow() {
+			return 1, ErrRateLimitExceeded.Derive("neighbor " + protocol.Neighbor.Identity.StringIdentifier + " exceeded the request rate limit")
+		}
+
 		protocol.ReceivingState = newRequestStateV1(protocol)
 
 	default:
@@ -315,9 +320,21 @@ func (state *transactionStateV1) Receive(data []byte, offset int, length int) (i
 	if state.offset == meta_transaction.MARSHALED_TOTAL_SIZE/consts.NumberOfTritsInAByte {
 		protocol := state.protocol
 
+		if protocol.Neighbor != nil && !protocol.Neighbor.transactionRateLimiter.Allow() {
+			return bytesRead, ErrRateLimitExceeded.Derive("neighbor " + protocol.Neighbor.Identity.StringIdentifier + " exceeded the transaction rate limit")
+		}
+
+		if !IsValidTransactionData(state.buffer) {
+			return bytesRead, ErrInvalidTransaction.Derive("received transaction data with an invalid trit encoding")
+		}
+
 		transactionData := make([]byte, meta_transaction.MARSHALED_TOTAL_SIZE/consts.NumberOfTritsInAByte)
 		copy(transactionData, state.buffer)
 
+		if protocol.Neighbor != nil {
+			atomic.AddUint64(&protocol.Neighbor.receivedTransactions, 1)
+		}
+
 		protocol.Events.ReceiveTransactionData.Trigger(transactionData)
 
 		go ProcessReceivedTransactionData(transactionData)
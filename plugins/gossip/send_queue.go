@@ -2,11 +2,13 @@ package gossip
 
 import (
 	"sync"
+	"sync/atomic"
 
 	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/model/meta_transaction"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 )
 
 // region plugin module setup //////////////////////////////////////////////////////////////////////////////////////////
@@ -18,76 +20,87 @@ func configureSendQueue(plugin *node.Plugin) {
 
 	Events.AddNeighbor.Attach(events.NewClosure(setupEventHandlers))
 
-	daemon.Events.Shutdown.Attach(events.NewClosure(func() {
-		plugin.LogInfo("Stopping Send Queue Dispatcher ...")
+	plugin.Events.Shutdown.Attach(events.NewClosure(func(plugin *node.Plugin) {
+		plugin.LogInfo("Stopping Send Queues ...")
 	}))
 }
 
 func runSendQueue(plugin *node.Plugin) {
-	plugin.LogInfo("Starting Send Queue Dispatcher ...")
-
-	daemon.BackgroundWorker("Gossip Send Queue Dispatcher", func() {
-		plugin.LogSuccess("Starting Send Queue Dispatcher ... done")
-
-		for {
-			select {
-			case <-daemon.ShutdownSignal:
-				plugin.LogSuccess("Stopping Send Queue Dispatcher ... done")
-
-				return
-
-			case tx := <-sendQueue:
-				connectedNeighborsMutex.RLock()
-				for _, neighborQueue := range neighborQueues {
-					select {
-					case neighborQueue.queue <- tx:
-						// log sth
-
-					default:
-						// log sth
-					}
-				}
-				connectedNeighborsMutex.RUnlock()
-			}
-		}
-	})
+	plugin.LogInfo("Starting Send Queues ...")
 
 	connectedNeighborsMutex.Lock()
 	for _, neighborQueue := range neighborQueues {
 		startNeighborSendQueue(neighborQueue.protocol.Neighbor, neighborQueue)
 	}
 	connectedNeighborsMutex.Unlock()
+
+	plugin.LogSuccess("Starting Send Queues ... done")
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region public api ///////////////////////////////////////////////////////////////////////////////////////////////////
 
+// Relays a transaction to all connected neighbors (transactions that do not fit into the queue of a neighbor are
+// dropped for that neighbor only).
 func SendTransaction(transaction *meta_transaction.MetaTransaction) {
-	sendQueue <- transaction
+	broadcastTransaction(transaction, SEND_PRIORITY_NORMAL)
 }
 
-func (neighbor *Neighbor) SendTransaction(transaction *meta_transaction.MetaTransaction) {
-	if queue, exists := neighborQueues[neighbor.Identity.StringIdentifier]; exists {
-		select {
-		case queue.queue <- transaction:
-			return
+// Sends one of our own transactions to all connected neighbors - it overtakes the relayed transactions in the queues.
+func SendPriorityTransaction(transaction *meta_transaction.MetaTransaction) {
+	broadcastTransaction(transaction, SEND_PRIORITY_HIGH)
+}
 
-		default:
-			return
-		}
+// Returns the send queue statistics of this neighbor (all values are 0 if it is not connected).
+func (neighbor *Neighbor) GetSendQueueStatistics() (result SendQueueStatistics) {
+	connectedNeighborsMutex.RLock()
+	queue, exists := neighborQueues[neighbor.Identity.StringIdentifier]
+	connectedNeighborsMutex.RUnlock()
+
+	if exists {
+		result = queue.getStatistics()
 	}
+
+	return
+}
+
+// Returns the accumulated send queue statistics (the sent and dropped counters include disconnected neighbors).
+func GetSendQueueStatistics() SendQueueStatistics {
+	result := SendQueueStatistics{
+		SentTransactions:            atomic.LoadUint64(&sentTransactions),
+		DroppedTransactions:         atomic.LoadUint64(&droppedTransactions),
+		DroppedPriorityTransactions: atomic.LoadUint64(&droppedPriorityTransactions),
+	}
+
+	connectedNeighborsMutex.RLock()
+	for _, queue := range neighborQueues {
+		result.QueuedTransactions += len(queue.queue)
+		result.QueuedPriorityTransactions += len(queue.priorityQueue)
+	}
+	connectedNeighborsMutex.RUnlock()
+
+	return result
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region utility methods //////////////////////////////////////////////////////////////////////////////////////////////
 
+func broadcastTransaction(transaction *meta_transaction.MetaTransaction, priority int) {
+	connectedNeighborsMutex.RLock()
+	for _, queue := range neighborQueues {
+		queue.enqueue(transaction, priority)
+	}
+	connectedNeighborsMutex.RUnlock()
+}
+
 func setupEventHandlers(neighbor *Neighbor) {
 	neighbor.Events.ProtocolConnectionEstablished.Attach(events.NewClosure(func(protocol *protocol) {
 		queue := &neighborQueue{
 			protocol:       protocol,
-			queue:          make(chan *meta_transaction.MetaTransaction, SEND_QUEUE_SIZE),
+			queue:          make(chan *meta_transaction.MetaTransaction, *SEND_QUEUE_SIZE.Value),
+			priorityQueue:  make(chan *meta_transaction.MetaTransaction, *PRIORITY_SEND_QUEUE_SIZE.Value),
 			disconnectChan: make(chan int, 1),
 		}
 
@@ -99,7 +112,9 @@ func setupEventHandlers(neighbor *Neighbor) {
 			close(queue.disconnectChan)
 
 			connectedNeighborsMutex.Lock()
-			delete(neighborQueues, neighbor.Identity.StringIdentifier)
+			if neighborQueues[neighbor.Identity.StringIdentifier] == queue {
+				delete(neighborQueues, neighbor.Identity.StringIdentifier)
+			}
 			connectedNeighborsMutex.Unlock()
 		}))
 
@@ -110,33 +125,101 @@ func setupEventHandlers(neighbor *Neighbor) {
 }
 
 func startNeighborSendQueue(neighbor *Neighbor, neighborQueue *neighborQueue) {
-	daemon.BackgroundWorker("Gossip Send Queue ("+neighbor.Identity.StringIdentifier+")", func() {
+	daemon.BackgroundWorker("Gossip Send Queue ("+neighbor.Identity.StringIdentifier+")", func(shutdownSignal <-chan struct{}) {
 		for {
+			// always empty the priority queue first
+			select {
+			case tx := <-neighborQueue.priorityQueue:
+				neighborQueue.send(tx)
+
+				continue
+
+			default:
+			}
+
 			select {
-			case <-daemon.ShutdownSignal:
+			case <-shutdownSignal:
 				return
 
 			case <-neighborQueue.disconnectChan:
 				return
 
+			case tx := <-neighborQueue.priorityQueue:
+				neighborQueue.send(tx)
+
 			case tx := <-neighborQueue.queue:
-				switch neighborQueue.protocol.Version {
-				case VERSION_1:
-					sendTransactionV1(neighborQueue.protocol, tx)
-				}
+				neighborQueue.send(tx)
 			}
 		}
-	})
+	}, shutdown.PRIORITY_GOSSIP)
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-// region types and interfaces /////////////////////////////////////////////////////////////////////////////////////////
+// region neighborQueue ////////////////////////////////////////////////////////////////////////////////////////////////
 
 type neighborQueue struct {
-	protocol       *protocol
-	queue          chan *meta_transaction.MetaTransaction
-	disconnectChan chan int
+	// the counters are accessed atomically and come first to guarantee their 64 bit alignment
+	sentTransactions            uint64
+	droppedTransactions         uint64
+	droppedPriorityTransactions uint64
+	protocol                    *protocol
+	queue                       chan *meta_transaction.MetaTransaction
+	priorityQueue               chan *meta_transaction.MetaTransaction
+	disconnectChan              chan int
+}
+
+// adds the transaction to the queue of the given priority or drops it if the queue is full
+func (neighborQueue *neighborQueue) enqueue(transaction *meta_transaction.MetaTransaction, priority int) {
+	switch priority {
+	case SEND_PRIORITY_HIGH:
+		select {
+		case neighborQueue.priorityQueue <- transaction:
+		default:
+			atomic.AddUint64(&neighborQueue.droppedPriorityTransactions, 1)
+			atomic.AddUint64(&droppedPriorityTransactions, 1)
+		}
+
+	default:
+		select {
+		case neighborQueue.queue <- transaction:
+		default:
+			atomic.AddUint64(&neighborQueue.droppedTransactions, 1)
+			atomic.AddUint64(&droppedTransactions, 1)
+		}
+	}
+}
+
+func (neighborQueue *neighborQueue) send(transaction *meta_transaction.MetaTransaction) {
+	switch neighborQueue.protocol.Version {
+	case VERSION_1:
+		sendTransactionV1(neighborQueue.protocol, transaction)
+	}
+
+	atomic.AddUint64(&neighborQueue.sentTransactions, 1)
+	atomic.AddUint64(&sentTransactions, 1)
+}
+
+func (neighborQueue *neighborQueue) getStatistics() SendQueueStatistics {
+	return SendQueueStatistics{
+		QueuedTransactions:          len(neighborQueue.queue),
+		QueuedPriorityTransactions:  len(neighborQueue.priorityQueue),
+		SentTransactions:            atomic.LoadUint64(&neighborQueue.sentTransactions),
+		DroppedTransactions:         atomic.LoadUint64(&neighborQueue.droppedTransactions),
+		DroppedPriorityTransactions: atomic.LoadUint64(&neighborQueue.droppedPriorityTransactions),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region types and interfaces /////////////////////////////////////////////////////////////////////////////////////////
+
+type SendQueueStatistics struct {
+	QueuedTransactions          int
+	QueuedPriorityTransactions  int
+	SentTransactions            uint64
+	DroppedTransactions         uint64
+	DroppedPriorityTransactions uint64
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -147,10 +230,20 @@ var neighborQueues = make(map[string]*neighborQueue)
 
 var connectedNeighborsMutex sync.RWMutex
 
-var sendQueue = make(chan *meta_transaction.MetaTransaction, SEND_QUEUE_SIZE)
+var (
+	sentTransactions            uint64
+	droppedTransactions         uint64
+	droppedPriorityTransactions uint64
+)
+
+const (
+	SEND_PRIORITY_NORMAL = iota
+	SEND_PRIORITY_HIGH
+)
 
 const (
-	SEND_QUEUE_SIZE = 500
+	DEFAULT_SEND_QUEUE_SIZE          = 500
+	DEFAULT_PRIORITY_SEND_QUEUE_SIZE = 1000
 )
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,62 @@
+package gossip
+
+import (
+	"testing"
+
+	"github.com/iotaledger/goshimmer/packages/model/meta_transaction"
+)
+
+func TestNeighborQueue_Enqueue(t *testing.T) {
+	queue := &neighborQueue{
+		queue:         make(chan *meta_transaction.MetaTransaction, 2),
+		priorityQueue: make(chan *meta_transaction.MetaTransaction, 1),
+	}
+
+	// a full queue of relayed transactions does not affect our own transactions
+	for i := 0; i < 3; i++ {
+		queue.enqueue(meta_transaction.New(), SEND_PRIORITY_NORMAL)
+	}
+	queue.enqueue(meta_transaction.New(), SEND_PRIORITY_HIGH)
+
+	statistics := queue.getStatistics()
+	if statistics.QueuedTransactions != 2 || statistics.DroppedTransactions != 1 {
+		t.Error("unexpected statistics of the relayed transactions", statistics)
+	}
+	if statistics.QueuedPriorityTransactions != 1 || statistics.DroppedPriorityTransactions != 0 {
+		t.Error("unexpected statistics of the priority transactions", statistics)
+	}
+
+	queue.enqueue(meta_transaction.New(), SEND_PRIORITY_HIGH)
+	if statistics := queue.getStatistics(); statistics.DroppedPriorityTransactions != 1 {
+		t.Error("overflowing priority transaction was not dropped", statistics)
+	}
+}
+
+func TestSendPriorityTransaction_FullQueue(t *testing.T) {
+	queue := &neighborQueue{
+		queue:         make(chan *meta_transaction.MetaTransaction, 1),
+		priorityQueue: make(chan *meta_transaction.MetaTransaction, 1),
+	}
+
+	connectedNeighborsMutex.Lock()
+	neighborQueues["test"] = queue
+	connectedNeighborsMutex.Unlock()
+	defer func() {
+		connectedNeighborsMutex.Lock()
+		delete(neighborQueues, "test")
+		connectedNeighborsMutex.Unlock()
+	}()
+
+	// the queue of the relayed transactions is full after the first one
+	SendTransaction(meta_transaction.New())
+	SendTransaction(meta_transaction.New())
+	SendPriorityTransaction(meta_transaction.New())
+
+	statistics := queue.getStatistics()
+	if statistics.DroppedTransactions != 1 {
+		t.Error("the relayed transaction was not dropped from the full queue", statistics)
+	}
+	if statistics.QueuedPriorityTransactions != 1 || statistics.DroppedPriorityTransactions != 0 {
+		t.Error("our own transaction was dropped because of the full queue", statistics)
+	}
+}
@@ -1,7 +1,7 @@
 package gossip
 
 import (
-	"strconv"
+	"strings"
 
 	"github.com/iotaledger/goshimmer/packages/accountability"
 	"github.com/iotaledger/goshimmer/packages/daemon"
@@ -11,6 +11,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/network"
 	"github.com/iotaledger/goshimmer/packages/network/tcp"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 )
 
 var TCPServer = tcp.NewServer()
@@ -62,22 +63,24 @@ func configureServer(plugin *node.Plugin) {
 
 		go protocol.Init()
 	}))
-
-	daemon.Events.Shutdown.Attach(events.NewClosure(func() {
-		plugin.LogInfo("Stopping TCP Server ...")
-
-		TCPServer.Shutdown()
-	}))
 }
 
 func runServer(plugin *node.Plugin) {
-	plugin.LogInfo("Starting TCP Server (port " + strconv.Itoa(*PORT.Value) + ") ...")
+	plugin.Log.Info("Starting TCP Server ...", "port", *PORT.Value)
+
+	daemon.BackgroundWorker("Gossip TCP Server", func(shutdownSignal <-chan struct{}) {
+		plugin.Log.Success("Starting TCP Server ... done", "port", *PORT.Value)
+
+		go func() {
+			<-shutdownSignal
+
+			plugin.LogInfo("Stopping TCP Server ...")
 
-	daemon.BackgroundWorker("Gossip TCP Server", func() {
-		plugin.LogSuccess("Starting TCP Server (port " + strconv.Itoa(*PORT.Value) + ") ... done")
+			TCPServer.Shutdown()
+		}()
 
-		TCPServer.Listen(*PORT.Value)
+		TCPServer.Listen(*PORT.Value, strings.Fields(*ADDRESS.Value)...)
 
 		plugin.LogSuccess("Stopping TCP Server ... done")
-	})
+	}, shutdown.PRIORITY_GOSSIP)
 }
@@ -0,0 +1,131 @@
+package gossip
+
+import (
+	"encoding/hex"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/iotaledger/goshimmer/packages/errors"
+	"github.com/iotaledger/goshimmer/packages/identity"
+	"github.com/iotaledger/goshimmer/packages/node"
+)
+
+// region plugin module setup //////////////////////////////////////////////////////////////////////////////////////////
+
+func configureStaticNeighbors(plugin *node.Plugin) {
+	for _, neighborDefinition := range strings.Fields(*NEIGHBORS.Value) {
+		neighbor, err := ParseNeighbor(neighborDefinition)
+		if err != nil {
+			panic("error while parsing static neighbor \"" + neighborDefinition + "\": " + err.Error())
+		}
+
+		AddStaticNeighbor(neighbor)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region public api ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Parses a neighbor definition of the form identity@host:port (IPv6 hosts have to be enclosed in square brackets).
+func ParseNeighbor(neighborDefinition string) (*Neighbor, errors.IdentifiableError) {
+	identityBits := strings.Split(neighborDefinition, "@")
+	if len(identityBits) != 2 {
+		return nil, ErrInvalidNeighborDefinition.Derive("missing identity in neighbor definition")
+	}
+
+	decodedIdentifier, err := hex.DecodeString(identityBits[0])
+	if err != nil {
+		return nil, ErrInvalidNeighborDefinition.Derive("error while parsing identity: " + err.Error())
+	}
+	if len(decodedIdentifier) != MARSHALED_IDENTITY_SIZE {
+		return nil, ErrInvalidNeighborDefinition.Derive("invalid identity length (" + strconv.Itoa(len(decodedIdentifier)) + " bytes)")
+	}
+
+	host, portString, err := net.SplitHostPort(identityBits[1])
+	if err != nil {
+		return nil, ErrInvalidNeighborDefinition.Derive("error while parsing address: " + err.Error())
+	}
+
+	port, err := strconv.ParseUint(portString, 10, 16)
+	if err != nil {
+		return nil, ErrInvalidNeighborDefinition.Derive("error while parsing port: " + err.Error())
+	}
+
+	// host names can resolve to multiple addresses (i.e. IPv4 and IPv6) which are all tried when connecting
+	addresses := []net.IP{net.ParseIP(host)}
+	if addresses[0] == nil {
+		if addresses, err = net.LookupIP(host); err != nil {
+			return nil, ErrInvalidNeighborDefinition.Derive("error while resolving host: " + err.Error())
+		}
+		if len(addresses) == 0 {
+			return nil, ErrInvalidNeighborDefinition.Derive("host " + host + " did not resolve to any address")
+		}
+	}
+
+	return NewNeighbor(&identity.Identity{
+		Identifier:       decodedIdentifier,
+		StringIdentifier: hex.EncodeToString(decodedIdentifier),
+	}, addresses[0], uint16(port), addresses[1:]...), nil
+}
+
+// Adds a neighbor that is maintained independently of the auto peering (it never gets dropped and reconnects forever).
+func AddStaticNeighbor(neighbor *Neighbor) {
+	staticNeighborsMutex.Lock()
+	staticNeighbors[neighbor.Identity.StringIdentifier] = true
+	staticNeighborsMutex.Unlock()
+
+	addNeighbor(neighbor)
+}
+
+// Removes a static neighbor and closes all of its connections.
+func RemoveStaticNeighbor(identifier string) bool {
+	staticNeighborsMutex.Lock()
+	_, exists := staticNeighbors[identifier]
+	delete(staticNeighbors, identifier)
+	staticNeighborsMutex.Unlock()
+
+	if !exists {
+		return false
+	}
+
+	if neighbor, exists := GetNeighbor(identifier); exists {
+		removeNeighbor(identifier)
+
+		neighbor.Disconnect()
+	}
+
+	return true
+}
+
+func IsStaticNeighbor(identifier string) bool {
+	staticNeighborsMutex.RLock()
+	defer staticNeighborsMutex.RUnlock()
+
+	_, exists := staticNeighbors[identifier]
+
+	return exists
+}
+
+func GetStaticNeighbors() map[string]*Neighbor {
+	result := make(map[string]*Neighbor)
+	for id, neighbor := range GetNeighbors() {
+		if IsStaticNeighbor(id) {
+			result[id] = neighbor
+		}
+	}
+
+	return result
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region constants and variables //////////////////////////////////////////////////////////////////////////////////////
+
+var staticNeighbors = make(map[string]bool)
+
+var staticNeighborsMutex sync.RWMutex
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,66 @@
+package gossip
+
+import (
+	"net"
+	"testing"
+)
+
+func TestParseNeighbor(t *testing.T) {
+	neighbor, err := ParseNeighbor("7F7A876A4236091257E650DA8DCF195FBE3CB625@127.0.0.1:14666")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if neighbor.Identity.StringIdentifier != "7f7a876a4236091257e650da8dcf195fbe3cb625" {
+		t.Error("identifier was not normalized", neighbor.Identity.StringIdentifier)
+	}
+	if !neighbor.Address.Equal(net.IPv4(127, 0, 0, 1)) || neighbor.Port != 14666 {
+		t.Error("address was not parsed correctly", neighbor.Address, neighbor.Port)
+	}
+
+	neighbor, err = ParseNeighbor("7f7a876a4236091257e650da8dcf195fbe3cb625@[::1]:14666")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !neighbor.Address.Equal(net.IPv6loopback) {
+		t.Error("ipv6 address was not parsed correctly", neighbor.Address)
+	}
+
+	for _, invalidDefinition := range []string{
+		"127.0.0.1:14666",
+		"xyz@127.0.0.1:14666",
+		"7f7a876a42@127.0.0.1:14666",
+		"7f7a876a4236091257e650da8dcf195fbe3cb625@127.0.0.1",
+		"7f7a876a4236091257e650da8dcf195fbe3cb625@127.0.0.1:70000",
+	} {
+		if _, err := ParseNeighbor(invalidDefinition); err == nil {
+			t.Error("invalid neighbor definition was accepted", invalidDefinition)
+		}
+	}
+}
+
+func TestStaticNeighbor(t *testing.T) {
+	neighbor, err := ParseNeighbor("1f7a876a4236091257e650da8dcf195fbe3cb625@127.0.0.1:14666")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AddStaticNeighbor(neighbor)
+
+	// neighbors discovered by the auto peering neither overwrite nor remove static neighbors
+	AddNeighbor(NewNeighbor(neighbor.Identity, net.IPv4(127, 0, 0, 2), 14667))
+	RemoveNeighbor(neighbor.Identity.StringIdentifier)
+
+	if existingNeighbor, exists := GetNeighbor(neighbor.Identity.StringIdentifier); !exists {
+		t.Error("static neighbor was removed")
+	} else if !existingNeighbor.Equals(neighbor) {
+		t.Error("static neighbor was updated")
+	}
+
+	if !RemoveStaticNeighbor(neighbor.Identity.StringIdentifier) {
+		t.Error("static neighbor could not be removed")
+	}
+	if _, exists := GetNeighbor(neighbor.Identity.StringIdentifier); exists {
+		t.Error("static neighbor still exists after removal")
+	}
+}
@@ -1,26 +1,90 @@
 package gossip
 
 import (
+	"time"
+
 	"github.com/iotaledger/goshimmer/packages/filter"
 	"github.com/iotaledger/goshimmer/packages/model/meta_transaction"
+	"github.com/iotaledger/goshimmer/packages/node"
 )
 
+// region plugin module setup //////////////////////////////////////////////////////////////////////////////////////////
+
+func configureTransactionProcessor(plugin *node.Plugin) {
+	transactionFilter = filter.NewSeenSet(*TRANSACTION_FILTER_CAPACITY.Value, *TRANSACTION_FILTER_TTL.Value)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region public api ///////////////////////////////////////////////////////////////////////////////////////////////////
 
 func ProcessReceivedTransactionData(transactionData []byte) {
-	if transactionFilter.Add(transactionData) {
-		Events.ReceiveTransaction.Trigger(meta_transaction.FromBytes(transactionData))
+	transaction := meta_transaction.FromBytes(transactionData)
+
+	// transactions without enough proof of work are dropped (neighbors are not banned for it, since the minimum weight
+	// magnitude can change while the node is running)
+	if minWeightMagnitude := MIN_WEIGHT_MAGNITUDE.GetValue(); minWeightMagnitude != 0 && uint64(transaction.GetWeightMagnitude()) < minWeightMagnitude {
+		return
 	}
+
+	// the hash is cached in the transaction, so the solidifier does not have to compute it again
+	if transactionFilter.Add(transaction.GetHash()) {
+		Events.ReceiveTransaction.Trigger(transaction)
+	}
+}
+
+// Returns true if every byte of the transaction data encodes 5 trits (a byte outside of [-121, 121] can not be the
+// result of a valid transaction).
+func IsValidTransactionData(transactionData []byte) bool {
+	for _, transactionByte := range transactionData {
+		if value := int8(transactionByte); value > MAX_TRITS_IN_A_BYTE_VALUE || value < -MAX_TRITS_IN_A_BYTE_VALUE {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Processes one of our own transactions like a received one and remembers it, so it overtakes the relayed
+// transactions in the send queues once it became solid (see IsIssuedTransaction).
+func IssueTransaction(transaction *meta_transaction.MetaTransaction) {
+	issuedTransactions.Add(transaction.GetHash())
+
+	if transactionFilter.Add(transaction.GetHash()) {
+		Events.ReceiveTransaction.Trigger(transaction)
+	}
+}
+
+// Returns true if the transaction was issued by this node (and should therefore be sent with a higher priority).
+func IsIssuedTransaction(transactionHash string) bool {
+	return issuedTransactions.Contains(transactionHash)
+}
+
+// Returns the number of duplicate (hits) and new transactions (misses) that were received through gossip.
+func GetTransactionFilterStatistics() (hits uint64, misses uint64) {
+	return transactionFilter.Statistics()
+}
+
+// Returns the share of the received transactions that were duplicates.
+func GetTransactionFilterHitRate() float64 {
+	return transactionFilter.HitRate()
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region constants and variables //////////////////////////////////////////////////////////////////////////////////////
 
-var transactionFilter = filter.NewByteArrayFilter(TRANSACTION_FILTER_SIZE)
+var transactionFilter = filter.NewSeenSet(TRANSACTION_FILTER_DEFAULT_CAPACITY, TRANSACTION_FILTER_DEFAULT_TTL)
+
+var issuedTransactions = filter.NewSeenSet(ISSUED_TRANSACTIONS_CAPACITY, TRANSACTION_FILTER_DEFAULT_TTL)
 
 const (
-	TRANSACTION_FILTER_SIZE = 500
+	TRANSACTION_FILTER_DEFAULT_CAPACITY = 100000
+	TRANSACTION_FILTER_DEFAULT_TTL      = 10 * time.Minute
+	ISSUED_TRANSACTIONS_CAPACITY        = 10000
+
+	// the largest absolute value of a byte that encodes 5 trits ((3^5 - 1) / 2)
+	MAX_TRITS_IN_A_BYTE_VALUE = 121
 )
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
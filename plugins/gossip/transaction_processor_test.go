@@ -4,10 +4,26 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/model/meta_transaction"
 	"github.com/iotaledger/iota.go/consts"
 )
 
+func TestIsValidTransactionData(t *testing.T) {
+	transactionData := make([]byte, meta_transaction.MARSHALED_TOTAL_SIZE/consts.NumberOfTritsInAByte)
+	for i := range transactionData {
+		transactionData[i] = byte(int8(i%(2*MAX_TRITS_IN_A_BYTE_VALUE+1) - MAX_TRITS_IN_A_BYTE_VALUE))
+	}
+	if !IsValidTransactionData(transactionData) {
+		t.Error("valid transaction data was rejected")
+	}
+
+	transactionData[0] = MAX_TRITS_IN_A_BYTE_VALUE + 1
+	if IsValidTransactionData(transactionData) {
+		t.Error("invalid transaction data was accepted")
+	}
+}
+
 func BenchmarkProcessSimilarTransactionsFiltered(b *testing.B) {
 	byteArray := setupTransaction(meta_transaction.MARSHALED_TOTAL_SIZE / consts.NumberOfTritsInAByte)
 
@@ -38,6 +54,37 @@ func BenchmarkProcessSimilarTransactionsUnfiltered(b *testing.B) {
 	wg.Wait()
 }
 
+func TestProcessReceivedTransactionData_MinWeightMagnitude(t *testing.T) {
+	received := 0
+	closure := events.NewClosure(func(transaction *meta_transaction.MetaTransaction) {
+		received++
+	})
+	Events.ReceiveTransaction.Attach(closure)
+	defer Events.ReceiveTransaction.Detach(closure)
+
+	if err := MIN_WEIGHT_MAGNITUDE.SetValue(243); err != nil {
+		t.Fatal(err)
+	}
+	defer MIN_WEIGHT_MAGNITUDE.SetValue(MIN_WEIGHT_MAGNITUDE.DefaultValue)
+
+	transactionData := setupTransaction(meta_transaction.MARSHALED_TOTAL_SIZE / consts.NumberOfTritsInAByte)
+	transactionData[0] = 1
+
+	ProcessReceivedTransactionData(transactionData)
+	if received != 0 {
+		t.Error("a transaction without enough proof of work was processed")
+	}
+
+	if err := MIN_WEIGHT_MAGNITUDE.SetValue(0); err != nil {
+		t.Fatal(err)
+	}
+
+	ProcessReceivedTransactionData(transactionData)
+	if received != 1 {
+		t.Error("the transaction was not processed after the minimum weight magnitude was removed")
+	}
+}
+
 func setupTransaction(byteArraySize int) []byte {
 	byteArray := make([]byte, byteArraySize)
 
@@ -47,3 +94,29 @@ func setupTransaction(byteArraySize int) []byte {
 
 	return byteArray
 }
+
+func TestIssueTransaction(t *testing.T) {
+	received := 0
+	closure := events.NewClosure(func(transaction *meta_transaction.MetaTransaction) {
+		received++
+	})
+	Events.ReceiveTransaction.Attach(closure)
+	defer Events.ReceiveTransaction.Detach(closure)
+
+	transaction := meta_transaction.New()
+	transaction.SetHead(true)
+
+	IssueTransaction(transaction)
+	if received != 1 {
+		t.Error("the issued transaction was not processed")
+	}
+	if !IsIssuedTransaction(transaction.GetHash()) {
+		t.Error("the issued transaction was not remembered")
+	}
+
+	// a neighbor that sends our own transaction back does not cause it to be processed again
+	ProcessReceivedTransactionData(transaction.GetBytes())
+	if received != 1 {
+		t.Error("the issued transaction was processed twice")
+	}
+}
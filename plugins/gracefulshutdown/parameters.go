@@ -0,0 +1,16 @@
+package gracefulshutdown
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/parameter"
+)
+
+var (
+	// maximum amount of time to wait for background processes to terminate. After that the process is killed.
+	WAIT_TO_KILL_TIME = parameter.AddDuration("GRACEFULSHUTDOWN/WAIT_TO_KILL_TIME", 10*time.Second, "maximum time to wait for background processes to terminate before the node is killed").SetRange(time.Second, time.Hour)
+
+	// maximum amount of time that a single shutdown stage (the background workers of one priority) should take before
+	// its remaining workers are reported.
+	STAGE_TIMEOUT = parameter.AddDuration("GRACEFULSHUTDOWN/STAGE_TIMEOUT", 5*time.Second, "maximum time that the background workers of a single shutdown stage should need to terminate before they are reported").SetRange(100*time.Millisecond, time.Hour)
+)
@@ -3,7 +3,6 @@ package gracefulshutdown
 import (
 	"os"
 	"os/signal"
-	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -12,10 +11,9 @@ import (
 	"github.com/iotaledger/goshimmer/packages/node"
 )
 
-// maximum amount of time to wait for background processes to terminate. After that the process is killed.
-const WAIT_TO_KILL_TIME_IN_SECONDS = 10
-
 var PLUGIN = node.NewPlugin("Graceful Shutdown", node.Enabled, func(plugin *node.Plugin) {
+	configureStageReporting(plugin)
+
 	gracefulStop := make(chan os.Signal)
 
 	signal.Notify(gracefulStop, syscall.SIGTERM)
@@ -24,21 +22,17 @@ var PLUGIN = node.NewPlugin("Graceful Shutdown", node.Enabled, func(plugin *node
 	go func() {
 		<-gracefulStop
 
-		plugin.LogWarning("Received shutdown request - waiting (max " + strconv.Itoa(WAIT_TO_KILL_TIME_IN_SECONDS) + " seconds) to finish processing ...")
+		waitToKillTime := WAIT_TO_KILL_TIME.GetValue()
+
+		plugin.Log.Warning("Received shutdown request - waiting to finish processing ...", "timeout", waitToKillTime)
 
 		go func() {
 			start := time.Now()
 			for x := range time.Tick(1 * time.Second) {
-				secondsSinceStart := x.Sub(start).Seconds()
-
-				if secondsSinceStart <= WAIT_TO_KILL_TIME_IN_SECONDS {
-					processList := ""
-					runningBackgroundWorkers := daemon.GetRunningBackgroundWorkers()
-					if len(runningBackgroundWorkers) >= 1 {
-						processList = "(" + strings.Join(runningBackgroundWorkers, ", ") + ") "
-					}
+				timeSinceStart := x.Sub(start)
 
-					plugin.LogWarning("Received shutdown request - waiting (max " + strconv.Itoa(WAIT_TO_KILL_TIME_IN_SECONDS-int(secondsSinceStart)) + " seconds) to finish processing " + processList + "...")
+				if timeSinceStart <= waitToKillTime {
+					plugin.Log.Warning("Received shutdown request - waiting to finish processing ...", "timeout", (waitToKillTime - timeSinceStart).Round(time.Second), "workers", strings.Join(daemon.GetRunningBackgroundWorkers(), ", "))
 				} else {
 					plugin.LogFailure("Background processes did not terminate in time! Forcing shutdown ...")
 
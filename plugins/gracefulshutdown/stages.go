@@ -0,0 +1,86 @@
+package gracefulshutdown
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/daemon"
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/packages/node"
+)
+
+// region plugin module setup //////////////////////////////////////////////////////////////////////////////////////////
+
+func configureStageReporting(plugin *node.Plugin) {
+	daemon.Events.ShutdownStageStarted.Attach(events.NewClosure(func(priority int, workerNames []string) {
+		stage := &shutdownStage{
+			priority:    priority,
+			workerNames: workerNames,
+			startTime:   time.Now(),
+		}
+
+		currentStageMutex.Lock()
+		currentStage = stage
+		currentStageMutex.Unlock()
+
+		plugin.Log.Debug("Stopping shutdown stage ...", "priority", priority, "workers", strings.Join(workerNames, ", "))
+
+		time.AfterFunc(*STAGE_TIMEOUT.Value, func() {
+			reportStageTimeout(plugin, stage)
+		})
+	}))
+
+	daemon.Events.ShutdownStageCompleted.Attach(events.NewClosure(func(priority int) {
+		currentStageMutex.Lock()
+		stage := currentStage
+		currentStage = nil
+		currentStageMutex.Unlock()
+
+		if stage != nil {
+			plugin.Log.Debug("Stopping shutdown stage ... done", "priority", priority, "duration", time.Since(stage.startTime))
+		}
+	}))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region shutdown stages //////////////////////////////////////////////////////////////////////////////////////////////
+
+type shutdownStage struct {
+	priority    int
+	workerNames []string
+	startTime   time.Time
+}
+
+var (
+	currentStage      *shutdownStage
+	currentStageMutex sync.Mutex
+)
+
+// Reports the workers of the given stage that are still running if the daemon did not move on to the next stage yet.
+func reportStageTimeout(plugin *node.Plugin, stage *shutdownStage) {
+	currentStageMutex.Lock()
+	stageCompleted := currentStage != stage
+	currentStageMutex.Unlock()
+
+	if stageCompleted {
+		return
+	}
+
+	runningWorkers := make(map[string]bool)
+	for _, workerName := range daemon.GetRunningBackgroundWorkers() {
+		runningWorkers[workerName] = true
+	}
+
+	pendingWorkers := make([]string, 0)
+	for _, workerName := range stage.workerNames {
+		if runningWorkers[workerName] {
+			pendingWorkers = append(pendingWorkers, workerName)
+		}
+	}
+
+	plugin.Log.Failure("Shutdown stage did not terminate in time", "priority", stage.priority, "timeout", *STAGE_TIMEOUT.Value, "workers", strings.Join(pendingWorkers, ", "))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
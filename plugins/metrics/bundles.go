@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"sync/atomic"
+)
+
+// public api method to proactively retrieve the amount of bundles that became solid during the last second
+func GetBundlesPerSecond() uint64 {
+	return atomic.LoadUint64(&measuredBundlesPerSecond)
+}
+
+// counter for the solid bundles
+var bundlesSinceLastMeasurement uint64
+
+// measured value of the solid bundles per second
+var measuredBundlesPerSecond uint64
+
+// increases the solid bundle counter
+func increaseBundleCounter() {
+	atomic.AddUint64(&bundlesSinceLastMeasurement, 1)
+}
+
+// measures the solid bundles per second
+func measureBundlesPerSecond() {
+	// sample the current counter value into a measured value (and reset the counter)
+	sampledBundlesPerSecond := atomic.SwapUint64(&bundlesSinceLastMeasurement, 0)
+
+	// store the measured value
+	atomic.StoreUint64(&measuredBundlesPerSecond, sampledBundlesPerSecond)
+
+	// trigger events for outside listeners
+	Events.BundlesPerSecondUpdated.Trigger(sampledBundlesPerSecond)
+}
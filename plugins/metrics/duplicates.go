@@ -0,0 +1,37 @@
+package metrics
+
+import (
+	"sync/atomic"
+
+	"github.com/iotaledger/goshimmer/plugins/gossip"
+)
+
+// public api method to proactively retrieve the amount of duplicate transactions that were filtered per second
+func GetReceivedDuplicateTPS() uint64 {
+	return atomic.LoadUint64(&measuredReceivedDuplicateTPS)
+}
+
+// public api method to retrieve the share of received transactions that were duplicates (since the node started)
+func GetReceivedDuplicateRate() float64 {
+	return gossip.GetTransactionFilterHitRate()
+}
+
+// amount of duplicates that were filtered by gossip when we measured the last time
+var lastTransactionFilterHits uint64
+
+// measured value of the received duplicates per second
+var measuredReceivedDuplicateTPS uint64
+
+// measures the amount of duplicates that were filtered since the last measurement
+func measureReceivedDuplicateTPS() {
+	// sample the current counter value of the gossip transaction filter
+	transactionFilterHits, _ := gossip.GetTransactionFilterStatistics()
+	sampledDuplicateTPS := transactionFilterHits - lastTransactionFilterHits
+	lastTransactionFilterHits = transactionFilterHits
+
+	// store the measured value
+	atomic.StoreUint64(&measuredReceivedDuplicateTPS, sampledDuplicateTPS)
+
+	// trigger events for outside listeners
+	Events.ReceivedDuplicateTPSUpdated.Trigger(sampledDuplicateTPS)
+}
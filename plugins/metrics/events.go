@@ -2,16 +2,29 @@ package metrics
 
 import (
 	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/packages/prometheus"
 )
 
 var Events = pluginEvents{
-	ReceivedTPSUpdated: events.NewEvent(uint64EventCaller),
+	ReceivedTPSUpdated:           events.NewEvent(uint64EventCaller),
+	ReceivedDuplicateTPSUpdated:  events.NewEvent(uint64EventCaller),
+	SolidTPSUpdated:              events.NewEvent(uint64EventCaller),
+	BundlesPerSecondUpdated:      events.NewEvent(uint64EventCaller),
+	SolidificationLatencyUpdated: events.NewEvent(histogramEventCaller),
 }
 
 type pluginEvents struct {
-	ReceivedTPSUpdated *events.Event
+	ReceivedTPSUpdated           *events.Event
+	ReceivedDuplicateTPSUpdated  *events.Event
+	SolidTPSUpdated              *events.Event
+	BundlesPerSecondUpdated      *events.Event
+	SolidificationLatencyUpdated *events.Event
 }
 
 func uint64EventCaller(handler interface{}, params ...interface{}) {
 	handler.(func(uint64))(params[0].(uint64))
 }
+
+func histogramEventCaller(handler interface{}, params ...interface{}) {
+	handler.(func(prometheus.HistogramSnapshot))(params[0].(prometheus.HistogramSnapshot))
+}
@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/model/value_transaction"
+	"github.com/iotaledger/goshimmer/packages/prometheus"
+	"github.com/iotaledger/goshimmer/plugins/tangle"
+)
+
+// public api method to retrieve the histogram of the time (in seconds) that passed between receiving a transaction and
+// it becoming solid (since the node started)
+func GetSolidificationLatency() *prometheus.Histogram {
+	return solidificationLatency
+}
+
+// histogram of the solidification latencies
+var solidificationLatency = prometheus.NewHistogram(SOLIDIFICATION_LATENCY_BUCKETS...)
+
+// upper bounds (in seconds) of the buckets of the solidification latency histogram
+var SOLIDIFICATION_LATENCY_BUCKETS = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 300}
+
+// records the time that passed since the solid transaction was received (according to its metadata)
+func observeSolidificationLatency(transaction *value_transaction.ValueTransaction) {
+	if txMetadata, err := tangle.GetTransactionMetadata(transaction.GetHash()); err == nil && txMetadata != nil {
+		solidificationLatency.Observe(time.Since(txMetadata.GetReceivedTime()).Seconds())
+	}
+}
+
+// publishes the current state of the solidification latency histogram
+func measureSolidificationLatency() {
+	Events.SolidificationLatencyUpdated.Trigger(solidificationLatency.Snapshot())
+}
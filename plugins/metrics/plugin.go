@@ -5,20 +5,57 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/packages/model/bundle"
 	"github.com/iotaledger/goshimmer/packages/model/meta_transaction"
+	"github.com/iotaledger/goshimmer/packages/model/value_transaction"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/timeutil"
+	"github.com/iotaledger/goshimmer/plugins/bundleprocessor"
 	"github.com/iotaledger/goshimmer/plugins/gossip"
+	"github.com/iotaledger/goshimmer/plugins/tangle"
 )
 
-var PLUGIN = node.NewPlugin("Metrics", node.Enabled, configure, run)
+var PLUGIN = node.NewPlugin("Metrics", node.Enabled, configure, run).DependsOn(gossip.PLUGIN, tangle.PLUGIN, bundleprocessor.PLUGIN)
 
 func configure(plugin *node.Plugin) {
 	// increase received TPS counter whenever we receive a new transaction
 	gossip.Events.ReceiveTransaction.Attach(events.NewClosure(func(_ *meta_transaction.MetaTransaction) { increaseReceivedTPSCounter() }))
+
+	// increase the solid transaction counters and record the latency whenever a transaction becomes solid
+	tangle.Events.TransactionSolid.Attach(events.NewClosure(func(transaction *value_transaction.ValueTransaction) {
+		increaseSolidTransactionCounter()
+		increaseSolidTPSCounter()
+		observeSolidificationLatency(transaction)
+	}))
+
+	// increase the bundle counter whenever a bundle becomes solid
+	bundleprocessor.Events.BundleSolid.Attach(events.NewClosure(func(_ *bundle.Bundle, _ []*value_transaction.ValueTransaction) { increaseBundleCounter() }))
 }
 
 func run(plugin *node.Plugin) {
 	// create a background worker that "measures" the TPS value every second
-	daemon.BackgroundWorker("Metrics TPS Updater", func() { timeutil.Ticker(measureReceivedTPS, 1*time.Second) })
+	daemon.BackgroundWorker("Metrics TPS Updater", func(shutdownSignal <-chan struct{}) {
+		timeutil.Ticker(measureReceivedTPS, 1*time.Second, shutdownSignal)
+	}, shutdown.PRIORITY_METRICS)
+
+	// create a background worker that "measures" the filtered duplicates every second
+	daemon.BackgroundWorker("Metrics Duplicate TPS Updater", func(shutdownSignal <-chan struct{}) {
+		timeutil.Ticker(measureReceivedDuplicateTPS, 1*time.Second, shutdownSignal)
+	}, shutdown.PRIORITY_METRICS)
+
+	// create a background worker that "measures" the solid TPS value every second
+	daemon.BackgroundWorker("Metrics Solid TPS Updater", func(shutdownSignal <-chan struct{}) {
+		timeutil.Ticker(measureSolidTPS, 1*time.Second, shutdownSignal)
+	}, shutdown.PRIORITY_METRICS)
+
+	// create a background worker that "measures" the solid bundles every second
+	daemon.BackgroundWorker("Metrics Bundles Per Second Updater", func(shutdownSignal <-chan struct{}) {
+		timeutil.Ticker(measureBundlesPerSecond, 1*time.Second, shutdownSignal)
+	}, shutdown.PRIORITY_METRICS)
+
+	// create a background worker that publishes the solidification latency histogram every second
+	daemon.BackgroundWorker("Metrics Solidification Latency Updater", func(shutdownSignal <-chan struct{}) {
+		timeutil.Ticker(measureSolidificationLatency, 1*time.Second, shutdownSignal)
+	}, shutdown.PRIORITY_METRICS)
 }
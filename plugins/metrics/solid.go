@@ -0,0 +1,18 @@
+package metrics
+
+import (
+	"sync/atomic"
+)
+
+// public api method to retrieve the amount of transactions that became solid since the node started
+func GetSolidTransactionCount() uint64 {
+	return atomic.LoadUint64(&solidTransactionCount)
+}
+
+// counter for the transactions that became solid
+var solidTransactionCount uint64
+
+// increases the solid transaction counter
+func increaseSolidTransactionCounter() {
+	atomic.AddUint64(&solidTransactionCount, 1)
+}
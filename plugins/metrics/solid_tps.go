@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"sync/atomic"
+)
+
+// public api method to proactively retrieve the amount of transactions that became solid during the last second
+func GetSolidTPS() uint64 {
+	return atomic.LoadUint64(&measuredSolidTPS)
+}
+
+// counter for the solid TPS
+var tpsSolidSinceLastMeasurement uint64
+
+// measured value of the solid TPS
+var measuredSolidTPS uint64
+
+// increases the solid TPS counter
+func increaseSolidTPSCounter() {
+	atomic.AddUint64(&tpsSolidSinceLastMeasurement, 1)
+}
+
+// measures the solid TPS value
+func measureSolidTPS() {
+	// sample the current counter value into a measured TPS value (and reset the counter)
+	sampledTPS := atomic.SwapUint64(&tpsSolidSinceLastMeasurement, 0)
+
+	// store the measured value
+	atomic.StoreUint64(&measuredSolidTPS, sampledTPS)
+
+	// trigger events for outside listeners
+	Events.SolidTPSUpdated.Trigger(sampledTPS)
+}
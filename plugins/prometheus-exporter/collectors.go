@@ -0,0 +1,188 @@
+package prometheus_exporter
+
+import (
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/errors"
+	"github.com/iotaledger/goshimmer/packages/events"
+	"github.com/iotaledger/goshimmer/packages/model/bundle"
+	"github.com/iotaledger/goshimmer/packages/model/value_transaction"
+	"github.com/iotaledger/goshimmer/packages/prometheus"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/acceptedneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/neighborhood"
+	"github.com/iotaledger/goshimmer/plugins/bundleprocessor"
+	"github.com/iotaledger/goshimmer/plugins/gossip"
+	"github.com/iotaledger/goshimmer/plugins/metrics"
+	"github.com/iotaledger/goshimmer/plugins/tangle"
+	"github.com/iotaledger/goshimmer/plugins/tipselection"
+)
+
+func registerMetrics() {
+	registerGossipMetrics()
+	registerTangleMetrics()
+	registerWorkerPoolMetrics()
+	registerCacheMetrics()
+	registerDatabaseMetrics()
+	registerAutopeeringMetrics()
+	registerBundleProcessorMetrics()
+}
+
+func registerGossipMetrics() {
+	Registry.NewGaugeFunc("goshimmer_gossip_neighbors", "amount of gossip neighbors (including the ones that are not connected)", nil, func() float64 {
+		return float64(len(gossip.GetNeighbors()))
+	})
+	Registry.NewGaugeFunc("goshimmer_gossip_connected_neighbors", "amount of gossip neighbors that we are connected to", nil, func() float64 {
+		connectedNeighbors := 0
+		for _, neighbor := range gossip.GetNeighbors() {
+			if neighbor.IsConnected() {
+				connectedNeighbors++
+			}
+		}
+
+		return float64(connectedNeighbors)
+	})
+
+	Registry.NewCounterFunc("goshimmer_gossip_received_transactions_total", "amount of new transactions that were received through gossip", nil, func() float64 {
+		_, misses := gossip.GetTransactionFilterStatistics()
+
+		return float64(misses)
+	})
+	Registry.NewCounterFunc("goshimmer_gossip_received_duplicate_transactions_total", "amount of duplicate transactions that were filtered", nil, func() float64 {
+		hits, _ := gossip.GetTransactionFilterStatistics()
+
+		return float64(hits)
+	})
+	Registry.NewGaugeFunc("goshimmer_gossip_received_tps", "amount of new transactions that were received during the last second", nil, func() float64 {
+		return float64(metrics.GetReceivedTPS())
+	})
+
+	Registry.NewCounterFunc("goshimmer_gossip_sent_transactions_total", "amount of transactions that were sent to neighbors", nil, func() float64 {
+		return float64(gossip.GetSendQueueStatistics().SentTransactions)
+	})
+	Registry.NewCounterFunc("goshimmer_gossip_dropped_transactions_total", "amount of transactions that were dropped because the send queue of a neighbor was full", prometheus.Labels{"priority": "normal"}, func() float64 {
+		return float64(gossip.GetSendQueueStatistics().DroppedTransactions)
+	})
+	Registry.NewCounterFunc("goshimmer_gossip_dropped_transactions_total", "amount of transactions that were dropped because the send queue of a neighbor was full", prometheus.Labels{"priority": "high"}, func() float64 {
+		return float64(gossip.GetSendQueueStatistics().DroppedPriorityTransactions)
+	})
+	Registry.NewGaugeFunc("goshimmer_gossip_send_queue_size", "amount of transactions waiting in the send queues of all neighbors", prometheus.Labels{"priority": "normal"}, func() float64 {
+		return float64(gossip.GetSendQueueStatistics().QueuedTransactions)
+	})
+	Registry.NewGaugeFunc("goshimmer_gossip_send_queue_size", "amount of transactions waiting in the send queues of all neighbors", prometheus.Labels{"priority": "high"}, func() float64 {
+		return float64(gossip.GetSendQueueStatistics().QueuedPriorityTransactions)
+	})
+}
+
+func registerTangleMetrics() {
+	Registry.NewCounterFunc("goshimmer_tangle_solid_transactions_total", "amount of transactions that became solid", nil, func() float64 {
+		return float64(metrics.GetSolidTransactionCount())
+	})
+	Registry.NewGaugeFunc("goshimmer_tangle_solid_tps", "amount of transactions that became solid during the last second", nil, func() float64 {
+		return float64(metrics.GetSolidTPS())
+	})
+	Registry.RegisterHistogram("goshimmer_tangle_solidification_latency_seconds", "time that passed between receiving a transaction and it becoming solid", nil, metrics.GetSolidificationLatency())
+	Registry.NewGaugeFunc("goshimmer_tangle_tips", "amount of tips that are available for the tip selection", nil, func() float64 {
+		return float64(tipselection.GetTipsCount())
+	})
+}
+
+func registerWorkerPoolMetrics() {
+	queueSizes := map[string]func() int{
+		"solidifier":             tangle.GetSolidifierQueueSize,
+		"bundle_processor":       bundleprocessor.GetQueueSize,
+		"value_bundle_processor": bundleprocessor.GetValueBundleQueueSize,
+	}
+
+	for _, pool := range []string{"solidifier", "bundle_processor", "value_bundle_processor"} {
+		queueSize := queueSizes[pool]
+
+		Registry.NewGaugeFunc("goshimmer_workerpool_queue_size", "amount of tasks waiting for a worker", prometheus.Labels{"pool": pool}, func() float64 {
+			return float64(queueSize())
+		})
+	}
+}
+
+func registerCacheMetrics() {
+	for _, cache := range []string{"transaction", "transaction_metadata", "approvers", "bundle"} {
+		cache := cache
+		labels := prometheus.Labels{"cache": cache}
+
+		Registry.NewCounterFunc("goshimmer_cache_hits_total", "amount of cache lookups that found an entry", labels, func() float64 {
+			return float64(tangle.GetCacheStatistics()[cache].Hits)
+		})
+		Registry.NewCounterFunc("goshimmer_cache_misses_total", "amount of cache lookups that did not find an entry", labels, func() float64 {
+			return float64(tangle.GetCacheStatistics()[cache].Misses)
+		})
+		Registry.NewGaugeFunc("goshimmer_cache_hit_rate", "share of the cache lookups that found an entry (since the node started)", labels, func() float64 {
+			statistics := tangle.GetCacheStatistics()[cache]
+			if statistics.Hits+statistics.Misses == 0 {
+				return 0
+			}
+
+			return float64(statistics.Hits) / float64(statistics.Hits+statistics.Misses)
+		})
+		Registry.NewGaugeFunc("goshimmer_cache_size", "amount of entries in the cache", labels, func() float64 {
+			return float64(tangle.GetCacheStatistics()[cache].Size)
+		})
+		Registry.NewGaugeFunc("goshimmer_cache_capacity", "maximum amount of entries in the cache", labels, func() float64 {
+			return float64(tangle.GetCacheStatistics()[cache].Capacity)
+		})
+	}
+}
+
+func registerDatabaseMetrics() {
+	operationCounts := map[string]func(database.Statistics) uint64{
+		"read":      func(statistics database.Statistics) uint64 { return statistics.Reads },
+		"write":     func(statistics database.Statistics) uint64 { return statistics.Writes },
+		"delete":    func(statistics database.Statistics) uint64 { return statistics.Deletes },
+		"iteration": func(statistics database.Statistics) uint64 { return statistics.Iterations },
+	}
+
+	for _, operation := range []string{"read", "write", "delete", "iteration"} {
+		operationCount := operationCounts[operation]
+
+		Registry.NewCounterFunc("goshimmer_database_operations_total", "amount of executed database operations", prometheus.Labels{"operation": operation}, func() float64 {
+			return float64(operationCount(database.GetStatistics()))
+		})
+	}
+
+	Registry.NewCounterFunc("goshimmer_database_errors_total", "amount of database operations that failed", nil, func() float64 {
+		return float64(database.GetStatistics().Errors)
+	})
+}
+
+func registerAutopeeringMetrics() {
+	Registry.NewGaugeFunc("goshimmer_autopeering_peers", "amount of peers in the lists of the auto peering", prometheus.Labels{"list": "known"}, func() float64 {
+		return float64(len(knownpeers.INSTANCE.Peers))
+	})
+	Registry.NewGaugeFunc("goshimmer_autopeering_peers", "amount of peers in the lists of the auto peering", prometheus.Labels{"list": "neighborhood"}, func() float64 {
+		return float64(len(neighborhood.INSTANCE.Peers))
+	})
+	Registry.NewGaugeFunc("goshimmer_autopeering_peers", "amount of peers in the lists of the auto peering", prometheus.Labels{"list": "chosen"}, func() float64 {
+		return float64(len(chosenneighbors.INSTANCE.Peers))
+	})
+	Registry.NewGaugeFunc("goshimmer_autopeering_peers", "amount of peers in the lists of the auto peering", prometheus.Labels{"list": "accepted"}, func() float64 {
+		return float64(len(acceptedneighbors.INSTANCE.Peers))
+	})
+}
+
+func registerBundleProcessorMetrics() {
+	Registry.NewGaugeFunc("goshimmer_bundleprocessor_bundles_per_second", "amount of bundles that became solid during the last second", nil, func() float64 {
+		return float64(metrics.GetBundlesPerSecond())
+	})
+
+	solidBundles := Registry.NewCounter("goshimmer_bundleprocessor_bundles_total", "amount of processed bundles", prometheus.Labels{"result": "solid"})
+	invalidBundles := Registry.NewCounter("goshimmer_bundleprocessor_bundles_total", "amount of processed bundles", prometheus.Labels{"result": "invalid"})
+	processingErrors := Registry.NewCounter("goshimmer_bundleprocessor_errors_total", "amount of bundles that could not be processed", nil)
+
+	bundleprocessor.Events.BundleSolid.Attach(events.NewClosure(func(_ *bundle.Bundle, _ []*value_transaction.ValueTransaction) {
+		solidBundles.Inc()
+	}))
+	bundleprocessor.Events.InvalidBundle.Attach(events.NewClosure(func(_ *bundle.Bundle, _ []*value_transaction.ValueTransaction) {
+		invalidBundles.Inc()
+	}))
+	bundleprocessor.Events.Error.Attach(events.NewClosure(func(_ errors.IdentifiableError) {
+		processingErrors.Inc()
+	}))
+}
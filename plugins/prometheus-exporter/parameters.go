@@ -0,0 +1,8 @@
+package prometheus_exporter
+
+import "github.com/iotaledger/goshimmer/packages/parameter"
+
+var (
+	ADDRESS = parameter.AddString("PROMETHEUS/ADDRESS", "", "address to bind the metrics endpoint to (empty = all interfaces)")
+	PORT    = parameter.AddInt("PROMETHEUS/PORT", 9311, "tcp port that the metrics are exported on (in the Prometheus text format under /metrics)")
+)
@@ -0,0 +1,78 @@
+package prometheus_exporter
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/daemon"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/prometheus"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/plugins/bundleprocessor"
+	"github.com/iotaledger/goshimmer/plugins/gossip"
+	"github.com/iotaledger/goshimmer/plugins/metrics"
+	"github.com/iotaledger/goshimmer/plugins/tangle"
+	"github.com/iotaledger/goshimmer/plugins/tipselection"
+)
+
+var PLUGIN = node.NewPlugin("Prometheus Exporter", node.Enabled, configure, run).DependsOn(metrics.PLUGIN, gossip.PLUGIN, tangle.PLUGIN, bundleprocessor.PLUGIN, tipselection.PLUGIN)
+
+// the registry that contains all exported metrics (other plugins can register their own metrics as well)
+var Registry = prometheus.NewRegistry()
+
+var server *http.Server
+
+func configure(plugin *node.Plugin) {
+	registerMetrics()
+
+	router := http.NewServeMux()
+	router.HandleFunc("/metrics", serveMetrics(plugin))
+
+	server = &http.Server{
+		Addr:    net.JoinHostPort(*ADDRESS.Value, strconv.Itoa(*PORT.Value)),
+		Handler: router,
+	}
+}
+
+func run(plugin *node.Plugin) {
+	plugin.LogInfo("Starting Metrics Endpoint ...")
+
+	daemon.BackgroundWorker("Prometheus Exporter", func(shutdownSignal <-chan struct{}) {
+		plugin.Log.Success("Starting Metrics Endpoint ... done", "address", server.Addr)
+
+		go func() {
+			<-shutdownSignal
+
+			plugin.LogInfo("Stopping Metrics Endpoint ...")
+
+			ctx, cancel := context.WithTimeout(context.Background(), 1*time.Second)
+			defer cancel()
+
+			if err := server.Shutdown(ctx); err != nil {
+				plugin.Log.Failure("failed to stop the metrics endpoint", "error", err)
+			}
+		}()
+
+		if err := server.ListenAndServe(); err != http.ErrServerClosed {
+			plugin.Log.Failure("failed to start the metrics endpoint", "error", err)
+		}
+
+		plugin.LogSuccess("Stopping Metrics Endpoint ... done")
+	}, shutdown.PRIORITY_PROMETHEUS)
+}
+
+func serveMetrics(plugin *node.Plugin) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", CONTENT_TYPE)
+
+		if err := Registry.Write(w); err != nil {
+			plugin.Log.Debug("failed to write the metrics", "error", err)
+		}
+	}
+}
+
+// the content type of the Prometheus text exposition format
+const CONTENT_TYPE = "text/plain; version=0.0.4; charset=utf-8"
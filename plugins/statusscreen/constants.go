@@ -4,4 +4,7 @@ import "time"
 
 const (
 	REPAINT_INTERVAL = 500 * time.Millisecond
+
+	PAGE_LOG         = "log"
+	PAGE_AUTOPEERING = "autopeering"
 )
@@ -10,6 +10,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/rivo/tview"
 )
 
@@ -23,12 +24,12 @@ func configure(plugin *node.Plugin) {
 		return
 	}
 
-	node.DEFAULT_LOGGER.Enabled = false
+	node.DEFAULT_LOGGER.SetEnabled(false)
 
 	plugin.Node.AddLogger(DEFAULT_LOGGER)
 
-	daemon.Events.Shutdown.Attach(events.NewClosure(func() {
-		node.DEFAULT_LOGGER.Enabled = true
+	plugin.Events.Shutdown.Attach(events.NewClosure(func(plugin *node.Plugin) {
+		node.DEFAULT_LOGGER.SetEnabled(true)
 
 		if app != nil {
 			app.Stop()
@@ -62,7 +63,15 @@ func run(plugin *node.Plugin) {
 	content.SetOffset(0, 0)
 	content.SetGap(0, 0)
 
-	footer := newPrimitive("")
+	autopeering := NewUIAutopeering()
+
+	pages := tview.NewPages().
+		AddPage(PAGE_LOG, content, true, true).
+		AddPage(PAGE_AUTOPEERING, autopeering.Primitive, true, false)
+
+	currentPage := PAGE_LOG
+
+	footer := newPrimitive("[Tab] switch between the log and the autopeering state")
 	footer.SetBackgroundColor(tcell.ColorDarkMagenta)
 	footer.SetTextColor(tcell.ColorWhite)
 
@@ -71,7 +80,7 @@ func run(plugin *node.Plugin) {
 		SetColumns(0).
 		SetBorders(false).
 		AddItem(headerBar.Primitive, 0, 0, 1, 1, 0, 0, false).
-		AddItem(content, 1, 0, 1, 1, 0, 0, false).
+		AddItem(pages, 1, 0, 1, 1, 0, 0, false).
 		AddItem(footer, 2, 0, 1, 1, 0, 0, false)
 
 	frame := tview.NewFrame(grid).
@@ -85,12 +94,29 @@ func run(plugin *node.Plugin) {
 			return nil
 		}
 
+		if event.Key() == tcell.KeyTab {
+			if currentPage == PAGE_LOG {
+				currentPage = PAGE_AUTOPEERING
+			} else {
+				currentPage = PAGE_LOG
+			}
+			pages.SwitchToPage(currentPage)
+
+			return nil
+		}
+
 		return event
 	})
 
 	app.SetBeforeDrawFunc(func(screen tcell.Screen) bool {
 		headerBar.Update()
 
+		if currentPage == PAGE_AUTOPEERING {
+			autopeering.Update()
+
+			return false
+		}
+
 		rows := make([]int, 2)
 		rows[0] = 1
 		rows[1] = 1
@@ -124,22 +150,22 @@ func run(plugin *node.Plugin) {
 		return false
 	})
 
-	daemon.BackgroundWorker("Statusscreen Refresher", func() {
+	daemon.BackgroundWorker("Statusscreen Refresher", func(shutdownSignal <-chan struct{}) {
 		for {
 			select {
-			case <-daemon.ShutdownSignal:
+			case <-shutdownSignal:
 				return
 			case <-time.After(1 * time.Second):
 				app.QueueUpdateDraw(func() {})
 			}
 		}
-	})
+	}, shutdown.PRIORITY_STATUSSCREEN)
 
-	daemon.BackgroundWorker("Statusscreen App", func() {
+	daemon.BackgroundWorker("Statusscreen App", func(shutdownSignal <-chan struct{}) {
 		if err := app.SetRoot(frame, true).SetFocus(frame).Run(); err != nil {
 			panic(err)
 		}
-	})
+	}, shutdown.PRIORITY_STATUSSCREEN)
 }
 
 var PLUGIN = node.NewPlugin("Status Screen", node.Enabled, configure, run)
@@ -0,0 +1,90 @@
+package statusscreen
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gdamore/tcell"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/inspection"
+	"github.com/rivo/tview"
+)
+
+type UIAutopeering struct {
+	Primitive *tview.TextView
+}
+
+func NewUIAutopeering() *UIAutopeering {
+	uiAutopeering := &UIAutopeering{
+		Primitive: tview.NewTextView(),
+	}
+
+	uiAutopeering.Primitive.
+		SetTextAlign(tview.AlignLeft).
+		SetTextColor(tcell.ColorBlack).
+		SetDynamicColors(true).
+		SetBackgroundColor(tcell.ColorWhite)
+
+	return uiAutopeering
+}
+
+func (uiAutopeering *UIAutopeering) Update() {
+	view := uiAutopeering.Primitive
+	view.Clear()
+
+	salts := inspection.GetSalts()
+	fmt.Fprintln(view)
+	fmt.Fprintf(view, " [::b]Salts:[::-] public expires in %v / private expires in %v\n", formatSeconds(salts.Public.ExpiresIn), formatSeconds(salts.Private.ExpiresIn))
+
+	uiAutopeering.printNeighborSet("Chosen Neighbors", inspection.GetChosenNeighbors())
+	uiAutopeering.printNeighborSet("Accepted Neighbors", inspection.GetAcceptedNeighbors())
+
+	neighborhood := inspection.GetNeighborhood()
+	fmt.Fprintln(view)
+	fmt.Fprintf(view, " [::b]Neighborhood (%d):[::-]\n", len(neighborhood))
+	for _, neighbor := range neighborhood {
+		uiAutopeering.printNeighbor(neighbor, "")
+	}
+
+	knownPeers := inspection.GetKnownPeers()
+	fmt.Fprintln(view)
+	fmt.Fprintf(view, " [::b]Known Peers (%d):[::-]\n", len(knownPeers))
+	for _, knownPeer := range knownPeers {
+		fmt.Fprintf(view, "   %-64v %-21v first seen %v / last seen %v / score %.2f\n",
+			knownPeer.Identifier,
+			fmt.Sprintf("%v:%d", knownPeer.Address, knownPeer.PeeringPort),
+			formatTime(knownPeer.FirstSeen),
+			formatTime(knownPeer.LastSeen),
+			knownPeer.Score,
+		)
+	}
+}
+
+func (uiAutopeering *UIAutopeering) printNeighborSet(title string, neighborSet *inspection.NeighborSet) {
+	fmt.Fprintln(uiAutopeering.Primitive)
+	fmt.Fprintf(uiAutopeering.Primitive, " [::b]%v (%d):[::-]\n", title, len(neighborSet.Neighbors))
+
+	for _, neighbor := range neighborSet.Neighbors {
+		marker := ""
+		if neighborSet.FurthestNeighbor != nil && neighbor.Identifier == neighborSet.FurthestNeighbor.Identifier {
+			marker = " [darkmagenta::b](furthest)[-::-]"
+		}
+
+		uiAutopeering.printNeighbor(neighbor, marker)
+	}
+}
+
+func (uiAutopeering *UIAutopeering) printNeighbor(neighbor *inspection.Neighbor, marker string) {
+	fmt.Fprintf(uiAutopeering.Primitive, "   %-64v %-21v distance %020d%v\n", neighbor.Identifier, fmt.Sprintf("%v:%d", neighbor.Address, neighbor.PeeringPort), neighbor.Distance, marker)
+}
+
+func formatSeconds(seconds int64) string {
+	return (time.Duration(seconds) * time.Second).String()
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "never"
+	}
+
+	return t.Format("2006-01-02 15:04:05")
+}
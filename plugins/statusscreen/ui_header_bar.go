@@ -8,6 +8,7 @@ import (
 
 	"github.com/gdamore/tcell"
 	"github.com/iotaledger/goshimmer/packages/accountability"
+	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/acceptedneighbors"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
@@ -125,7 +126,7 @@ func (headerBar *UIHeaderBar) Update() {
 
 func (headerBar *UIHeaderBar) printLogo() {
 	fmt.Fprintln(headerBar.LogoContainer, "")
-	fmt.Fprintln(headerBar.LogoContainer, "   SHIMMER 0.0.1")
+	fmt.Fprintln(headerBar.LogoContainer, "   SHIMMER "+node.VERSION)
 	fmt.Fprintln(headerBar.LogoContainer, "  ┌──────┬──────┐")
 	fmt.Fprintln(headerBar.LogoContainer, "    ───┐ │ ┌───")
 	fmt.Fprintln(headerBar.LogoContainer, "     ┐ │ │ │ ┌")
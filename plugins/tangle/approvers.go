@@ -58,7 +58,10 @@ var approversCache = datastructure.NewLRUCache(APPROVERS_CACHE_SIZE, &datastruct
 
 func onEvictApprovers(_ interface{}, value interface{}) {
 	if evictedApprovers := value.(*approvers.Approvers); evictedApprovers.GetModified() {
+		pendingDatabaseWrites.Add(1)
 		go func(evictedApprovers *approvers.Approvers) {
+			defer pendingDatabaseWrites.Done()
+
 			if err := storeApproversInDatabase(evictedApprovers); err != nil {
 				panic(err)
 			}
@@ -62,7 +62,10 @@ var bundleCache = datastructure.NewLRUCache(BUNDLE_CACHE_SIZE, &datastructure.LR
 
 func onEvictBundle(_ interface{}, value interface{}) {
 	if evictedBundle := value.(*bundle.Bundle); evictedBundle.GetModified() {
+		pendingDatabaseWrites.Add(1)
 		go func(evictedBundle *bundle.Bundle) {
+			defer pendingDatabaseWrites.Done()
+
 			if err := storeBundleInDatabase(evictedBundle); err != nil {
 				panic(err)
 			}
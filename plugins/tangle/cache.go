@@ -0,0 +1,80 @@
+package tangle
+
+import (
+	"sync"
+
+	"github.com/iotaledger/goshimmer/packages/daemon"
+	"github.com/iotaledger/goshimmer/packages/datastructure"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+)
+
+// region plugin module setup //////////////////////////////////////////////////////////////////////////////////////////
+
+func runCacheFlusher(plugin *node.Plugin) {
+	daemon.BackgroundWorker("Tangle Cache Flusher", func(shutdownSignal <-chan struct{}) {
+		<-shutdownSignal
+
+		flushCaches(plugin)
+	}, shutdown.PRIORITY_TANGLE_CACHES)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region cache flushing ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// the evicted entries are written to the database asynchronously - this allows to wait until they are persisted
+var pendingDatabaseWrites sync.WaitGroup
+
+// Writes the modified entries of all caches to the database. The caches are flushed one after the other (transactions
+// first), so a transaction is always persisted before its metadata, its approvers and its bundle.
+func flushCaches(plugin *node.Plugin) {
+	plugin.LogInfo("Flushing Caches ...")
+
+	flushCache(transactionCache)
+	flushCache(transactionMetadataCache)
+	flushCache(approversCache)
+	flushCache(bundleCache)
+
+	plugin.LogSuccess("Flushing Caches ... done")
+}
+
+func flushCache(cache *datastructure.LRUCache) {
+	cache.DeleteAll()
+
+	pendingDatabaseWrites.Wait()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region cache statistics /////////////////////////////////////////////////////////////////////////////////////////////
+
+// Returns the hit and miss counters and the current size of the tangle caches, keyed by the name of the cached model.
+func GetCacheStatistics() map[string]CacheStatistics {
+	return map[string]CacheStatistics{
+		"transaction":          getCacheStatistics(transactionCache),
+		"transaction_metadata": getCacheStatistics(transactionMetadataCache),
+		"approvers":            getCacheStatistics(approversCache),
+		"bundle":               getCacheStatistics(bundleCache),
+	}
+}
+
+func getCacheStatistics(cache *datastructure.LRUCache) CacheStatistics {
+	hits, misses := cache.Statistics()
+
+	return CacheStatistics{
+		Hits:     hits,
+		Misses:   misses,
+		Size:     cache.GetSize(),
+		Capacity: cache.GetCapacity(),
+	}
+}
+
+type CacheStatistics struct {
+	Hits     uint64
+	Misses   uint64
+	Size     int
+	Capacity int
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
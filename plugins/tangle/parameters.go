@@ -0,0 +1,8 @@
+package tangle
+
+import "github.com/iotaledger/goshimmer/packages/parameter"
+
+var (
+	SOLIDIFIER_WORKER_COUNT = parameter.AddUint("TANGLE/SOLIDIFIER_WORKER_COUNT", 5000, "amount of workers that check the solidity of received transactions").SetRange(1, 100000)
+	SOLIDIFIER_QUEUE_SIZE   = parameter.AddUint("TANGLE/SOLIDIFIER_QUEUE_SIZE", 10000, "amount of received transactions that can wait for a solidifier worker").SetRange(1, 1000000)
+)
@@ -18,6 +18,7 @@ func configure(plugin *node.Plugin) {
 
 func run(plugin *node.Plugin) {
 	runSolidifier(plugin)
+	runCacheFlusher(plugin)
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1,6 +1,8 @@
 package tangle
 
 import (
+	"time"
+
 	"github.com/iotaledger/goshimmer/packages/daemon"
 	"github.com/iotaledger/goshimmer/packages/errors"
 	"github.com/iotaledger/goshimmer/packages/events"
@@ -9,6 +11,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/model/transactionmetadata"
 	"github.com/iotaledger/goshimmer/packages/model/value_transaction"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/workerpool"
 	"github.com/iotaledger/goshimmer/plugins/gossip"
 	"github.com/iotaledger/iota.go/trinary"
@@ -20,36 +23,47 @@ var workerPool *workerpool.WorkerPool
 
 func configureSolidifier(plugin *node.Plugin) {
 	workerPool = workerpool.New(func(task workerpool.Task) {
-		processMetaTransaction(plugin, task.Param(0).(*meta_transaction.MetaTransaction))
+		processMetaTransaction(plugin, task.Param(0).(*meta_transaction.MetaTransaction), task.Param(1).(time.Time))
 
 		task.Return(nil)
-	}, workerpool.WorkerCount(WORKER_COUNT), workerpool.QueueSize(10000))
+	}, workerpool.WorkerCount(int(*SOLIDIFIER_WORKER_COUNT.Value)), workerpool.QueueSize(int(*SOLIDIFIER_QUEUE_SIZE.Value)))
 
 	gossip.Events.ReceiveTransaction.Attach(events.NewClosure(func(rawTransaction *meta_transaction.MetaTransaction) {
-		workerPool.Submit(rawTransaction)
-	}))
-
-	daemon.Events.Shutdown.Attach(events.NewClosure(func() {
-		plugin.LogInfo("Stopping Solidifier ...")
-
-		workerPool.Stop()
+		// remember when the transaction was received, so the time it waits for a solidifier worker counts as well
+		workerPool.Submit(rawTransaction, time.Now())
 	}))
 }
 
 func runSolidifier(plugin *node.Plugin) {
 	plugin.LogInfo("Starting Solidifier ...")
 
-	daemon.BackgroundWorker("Tangle Solidifier", func() {
+	// start the pool right away, so no transactions get dropped while the worker is being scheduled
+	workerPool.Start()
+
+	daemon.BackgroundWorker("Tangle Solidifier", func(shutdownSignal <-chan struct{}) {
 		plugin.LogSuccess("Starting Solidifier ... done")
 
+		go func() {
+			<-shutdownSignal
+
+			plugin.LogInfo("Stopping Solidifier ...")
+
+			workerPool.Stop()
+		}()
+
 		workerPool.Run()
 
 		plugin.LogSuccess("Stopping Solidifier ... done")
-	})
+	}, shutdown.PRIORITY_SOLIDIFIER)
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// Returns the amount of received transactions that are still waiting to be processed by the solidifier.
+func GetSolidifierQueueSize() int {
+	return workerPool.GetPendingQueueSize()
+}
+
 // Checks and updates the solid flag of a single transaction.
 func checkSolidity(transaction *value_transaction.ValueTransaction) (result bool, err errors.IdentifiableError) {
 	// abort if transaction is solid already
@@ -144,7 +158,7 @@ func propagateSolidity(transactionHash trinary.Trytes) errors.IdentifiableError
 	return nil
 }
 
-func processMetaTransaction(plugin *node.Plugin, metaTransaction *meta_transaction.MetaTransaction) {
+func processMetaTransaction(plugin *node.Plugin, metaTransaction *meta_transaction.MetaTransaction, receivedTime time.Time) {
 	var newTransaction bool
 	if tx, err := GetTransaction(metaTransaction.GetHash(), func(transactionHash trinary.Trytes) *value_transaction.ValueTransaction {
 		newTransaction = true
@@ -153,15 +167,24 @@ func processMetaTransaction(plugin *node.Plugin, metaTransaction *meta_transacti
 	}); err != nil {
 		plugin.LogFailure(err.Error())
 	} else if newTransaction {
-		processTransaction(plugin, tx)
+		processTransaction(plugin, tx, receivedTime)
 	}
 }
 
-func processTransaction(plugin *node.Plugin, transaction *value_transaction.ValueTransaction) {
+func processTransaction(plugin *node.Plugin, transaction *value_transaction.ValueTransaction, receivedTime time.Time) {
 	Events.TransactionStored.Trigger(transaction)
 
 	transactionHash := transaction.GetHash()
 
+	// store the received time before the solidity is checked (it is used to measure the solidification latency)
+	if txMetadata, err := GetTransactionMetadata(transactionHash, transactionmetadata.New); err != nil {
+		plugin.LogFailure(err.Error())
+
+		return
+	} else {
+		txMetadata.SetReceivedTime(receivedTime)
+	}
+
 	// register tx as approver for trunk
 	if trunkApprovers, err := GetApprovers(transaction.GetTrunkTransactionHash(), approvers.New); err != nil {
 		plugin.LogFailure(err.Error())
@@ -187,5 +210,3 @@ func processTransaction(plugin *node.Plugin, transaction *value_transaction.Valu
 		return
 	}
 }
-
-const WORKER_COUNT = 5000
@@ -1,9 +1,12 @@
 package tangle
 
 import (
+	"io/ioutil"
+	"os"
 	"sync"
 	"testing"
 
+	"github.com/iotaledger/goshimmer/packages/database"
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/model/value_transaction"
 	"github.com/iotaledger/goshimmer/packages/node"
@@ -13,7 +16,15 @@ import (
 
 func TestSolidifier(t *testing.T) {
 	// show all error messages for tests
-	*node.LOG_LEVEL.Value = node.LOG_LEVEL_DEBUG
+	node.LOG_LEVEL.SetValue(node.LOG_LEVEL_DEBUG)
+
+	// use an empty database - the caches are persisted on shutdown, so the transactions of a previous run would be known
+	directory, err := ioutil.TempDir("", "tangle")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+	database.DIRECTORY.SetValue(directory)
 
 	// start a test node
 	node.Start(PLUGIN)
@@ -59,7 +59,10 @@ var transactionCache = datastructure.NewLRUCache(TRANSACTION_CACHE_SIZE, &datast
 
 func onEvictTransaction(_ interface{}, value interface{}) {
 	if evictedTransaction := value.(*value_transaction.ValueTransaction); evictedTransaction.GetModified() {
+		pendingDatabaseWrites.Add(1)
 		go func(evictedTransaction *value_transaction.ValueTransaction) {
+			defer pendingDatabaseWrites.Done()
+
 			if err := storeTransactionInDatabase(evictedTransaction); err != nil {
 				panic(err)
 			}
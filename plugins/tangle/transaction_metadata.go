@@ -59,7 +59,10 @@ var transactionMetadataCache = datastructure.NewLRUCache(TRANSACTION_METADATA_CA
 
 func onEvictTransactionMetadata(_ interface{}, value interface{}) {
 	if evictedTransactionMetadata := value.(*transactionmetadata.TransactionMetadata); evictedTransactionMetadata.GetModified() {
+		pendingDatabaseWrites.Add(1)
 		go func(evictedTransactionMetadata *transactionmetadata.TransactionMetadata) {
+			defer pendingDatabaseWrites.Done()
+
 			if err := storeTransactionMetadataInDatabase(evictedTransactionMetadata); err != nil {
 				panic(err)
 			}
@@ -7,7 +7,7 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/tangle"
 )
 
-var PLUGIN = node.NewPlugin("Tipselection", node.Enabled, configure, run)
+var PLUGIN = node.NewPlugin("Tipselection", node.Enabled, configure, run).DependsOn(tangle.PLUGIN)
 
 func configure(node *node.Plugin) {
 	tangle.Events.TransactionSolid.Attach(events.NewClosure(func(transaction *value_transaction.ValueTransaction) {
@@ -11,7 +11,7 @@ import (
 	. "github.com/iotaledger/iota.go/trinary"
 )
 
-var PLUGIN = node.NewPlugin("Validator", node.Enabled, configure, run)
+var PLUGIN = node.NewPlugin("Validator", node.Enabled, configure, run).DependsOn(bundleprocessor.PLUGIN)
 
 func validateSignatures(bundleHash Hash, txs []*value_transaction.ValueTransaction) (bool, error) {
 	for i, tx := range txs {
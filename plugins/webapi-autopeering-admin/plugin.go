@@ -0,0 +1,72 @@
+package webapi_autopeering_admin
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/autopeering"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/neighborchurn"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/saltmanager"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+	"github.com/labstack/echo"
+)
+
+// the endpoint replaces the salts (and therefore the neighbors) of the node, so it has to be enabled explicitly
+var PLUGIN = node.NewPlugin("WebAPI Autopeering Admin Endpoint", node.Disabled, configure).DependsOn(webapi.PLUGIN, autopeering.PLUGIN)
+
+func configure(plugin *node.Plugin) {
+	webapi.AddPostEndpoint("rotateSalts", RotateSaltsHandler)
+}
+
+// Replaces the public and / or the private salt before they expire and returns the neighbor churn of the previous
+// rotations (the churn of the forced rotation can be queried with a later request).
+func RotateSaltsHandler(c echo.Context) error {
+	c.Set("requestStartTime", time.Now())
+
+	var request rotateSaltsRequest
+	if err := c.Bind(&request); err != nil {
+		return requestFailed(c, err.Error())
+	}
+
+	switch request.Salt {
+	case "":
+		saltmanager.RotatePublicSalt()
+		saltmanager.RotatePrivateSalt()
+	case "public":
+		saltmanager.RotatePublicSalt()
+	case "private":
+		saltmanager.RotatePrivateSalt()
+	case "none":
+	default:
+		return requestFailed(c, "unknown salt: "+request.Salt+" (expected public, private or none)")
+	}
+
+	return c.JSON(http.StatusOK, rotateSaltsResponse{
+		Duration:              time.Since(c.Get("requestStartTime").(time.Time)).Nanoseconds() / 1e6,
+		Status:                "success",
+		ChosenNeighborChurn:   neighborchurn.CHOSEN_NEIGHBORS.GetRotations(),
+		AcceptedNeighborChurn: neighborchurn.ACCEPTED_NEIGHBORS.GetRotations(),
+	})
+}
+
+func requestFailed(c echo.Context, message string) error {
+	return c.JSON(http.StatusOK, rotateSaltsResponse{
+		Duration: time.Since(c.Get("requestStartTime").(time.Time)).Nanoseconds() / 1e6,
+		Status:   "failed",
+		Message:  message,
+	})
+}
+
+type rotateSaltsRequest struct {
+	// public, private, none (only returns the statistics) or empty for both salts
+	Salt string `json:"salt"`
+}
+
+type rotateSaltsResponse struct {
+	Duration              int64                    `json:"duration"`
+	Status                string                   `json:"status"`
+	Message               string                   `json:"message,omitempty"`
+	ChosenNeighborChurn   []neighborchurn.Rotation `json:"chosenNeighborChurn,omitempty"`
+	AcceptedNeighborChurn []neighborchurn.Rotation `json:"acceptedNeighborChurn,omitempty"`
+}
@@ -0,0 +1,79 @@
+package webapi_autopeering
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/iotaledger/goshimmer/plugins/autopeering/inspection"
+	"github.com/labstack/echo"
+)
+
+// Lists all known peers with the time we first and last saw them.
+func GetKnownPeersHandler(c echo.Context) error {
+	c.Set("requestStartTime", time.Now())
+
+	return c.JSON(http.StatusOK, getKnownPeersResponse{
+		Duration:   time.Since(c.Get("requestStartTime").(time.Time)).Nanoseconds() / 1e6,
+		Status:     "success",
+		KnownPeers: inspection.GetKnownPeers(),
+	})
+}
+
+// Lists the current neighborhood (the candidates for our chosen neighbors) with their distances.
+func GetNeighborhoodHandler(c echo.Context) error {
+	c.Set("requestStartTime", time.Now())
+
+	return c.JSON(http.StatusOK, getNeighborhoodResponse{
+		Duration:     time.Since(c.Get("requestStartTime").(time.Time)).Nanoseconds() / 1e6,
+		Status:       "success",
+		Neighborhood: inspection.GetNeighborhood(),
+	})
+}
+
+// Lists the chosen and the accepted neighbors with their distances and the furthest neighbor of both sets.
+func GetNeighborsHandler(c echo.Context) error {
+	c.Set("requestStartTime", time.Now())
+
+	return c.JSON(http.StatusOK, getNeighborsResponse{
+		Duration:          time.Since(c.Get("requestStartTime").(time.Time)).Nanoseconds() / 1e6,
+		Status:            "success",
+		ChosenNeighbors:   inspection.GetChosenNeighbors(),
+		AcceptedNeighbors: inspection.GetAcceptedNeighbors(),
+	})
+}
+
+// Returns the expiration times of the public and the private salt.
+func GetSaltsHandler(c echo.Context) error {
+	c.Set("requestStartTime", time.Now())
+
+	return c.JSON(http.StatusOK, getSaltsResponse{
+		Duration: time.Since(c.Get("requestStartTime").(time.Time)).Nanoseconds() / 1e6,
+		Status:   "success",
+		Salts:    inspection.GetSalts(),
+	})
+}
+
+type getKnownPeersResponse struct {
+	Duration   int64                   `json:"duration"`
+	Status     string                  `json:"status"`
+	KnownPeers []*inspection.KnownPeer `json:"knownPeers"`
+}
+
+type getNeighborhoodResponse struct {
+	Duration     int64                  `json:"duration"`
+	Status       string                 `json:"status"`
+	Neighborhood []*inspection.Neighbor `json:"neighborhood"`
+}
+
+type getNeighborsResponse struct {
+	Duration          int64                   `json:"duration"`
+	Status            string                  `json:"status"`
+	ChosenNeighbors   *inspection.NeighborSet `json:"chosenNeighbors"`
+	AcceptedNeighbors *inspection.NeighborSet `json:"acceptedNeighbors"`
+}
+
+type getSaltsResponse struct {
+	Duration int64             `json:"duration"`
+	Status   string            `json:"status"`
+	Salts    *inspection.Salts `json:"salts"`
+}
@@ -0,0 +1,16 @@
+package webapi_autopeering
+
+import (
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/autopeering"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+)
+
+var PLUGIN = node.NewPlugin("WebAPI Autopeering Endpoint", node.Enabled, configure).DependsOn(webapi.PLUGIN, autopeering.PLUGIN)
+
+func configure(plugin *node.Plugin) {
+	webapi.AddEndpoint("getKnownPeers", GetKnownPeersHandler)
+	webapi.AddEndpoint("getNeighborhood", GetNeighborhoodHandler)
+	webapi.AddEndpoint("getNeighbors", GetNeighborsHandler)
+	webapi.AddEndpoint("getSalts", GetSaltsHandler)
+}
@@ -13,7 +13,7 @@ import (
 
 var PLUGIN = node.NewPlugin("WebAPI GTTA Endpoint", node.Enabled, func(plugin *node.Plugin) {
 	webapi.AddEndpoint("getTransactionsToApprove", Handler)
-})
+}).DependsOn(webapi.PLUGIN, tipselection.PLUGIN)
 
 func Handler(c echo.Context) error {
 	start := time.Now()
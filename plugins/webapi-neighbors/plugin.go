@@ -0,0 +1,99 @@
+package webapi_neighbors
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/gossip"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+	"github.com/labstack/echo"
+)
+
+// the endpoints change the neighbors of the node, so they have to be enabled explicitly
+var PLUGIN = node.NewPlugin("WebAPI Neighbors Endpoint", node.Disabled, configure).DependsOn(webapi.PLUGIN, gossip.PLUGIN)
+
+func configure(plugin *node.Plugin) {
+	webapi.AddPostEndpoint("addNeighbors", AddNeighborsHandler)
+	webapi.AddPostEndpoint("removeNeighbors", RemoveNeighborsHandler)
+}
+
+// Adds the given identity@host:port definitions as static neighbors.
+func AddNeighborsHandler(c echo.Context) error {
+	c.Set("requestStartTime", time.Now())
+
+	var request webRequest
+	if err := c.Bind(&request); err != nil {
+		return requestFailed(c, err.Error())
+	}
+
+	// parse all definitions first, so we do not add anything if the request is invalid
+	neighbors := make([]*gossip.Neighbor, 0, len(request.Neighbors))
+	for _, neighborDefinition := range request.Neighbors {
+		neighbor, err := gossip.ParseNeighbor(neighborDefinition)
+		if err != nil {
+			return requestFailed(c, neighborDefinition+": "+err.Error())
+		}
+
+		neighbors = append(neighbors, neighbor)
+	}
+
+	addedNeighbors := make([]string, 0, len(neighbors))
+	for _, neighbor := range neighbors {
+		gossip.AddStaticNeighbor(neighbor)
+
+		addedNeighbors = append(addedNeighbors, neighbor.Identity.StringIdentifier)
+	}
+
+	return requestSuccessful(c, "added static neighbors", addedNeighbors)
+}
+
+// Removes the static neighbors with the given identities (identity@host:port definitions are accepted as well).
+func RemoveNeighborsHandler(c echo.Context) error {
+	c.Set("requestStartTime", time.Now())
+
+	var request webRequest
+	if err := c.Bind(&request); err != nil {
+		return requestFailed(c, err.Error())
+	}
+
+	removedNeighbors := make([]string, 0, len(request.Neighbors))
+	for _, neighborDefinition := range request.Neighbors {
+		identifier := strings.ToLower(strings.Split(neighborDefinition, "@")[0])
+
+		if gossip.RemoveStaticNeighbor(identifier) {
+			removedNeighbors = append(removedNeighbors, identifier)
+		}
+	}
+
+	return requestSuccessful(c, "removed static neighbors", removedNeighbors)
+}
+
+func requestSuccessful(c echo.Context, message string, neighbors []string) error {
+	return c.JSON(http.StatusOK, webResponse{
+		Duration:  time.Since(c.Get("requestStartTime").(time.Time)).Nanoseconds() / 1e6,
+		Status:    "success",
+		Message:   message,
+		Neighbors: neighbors,
+	})
+}
+
+func requestFailed(c echo.Context, message string) error {
+	return c.JSON(http.StatusOK, webResponse{
+		Duration: time.Since(c.Get("requestStartTime").(time.Time)).Nanoseconds() / 1e6,
+		Status:   "failed",
+		Message:  message,
+	})
+}
+
+type webResponse struct {
+	Duration  int64    `json:"duration"`
+	Status    string   `json:"status"`
+	Message   string   `json:"message"`
+	Neighbors []string `json:"neighbors,omitempty"`
+}
+
+type webRequest struct {
+	Neighbors []string `json:"neighbors"`
+}
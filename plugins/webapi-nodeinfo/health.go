@@ -0,0 +1,119 @@
+package webapi_nodeinfo
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/daemon"
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/timeutil"
+	"github.com/iotaledger/goshimmer/plugins/tangle"
+	"github.com/labstack/echo"
+)
+
+// Probes the database once and then periodically in the background, so the (frequently polled) handlers only have to
+// read the result of the last probe.
+func runDatabaseProbe() {
+	probeDatabase()
+
+	daemon.BackgroundWorker("WebAPI NodeInfo Database Probe", func(shutdownSignal <-chan struct{}) {
+		timeutil.Ticker(probeDatabase, DATABASE_PROBE_INTERVAL, shutdownSignal)
+	}, shutdown.PRIORITY_WEBAPI)
+}
+
+// Reports if the node is alive, which means that its database still accepts writes.
+func HealthHandler(c echo.Context) error {
+	start := time.Now()
+
+	if err := getDatabaseProbeError(); err != nil {
+		return healthResponse(c, start, http.StatusServiceUnavailable, "database is not writable: "+err.Error())
+	}
+
+	return healthResponse(c, start, http.StatusOK, "healthy")
+}
+
+// Reports if the node is ready to serve requests, which means that it is healthy and synced.
+func ReadyHandler(c echo.Context) error {
+	start := time.Now()
+
+	if err := getDatabaseProbeError(); err != nil {
+		return healthResponse(c, start, http.StatusServiceUnavailable, "database is not writable: "+err.Error())
+	}
+
+	if !isSynced() {
+		return healthResponse(c, start, http.StatusServiceUnavailable, "node is not synced")
+	}
+
+	return healthResponse(c, start, http.StatusOK, "ready")
+}
+
+// The node counts as synced as long as it is connected to at least one neighbor and the solidifier keeps up with the
+// received transactions (there is no coordinator whose milestones could be used to determine the sync state).
+func isSynced() bool {
+	if _, connectedNeighbors := getGossipNeighborCounts(); connectedNeighbors == 0 {
+		return false
+	}
+
+	return tangle.GetSolidifierQueueSize() <= int(SYNC_MAX_PENDING_TRANSACTIONS.GetValue())
+}
+
+func probeDatabase() {
+	err := checkDatabaseWritable()
+
+	databaseProbeMutex.Lock()
+	databaseProbeError = err
+	databaseProbeMutex.Unlock()
+}
+
+// Returns the error of the last database probe (nil if the database was writable).
+func getDatabaseProbeError() error {
+	databaseProbeMutex.RLock()
+	defer databaseProbeMutex.RUnlock()
+
+	return databaseProbeError
+}
+
+// Writes and removes a probe entry to make sure that the database is still writable.
+func checkDatabaseWritable() error {
+	db, err := database.Get("health")
+	if err != nil {
+		return err
+	}
+
+	if err := db.Set(HEALTH_PROBE_KEY, []byte(strconv.FormatInt(time.Now().Unix(), 10))); err != nil {
+		return err
+	}
+
+	return db.Delete(HEALTH_PROBE_KEY)
+}
+
+func healthResponse(c echo.Context, start time.Time, statusCode int, message string) error {
+	status := "success"
+	if statusCode != http.StatusOK {
+		status = "failed"
+	}
+
+	return c.JSON(statusCode, healthWebResponse{
+		Duration: time.Since(start).Nanoseconds() / 1e6,
+		Status:   status,
+		Message:  message,
+	})
+}
+
+var HEALTH_PROBE_KEY = []byte("probe")
+
+// how often we check if the database is still writable
+const DATABASE_PROBE_INTERVAL = 5 * time.Second
+
+var databaseProbeError error
+
+var databaseProbeMutex sync.RWMutex
+
+type healthWebResponse struct {
+	Duration int64  `json:"duration"`
+	Status   string `json:"status"`
+	Message  string `json:"message"`
+}
@@ -0,0 +1,7 @@
+package webapi_nodeinfo
+
+import "github.com/iotaledger/goshimmer/packages/parameter"
+
+var (
+	SYNC_MAX_PENDING_TRANSACTIONS = parameter.AddUint("NODEINFO/SYNC_MAX_PENDING_TRANSACTIONS", 1000, "amount of received transactions waiting for the solidifier above which the node is not considered synced").SetRange(0, 1000000).MakeReloadable()
+)
@@ -0,0 +1,88 @@
+package webapi_nodeinfo
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/accountability"
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/acceptedneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/chosenneighbors"
+	"github.com/iotaledger/goshimmer/plugins/autopeering/instances/knownpeers"
+	"github.com/iotaledger/goshimmer/plugins/gossip"
+	"github.com/iotaledger/goshimmer/plugins/metrics"
+	"github.com/iotaledger/goshimmer/plugins/tangle"
+	"github.com/iotaledger/goshimmer/plugins/tipselection"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+	"github.com/labstack/echo"
+)
+
+var PLUGIN = node.NewPlugin("WebAPI NodeInfo Endpoint", node.Enabled, configure, run).DependsOn(webapi.PLUGIN, gossip.PLUGIN, tangle.PLUGIN, tipselection.PLUGIN, metrics.PLUGIN)
+
+var currentNode *node.Node
+
+func configure(plugin *node.Plugin) {
+	currentNode = plugin.Node
+
+	webapi.AddEndpoint("getNodeInfo", NodeInfoHandler)
+	webapi.AddEndpoint("healthz", HealthHandler)
+	webapi.AddEndpoint("readyz", ReadyHandler)
+}
+
+func run(plugin *node.Plugin) {
+	runDatabaseProbe()
+}
+
+// Returns the version, identity, uptime, enabled plugins and the current status of the node.
+func NodeInfoHandler(c echo.Context) error {
+	start := time.Now()
+
+	totalNeighbors, connectedNeighbors := getGossipNeighborCounts()
+
+	return c.JSON(http.StatusOK, webResponse{
+		Duration:              time.Since(start).Nanoseconds() / 1e6,
+		Version:               node.VERSION,
+		Identity:              accountability.OwnId().StringIdentifier,
+		Uptime:                int64(currentNode.GetUptime() / time.Second),
+		Plugins:               currentNode.GetLoadedPlugins(),
+		Synced:                isSynced(),
+		Neighbors:             totalNeighbors,
+		ConnectedNeighbors:    connectedNeighbors,
+		ChosenNeighbors:       chosenneighbors.INSTANCE.Len(),
+		AcceptedNeighbors:     acceptedneighbors.INSTANCE.Len(),
+		KnownPeers:            knownpeers.INSTANCE.Len(),
+		TipsCount:             tipselection.GetTipsCount(),
+		ReceivedTPS:           metrics.GetReceivedTPS(),
+		SolidTransactionCount: metrics.GetSolidTransactionCount(),
+	})
+}
+
+// Returns the amount of gossip neighbors and how many of them are currently connected.
+func getGossipNeighborCounts() (total int, connected int) {
+	for _, neighbor := range gossip.GetNeighbors() {
+		total++
+
+		if neighbor.IsConnected() {
+			connected++
+		}
+	}
+
+	return
+}
+
+type webResponse struct {
+	Duration              int64    `json:"duration"`
+	Version               string   `json:"version"`
+	Identity              string   `json:"identity"`
+	Uptime                int64    `json:"uptime"`
+	Plugins               []string `json:"plugins"`
+	Synced                bool     `json:"synced"`
+	Neighbors             int      `json:"neighbors"`
+	ConnectedNeighbors    int      `json:"connectedNeighbors"`
+	ChosenNeighbors       int      `json:"chosenNeighbors"`
+	AcceptedNeighbors     int      `json:"acceptedNeighbors"`
+	KnownPeers            int      `json:"knownPeers"`
+	TipsCount             int      `json:"tipsCount"`
+	ReceivedTPS           uint64   `json:"receivedTps"`
+	SolidTransactionCount uint64   `json:"solidTransactionCount"`
+}
@@ -0,0 +1,83 @@
+package webapi_parameters
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/parameter"
+	"github.com/iotaledger/goshimmer/plugins/cli"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+	"github.com/labstack/echo"
+)
+
+// the endpoints change the configuration of the node, so they have to be enabled explicitly
+var PLUGIN = node.NewPlugin("WebAPI Parameters Endpoint", node.Disabled, configure).DependsOn(webapi.PLUGIN, cli.PLUGIN)
+
+func configure(plugin *node.Plugin) {
+	webapi.AddEndpoint("getParameters", GetParametersHandler)
+	webapi.AddPostEndpoint("setParameter", SetParameterHandler)
+	webapi.AddPostEndpoint("reloadParameters", ReloadParametersHandler)
+}
+
+// Returns the current values of all parameters that can be changed while the node is running.
+func GetParametersHandler(c echo.Context) error {
+	c.Set("requestStartTime", time.Now())
+
+	return requestSuccessful(c)
+}
+
+// Changes the value of a single reloadable parameter (i.e. {"name": "NODE/LOG_LEVEL", "value": "4"}).
+func SetParameterHandler(c echo.Context) error {
+	c.Set("requestStartTime", time.Now())
+
+	var request setParameterRequest
+	if err := c.Bind(&request); err != nil {
+		return requestFailed(c, err.Error())
+	}
+
+	if err := parameter.SetReloadableValue(request.Name, request.Value); err != nil {
+		return requestFailed(c, err.Error())
+	}
+
+	return requestSuccessful(c)
+}
+
+// Reloads the reloadable parameters from the configuration file and the environment (like a SIGHUP).
+func ReloadParametersHandler(c echo.Context) error {
+	c.Set("requestStartTime", time.Now())
+
+	if err := cli.Reload(); err != nil {
+		return requestFailed(c, err.Error())
+	}
+
+	return requestSuccessful(c)
+}
+
+func requestSuccessful(c echo.Context) error {
+	return c.JSON(http.StatusOK, webResponse{
+		Duration:   time.Since(c.Get("requestStartTime").(time.Time)).Nanoseconds() / 1e6,
+		Status:     "success",
+		Parameters: parameter.GetReloadableValues(),
+	})
+}
+
+func requestFailed(c echo.Context, message string) error {
+	return c.JSON(http.StatusOK, webResponse{
+		Duration: time.Since(c.Get("requestStartTime").(time.Time)).Nanoseconds() / 1e6,
+		Status:   "failed",
+		Message:  message,
+	})
+}
+
+type setParameterRequest struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type webResponse struct {
+	Duration   int64             `json:"duration"`
+	Status     string            `json:"status"`
+	Message    string            `json:"message,omitempty"`
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
@@ -0,0 +1,7 @@
+package webapi_spammer
+
+import "github.com/iotaledger/goshimmer/packages/parameter"
+
+var (
+	TPS = parameter.AddUint("SPAMMER/TPS", 1000, "amount of transactions per second that the spammer sends (if the start request does not specify it)").SetRange(1, 1000000).MakeReloadable()
+)
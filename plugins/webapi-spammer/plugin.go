@@ -4,16 +4,24 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/iotaledger/goshimmer/packages/transactionspammer"
+	"github.com/iotaledger/goshimmer/plugins/tipselection"
 	"github.com/iotaledger/goshimmer/plugins/webapi"
 	"github.com/labstack/echo"
 )
 
-var PLUGIN = node.NewPlugin("Spammer", node.Disabled, configure)
+var PLUGIN = node.NewPlugin("Spammer", node.Disabled, configure).DependsOn(webapi.PLUGIN, tipselection.PLUGIN)
 
 func configure(plugin *node.Plugin) {
 	webapi.AddEndpoint("spammer", WebApiHandler)
+
+	TPS.Events.Change.Attach(events.NewClosure(func(oldValue uint64, newValue uint64) {
+		transactionspammer.SetTPS(uint(newValue))
+
+		plugin.Log.Info("spammer rate changed", "transactionsPerSecond", newValue)
+	}))
 }
 
 func WebApiHandler(c echo.Context) error {
@@ -27,7 +35,7 @@ func WebApiHandler(c echo.Context) error {
 	switch request.Cmd {
 	case "start":
 		if request.Tps == 0 {
-			request.Tps = 1000
+			request.Tps = uint(TPS.GetValue())
 		}
 
 		transactionspammer.Stop()
@@ -7,3 +7,9 @@ import (
 func AddEndpoint(url string, handler func(c echo.Context) error) {
 	Server.GET(url, handler)
 }
+
+// Registers an endpoint that changes the state of the node (it only accepts POST requests, so it can not be triggered
+// by simply following a link).
+func AddPostEndpoint(url string, handler func(c echo.Context) error) {
+	Server.POST(url, handler)
+}
@@ -3,9 +3,19 @@ package webapi
 import (
 	"net/http"
 
+	"github.com/iotaledger/goshimmer/packages/node"
 	"github.com/labstack/echo"
 )
 
+// Identifies the node software, so clients can check that they are talking to the right service.
 func IndexRequest(c echo.Context) error {
-	return c.String(http.StatusOK, "INDEX")
+	return c.JSON(http.StatusOK, indexWebResponse{
+		Name:    "GoShimmer",
+		Version: node.VERSION,
+	})
+}
+
+type indexWebResponse struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
 }
@@ -9,28 +9,18 @@ import (
 	"github.com/iotaledger/goshimmer/packages/events"
 	"github.com/iotaledger/goshimmer/packages/model/value_transaction"
 	"github.com/iotaledger/goshimmer/packages/node"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/plugins/tangle"
 )
 
 // zeromq logging is disabled by default
-var PLUGIN = node.NewPlugin("ZeroMQ", node.Disabled, configure, run)
+var PLUGIN = node.NewPlugin("ZeroMQ", node.Disabled, configure, run).DependsOn(tangle.PLUGIN)
 
 var publisher *Publisher
 var emptyTag = strings.Repeat("9", 27)
 
 // Configure the zeromq plugin
 func configure(plugin *node.Plugin) {
-
-	daemon.Events.Shutdown.Attach(events.NewClosure(func() {
-		plugin.LogInfo("Stopping ZeroMQ Publisher ...")
-
-		if err := publisher.Shutdown(); err != nil {
-			plugin.LogFailure("Stopping ZeroMQ Publisher: " + err.Error())
-		} else {
-			plugin.LogSuccess("Stopping ZeroMQ Publisher ... done")
-		}
-	}))
-
 	tangle.Events.TransactionStored.Attach(events.NewClosure(func(tx *value_transaction.ValueTransaction) {
 		// create goroutine for every event
 		go func() {
@@ -44,15 +34,27 @@ func configure(plugin *node.Plugin) {
 // Start the zeromq plugin
 func run(plugin *node.Plugin) {
 
-	plugin.LogInfo("Starting ZeroMQ Publisher (port " + strconv.Itoa(*PORT.Value) + ") ...")
+	plugin.Log.Info("Starting ZeroMQ Publisher ...", "port", *PORT.Value)
 
-	daemon.BackgroundWorker("ZeroMQ Publisher", func() {
+	daemon.BackgroundWorker("ZeroMQ Publisher", func(shutdownSignal <-chan struct{}) {
 		if err := startPublisher(plugin); err != nil {
-			plugin.LogFailure("Stopping ZeroMQ Publisher: " + err.Error())
+			plugin.Log.Failure("Stopping ZeroMQ Publisher", "error", err)
+
+			return
+		}
+
+		plugin.Log.Success("Starting ZeroMQ Publisher ... done", "port", *PORT.Value)
+
+		<-shutdownSignal
+
+		plugin.LogInfo("Stopping ZeroMQ Publisher ...")
+
+		if err := publisher.Shutdown(); err != nil {
+			plugin.Log.Failure("Stopping ZeroMQ Publisher", "error", err)
 		} else {
-			plugin.LogSuccess("Starting ZeroMQ Publisher (port " + strconv.Itoa(*PORT.Value) + ") ... done")
+			plugin.LogSuccess("Stopping ZeroMQ Publisher ... done")
 		}
-	})
+	}, shutdown.PRIORITY_ZEROMQ)
 }
 
 // Start the zmq publisher.